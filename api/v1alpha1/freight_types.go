@@ -46,10 +46,13 @@ func (f *Freight) UpdateID() {
 		)
 	}
 	for _, image := range f.Images {
-		artifacts = append(
-			artifacts,
-			fmt.Sprintf("%s:%s", image.RepoURL, image.Tag),
-		)
+		artifact := fmt.Sprintf("%s:%s", image.RepoURL, image.Tag)
+		// Including the digest, when it is known, ensures that an image tag that
+		// is overwritten with different content produces different Freight.
+		if image.Digest != "" {
+			artifact = fmt.Sprintf("%s@%s", artifact, image.Digest)
+		}
+		artifacts = append(artifacts, artifact)
 	}
 	for _, chart := range f.Charts {
 		artifacts = append(
@@ -73,6 +76,9 @@ type GitCommit struct {
 	ID string `json:"id,omitempty"`
 	// Branch denotes the branch of the repository where this commit was found.
 	Branch string `json:"branch,omitempty"`
+	// Tag denotes the tag that referenced this commit when it was selected.
+	// This is only set when the commit was selected by way of a tag.
+	Tag string `json:"tag,omitempty"`
 	// HealthCheckCommit is the ID of a specific commit. When specified,
 	// assessments of Stage health will used this value (instead of ID) when
 	// determining if applicable sources of Argo CD Application resources
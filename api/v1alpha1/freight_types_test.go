@@ -108,4 +108,14 @@ func TestFreightUpdateID(t *testing.T) {
 	freight.Commits[0].ID = "a-different-fake-commit"
 	freight.UpdateID()
 	require.NotEqual(t, result, freight.ID)
+	// Including an image digest should change the result
+	result = freight.ID
+	freight.Images[0].Digest = "fake-image-digest"
+	freight.UpdateID()
+	require.NotEqual(t, result, freight.ID)
+	// Changing an image digest should change the result
+	result = freight.ID
+	freight.Images[0].Digest = "a-different-fake-image-digest"
+	freight.UpdateID()
+	require.NotEqual(t, result, freight.ID)
 }
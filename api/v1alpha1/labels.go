@@ -5,5 +5,22 @@ const (
 
 	LabelTrueValue = "true"
 
+	// LabelSecretTypeKey identifies what a Secret in a project namespace is
+	// used for.
+	LabelSecretTypeKey = "kargo.akuity.io/secret-type"
+	// LabelSecretTypeWebhookHeaders is the value of the LabelSecretTypeKey
+	// label that permits HTTP webhooks to send a Secret's contents as headers.
+	LabelSecretTypeWebhookHeaders = "webhook-headers"
+
 	AnnotationKeyRefresh = "kargo.akuity.io/refresh"
+	AnnotationKeyAbort   = "kargo.akuity.io/abort"
+
+	// AnnotationKeyBreakGlass, when present on a Promotion, overrides any
+	// freeze window that would otherwise prevent its creation. Its value
+	// should explain why.
+	AnnotationKeyBreakGlass = "kargo.akuity.io/break-glass"
+	// AnnotationKeyBreakGlassBy records the user who created a Promotion
+	// bearing the AnnotationKeyBreakGlass annotation. It is set by Kargo and any
+	// client-supplied value is disregarded.
+	AnnotationKeyBreakGlassBy = "kargo.akuity.io/break-glass-by"
 )
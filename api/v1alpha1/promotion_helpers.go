@@ -2,8 +2,11 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -30,3 +33,90 @@ func GetPromotion(
 	}
 	return &promo, nil
 }
+
+// AbortPromotion requests that a Promotion be aborted by setting an annotation
+// on the Promotion. The Promotion controller reacts to this by cancelling any
+// in-progress execution of the Promotion's promotion mechanisms and moving the
+// Promotion to the Aborted phase. The annotation value is the timestamp of the
+// request.
+func AbortPromotion(
+	ctx context.Context,
+	c client.Client,
+	namespacedName types.NamespacedName,
+) error {
+	return abortPromotion(ctx, c, namespacedName, time.Now)
+}
+
+func abortPromotion(
+	ctx context.Context,
+	c client.Client,
+	namespacedName types.NamespacedName,
+	nowFunc func() time.Time,
+) error {
+	promo := &Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespacedName.Namespace,
+			Name:      namespacedName.Name,
+		},
+	}
+	patchBytes := []byte(
+		fmt.Sprintf(
+			`{"metadata":{"annotations":{"%s":"%s"}}}`,
+			AnnotationKeyAbort,
+			nowFunc().UTC().Format(time.RFC3339),
+		),
+	)
+	patch := client.RawPatch(types.MergePatchType, patchBytes)
+	if err := c.Patch(ctx, promo, patch); err != nil {
+		return errors.Wrapf(
+			err,
+			"error patching Promotion %q in namespace %q",
+			namespacedName.Name,
+			namespacedName.Namespace,
+		)
+	}
+	return nil
+}
+
+// AddPromotionApproval records the provided approval in the status of the
+// provided Promotion, which is updated in place. The update fails if the
+// Promotion has been modified since it was retrieved, so that approvals made
+// concurrently by different users cannot overwrite one another.
+func AddPromotionApproval(
+	ctx context.Context,
+	c client.Client,
+	promo *Promotion,
+	approval PromotionApproval,
+) error {
+	patch := client.MergeFromWithOptions(
+		promo.DeepCopy(),
+		client.MergeFromWithOptimisticLock{},
+	)
+	promo.Status.Approvals = append(promo.Status.Approvals, approval)
+	if err := c.Status().Patch(ctx, promo, patch); err != nil {
+		return errors.Wrapf(
+			err,
+			"error patching Promotion %q status in namespace %q",
+			promo.Name,
+			promo.Namespace,
+		)
+	}
+	return nil
+}
+
+// IsApprovedBy returns true if the specified user has approved the Promotion.
+func (p *Promotion) IsApprovedBy(username string) bool {
+	for _, approval := range p.Status.Approvals {
+		if approval.Username == username {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAbortRequested returns true if a user has requested that the Promotion be
+// aborted.
+func (p *Promotion) IsAbortRequested() bool {
+	_, ok := p.GetAnnotations()[AnnotationKeyAbort]
+	return ok
+}
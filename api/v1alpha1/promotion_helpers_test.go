@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -62,3 +63,151 @@ func TestGetPromotion(t *testing.T) {
 		})
 	}
 }
+
+func TestAbortPromotion(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, SchemeBuilder.AddToScheme(scheme))
+
+	mockNow := func() time.Time {
+		return time.Date(2023, 11, 2, 0, 0, 0, 0, time.UTC)
+	}
+	testCases := []struct {
+		name       string
+		client     client.Client
+		assertions func(client.Client, error)
+	}{
+		{
+			name:   "not found",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			assertions: func(_ client.Client, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error patching Promotion")
+			},
+		},
+		{
+			name: "success",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-promotion",
+						Namespace: "fake-namespace",
+					},
+				},
+			).Build(),
+			assertions: func(c client.Client, err error) {
+				require.NoError(t, err)
+				promo, err := GetPromotion(
+					context.Background(),
+					c,
+					types.NamespacedName{
+						Namespace: "fake-namespace",
+						Name:      "fake-promotion",
+					},
+				)
+				require.NoError(t, err)
+				require.True(t, promo.IsAbortRequested())
+				require.Equal(
+					t,
+					mockNow().Format(time.RFC3339),
+					promo.Annotations[AnnotationKeyAbort],
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := abortPromotion(
+				context.Background(),
+				testCase.client,
+				types.NamespacedName{
+					Namespace: "fake-namespace",
+					Name:      "fake-promotion",
+				},
+				mockNow,
+			)
+			testCase.assertions(testCase.client, err)
+		})
+	}
+}
+
+func TestAddPromotionApproval(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, SchemeBuilder.AddToScheme(scheme))
+
+	approvedAt := metav1.NewTime(time.Date(2023, 11, 2, 0, 0, 0, 0, time.UTC))
+	testCases := []struct {
+		name       string
+		client     client.Client
+		assertions func(client.Client, error)
+	}{
+		{
+			name:   "not found",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			assertions: func(_ client.Client, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error patching Promotion")
+			},
+		},
+		{
+			name: "success",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-promotion",
+						Namespace: "fake-namespace",
+					},
+					Status: PromotionStatus{
+						Approvals: []PromotionApproval{
+							{Username: "alice"},
+						},
+					},
+				},
+			).Build(),
+			assertions: func(c client.Client, err error) {
+				require.NoError(t, err)
+				promo, err := GetPromotion(
+					context.Background(),
+					c,
+					types.NamespacedName{
+						Namespace: "fake-namespace",
+						Name:      "fake-promotion",
+					},
+				)
+				require.NoError(t, err)
+				require.Len(t, promo.Status.Approvals, 2)
+				require.True(t, promo.IsApprovedBy("alice"))
+				require.True(t, promo.IsApprovedBy("bob"))
+				require.False(t, promo.IsApprovedBy("carol"))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			promo := &Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-promotion",
+					Namespace: "fake-namespace",
+				},
+			}
+			// Start from whatever is actually stored, if anything
+			_ = testCase.client.Get(
+				context.Background(),
+				types.NamespacedName{
+					Namespace: "fake-namespace",
+					Name:      "fake-promotion",
+				},
+				promo,
+			)
+			err := AddPromotionApproval(
+				context.Background(),
+				testCase.client,
+				promo,
+				PromotionApproval{
+					Username:   "bob",
+					ApprovedAt: &approvedAt,
+				},
+			)
+			testCase.assertions(testCase.client, err)
+		})
+	}
+}
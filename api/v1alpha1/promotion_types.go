@@ -28,11 +28,23 @@ const (
 	// room for the possibility in the near future that a Promotion might fail
 	// as a result of some user action.
 	PromotionPhaseErrored PromotionPhase = "Errored"
+	// PromotionPhaseAborted denotes a Promotion that was stopped before it could
+	// complete, either because a user requested that it be aborted or because
+	// it exceeded the Stage's promotion timeout. Further information can be
+	// found in the Promotion's status.
+	PromotionPhaseAborted PromotionPhase = "Aborted"
+	// PromotionPhaseSuperseded denotes a Promotion that was never executed
+	// because a newer Promotion to the same Stage was queued behind it while
+	// it was Pending and the Stage is configured to supersede stale Promotions.
+	PromotionPhaseSuperseded PromotionPhase = "Superseded"
 )
 
 // IsTerminal returns true if the PromotionPhase is a terminal one.
 func (p *PromotionPhase) IsTerminal() bool {
-	return *p == PromotionPhaseSucceeded || *p == PromotionPhaseErrored
+	return *p == PromotionPhaseSucceeded ||
+		*p == PromotionPhaseErrored ||
+		*p == PromotionPhaseAborted ||
+		*p == PromotionPhaseSuperseded
 }
 
 //+kubebuilder:resource:shortName={promo,promos}
@@ -77,6 +89,11 @@ type PromotionSpec struct {
 	//
 	//+kubebuilder:validation:MinLength=1
 	Freight string `json:"freight"`
+	// Rollback indicates that this Promotion returns the Stage to Freight from
+	// the Stage's own history. Such Freight need not be qualified for any of the
+	// Stage's upstream Stages. Creating a rollback Promotion requires permission
+	// to use the custom rollback verb on the Stage instead of the promote verb.
+	Rollback bool `json:"rollback,omitempty"`
 }
 
 // PromotionStatus describes the current state of the transition represented by
@@ -84,10 +101,175 @@ type PromotionSpec struct {
 type PromotionStatus struct {
 	// Phase describes where the Promotion currently is in its lifecycle.
 	Phase PromotionPhase `json:"phase,omitempty"`
+	// AwaitingApproval indicates that the Promotion is Pending because it has
+	// not yet received the approvals required by its Stage. A Promotion that is
+	// awaiting approval does not hold up other Promotions to the same Stage.
+	AwaitingApproval bool `json:"awaitingApproval,omitempty"`
+	// Approvals records the users who have approved this Promotion, in the
+	// order in which they did so.
+	Approvals []PromotionApproval `json:"approvals,omitempty"`
+	// StartedAt is the time at which the Promotion left the Stage's queue and
+	// began executing. A Stage's promotion timeout is measured from this time.
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
 	// Error describes any errors that are preventing the Promotion controller
 	// from executing this Promotion. i.e. If the Phase field has a value of
 	// Failed, this field can be expected to explain why.
 	Error string `json:"error,omitempty"`
+	// PullRequests contains information about pull requests that were opened
+	// while executing this Promotion.
+	PullRequests []PullRequestInfo `json:"pullRequests,omitempty"`
+	// SigningKeyID identifies the key with which commits made while executing
+	// this Promotion were signed. For GPG keys, this is the key ID. For SSH
+	// keys, this is the SHA256 fingerprint of the public key.
+	SigningKeyID string `json:"signingKeyID,omitempty"`
+	// ArgoCDAppSyncs contains information about sync operations that were
+	// initiated on Argo CD Applications while executing this Promotion.
+	ArgoCDAppSyncs []ArgoCDAppSyncInfo `json:"argoCDAppSyncs,omitempty"`
+	// HTTPWebhookResults contains information about HTTP webhooks that were
+	// executed while executing this Promotion.
+	HTTPWebhookResults []HTTPWebhookResult `json:"httpWebhookResults,omitempty"`
+	// Steps contains the results of the individual steps executed by this
+	// Promotion's promotion mechanisms, in the order in which they were first
+	// executed. Each step is the work done by a single promotion mechanism on a
+	// single target, such as a Git repository or an Argo CD Application.
+	Steps []PromotionStepResult `json:"steps,omitempty"`
+	// Attempts records each attempt at executing this Promotion that has
+	// ended, in the order in which they occurred. Every attempt but the last
+	// one necessarily failed. A Promotion is only attempted more than once if
+	// its Stage specifies a retry policy.
+	Attempts []PromotionAttempt `json:"attempts,omitempty"`
+	// SupersededBy is the name of the newer Promotion that superseded this one.
+	// This field is only set when the Phase field has a value of Superseded.
+	SupersededBy string `json:"supersededBy,omitempty"`
+}
+
+// PromotionApproval describes a single user's approval of a Promotion.
+type PromotionApproval struct {
+	// Username identifies the user who approved the Promotion.
+	Username string `json:"username"`
+	// ApprovedAt is the time at which the user approved the Promotion.
+	ApprovedAt *metav1.Time `json:"approvedAt,omitempty"`
+}
+
+// PromotionAttempt describes a single attempt at executing a Promotion.
+type PromotionAttempt struct {
+	// Phase is the phase in which the attempt ended. i.e. Succeeded or Errored.
+	Phase PromotionPhase `json:"phase,omitempty"`
+	// FinishedAt is the time at which the attempt ended.
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty"`
+	// Class identifies the promotion mechanism responsible for the failure, if
+	// the attempt failed and the failure could be attributed to one.
+	Class PromotionErrorClass `json:"class,omitempty"`
+	// Error describes the failure, if the attempt failed.
+	Error string `json:"error,omitempty"`
+}
+
+// WithPhase returns a copy of the PromotionStatus with the specified phase.
+func (p *PromotionStatus) WithPhase(phase PromotionPhase) *PromotionStatus {
+	status := p.DeepCopy()
+	status.Phase = phase
+	return status
+}
+
+// CurrentAttempt returns the number of the attempt at executing the Promotion
+// that is underway. Attempts are numbered starting from 1. Promotion
+// mechanisms use this to give anything they create on behalf of the Promotion,
+// such as Jobs and pull request branches, a name that is unique to the
+// attempt.
+func (p *PromotionStatus) CurrentAttempt() int {
+	return len(p.Attempts) + 1
+}
+
+// PullRequestState represents the state of a pull request.
+type PullRequestState string
+
+const (
+	// PullRequestStateOpen denotes a pull request that has been neither merged
+	// nor closed yet.
+	PullRequestStateOpen PullRequestState = "Open"
+	// PullRequestStateMerged denotes a pull request that has been merged.
+	PullRequestStateMerged PullRequestState = "Merged"
+	// PullRequestStateClosed denotes a pull request that has been closed without
+	// being merged.
+	PullRequestStateClosed PullRequestState = "Closed"
+)
+
+// PullRequestInfo describes a pull request that was opened by a Promotion.
+type PullRequestInfo struct {
+	// RepoURL is the URL of the repository in which the pull request was opened.
+	RepoURL string `json:"repoURL"`
+	// SourceBranch is the branch containing the changes proposed by the pull
+	// request.
+	SourceBranch string `json:"sourceBranch"`
+	// TargetBranch is the branch the pull request proposes to merge changes
+	// into.
+	TargetBranch string `json:"targetBranch"`
+	// Number is the number assigned to the pull request by the Git hosting
+	// provider.
+	Number int64 `json:"number"`
+	// URL is the URL of the pull request.
+	URL string `json:"url"`
+	// State is the most recently observed state of the pull request.
+	State PullRequestState `json:"state"`
+	// MergeCommit is the ID of the commit that resulted from merging the pull
+	// request. It is only set once the pull request has been merged.
+	MergeCommit string `json:"mergeCommit,omitempty"`
+}
+
+// ArgoCDAppSyncInfo describes a sync operation that was initiated on an Argo CD
+// Application by a Promotion.
+type ArgoCDAppSyncInfo struct {
+	// Namespace is the namespace of the Argo CD Application.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Argo CD Application.
+	Name string `json:"name"`
+	// Phase is the most recently observed phase of the sync operation. It is
+	// only set if the Promotion waits for the sync operation to complete.
+	Phase string `json:"phase,omitempty"`
+	// Message is the most recently observed message describing the sync
+	// operation's progress or outcome.
+	Message string `json:"message,omitempty"`
+}
+
+// HTTPWebhookResult describes the outcome of an HTTP webhook executed by a
+// Promotion.
+type HTTPWebhookResult struct {
+	// Name is the name of the webhook.
+	Name string `json:"name"`
+	// Attempts is the number of times the webhook's request has been made.
+	Attempts int32 `json:"attempts,omitempty"`
+	// Succeeded indicates whether the webhook's request has succeeded.
+	Succeeded bool `json:"succeeded,omitempty"`
+	// StatusCode is the status code of the most recent response.
+	StatusCode int32 `json:"statusCode,omitempty"`
+	// Response is the body of the most recent response. Long responses are
+	// truncated.
+	Response string `json:"response,omitempty"`
+	// Message explains why the most recent attempt failed, if it did.
+	Message string `json:"message,omitempty"`
+}
+
+// PromotionStepResult describes the outcome of a single step of a Promotion.
+// A step is the work done by a single promotion mechanism on a single target.
+type PromotionStepResult struct {
+	// Mechanism is the name of the promotion mechanism that executed the step.
+	Mechanism string `json:"mechanism"`
+	// Target identifies what the step acted upon. e.g. The URL of a Git
+	// repository or the namespace and name of an Argo CD Application.
+	Target string `json:"target,omitempty"`
+	// StartedAt is the time at which the step was first executed.
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// FinishedAt is the time at which the step completed, whether successfully
+	// or unsuccessfully. It is not set for as long as the step is in progress.
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty"`
+	// Commit is the ID of the commit, if any, that the step pushed to its
+	// target Git repository.
+	Commit string `json:"commit,omitempty"`
+	// Error explains why the step failed, if it did.
+	Error string `json:"error,omitempty"`
+	// Message provides additional detail about the outcome of the step. e.g.
+	// Why a step that succeeded did not push a commit or open a pull request.
+	Message string `json:"message,omitempty"`
 }
 
 //+kubebuilder:object:root=true
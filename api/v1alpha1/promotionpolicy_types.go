@@ -1,6 +1,10 @@
 package v1alpha1
 
 import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -28,6 +32,78 @@ type PromotionPolicy struct {
 	// of other, upstream Stages. This allows users to define Stages that are
 	// automatically updated as soon as new materials are detected.
 	EnableAutoPromotion bool `json:"enableAutoPromotion,omitempty"`
+	// AutoPromotionWindows, if specified, restricts auto-promotion into the
+	// Stage to times falling within at least one of the windows described. This
+	// has no effect on Promotions created manually.
+	AutoPromotionWindows []PromotionWindow `json:"autoPromotionWindows,omitempty"`
+	// FreezeWindows describes periods (e.g. change freezes) during which no
+	// Promotions into the Stage may be created, whether automatically or
+	// manually. A manually created Promotion may override an active freeze by
+	// bearing the kargo.akuity.io/break-glass annotation.
+	FreezeWindows []PromotionWindow `json:"freezeWindows,omitempty"`
+}
+
+// PromotionWindow describes a recurring period of time.
+type PromotionWindow struct {
+	// Schedule is a standard, five-field cron expression describing when the
+	// window opens. e.g. "0 12 * * 5" for every Friday at noon.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+	// Duration is how long the window stays open each time it opens. e.g. "12h"
+	Duration metav1.Duration `json:"duration"`
+	// TimeZone is the IANA name of the time zone (e.g. "America/New_York") in
+	// which the Schedule is interpreted. If not specified, UTC is assumed.
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// IsOpen returns a bool indicating whether the window is open at the given
+// time. An error is returned if the window's Schedule or TimeZone is invalid.
+func (p *PromotionWindow) IsOpen(t time.Time) (bool, error) {
+	loc := time.UTC
+	if p.TimeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(p.TimeZone); err != nil {
+			return false, errors.Wrapf(err, "invalid time zone %q", p.TimeZone)
+		}
+	}
+	schedule, err := cron.ParseStandard(p.Schedule)
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid schedule %q", p.Schedule)
+	}
+	// The window is open if it last opened no more than Duration ago. i.e. If
+	// it opens at least once after t-Duration and no later than t.
+	opened := schedule.Next(t.In(loc).Add(-p.Duration.Duration))
+	return !opened.IsZero() && !opened.After(t), nil
+}
+
+// IsFrozen returns a bool indicating whether any of the PromotionPolicy's
+// FreezeWindows is open at the given time.
+func (p *PromotionPolicy) IsFrozen(t time.Time) (bool, error) {
+	for i := range p.FreezeWindows {
+		open, err := p.FreezeWindows[i].IsOpen(t)
+		if err != nil || open {
+			return open, err
+		}
+	}
+	return false, nil
+}
+
+// IsAutoPromotionWindowOpen returns a bool indicating whether the
+// PromotionPolicy permits auto-promotion at the given time as far as its
+// AutoPromotionWindows are concerned. If no AutoPromotionWindows are
+// specified, auto-promotion is permitted at any time.
+func (p *PromotionPolicy) IsAutoPromotionWindowOpen(t time.Time) (bool, error) {
+	if len(p.AutoPromotionWindows) == 0 {
+		return true, nil
+	}
+	for i := range p.AutoPromotionWindows {
+		open, err := p.AutoPromotionWindows[i].IsOpen(t)
+		if err != nil || open {
+			return open, err
+		}
+	}
+	return false, nil
 }
 
 //+kubebuilder:object:root=true
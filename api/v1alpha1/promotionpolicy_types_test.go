@@ -0,0 +1,243 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPromotionWindowIsOpen(t *testing.T) {
+	// A Friday afternoon
+	fridayAfternoon := time.Date(2023, time.October, 13, 15, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name       string
+		window     PromotionWindow
+		t          time.Time
+		assertions func(bool, error)
+	}{
+		{
+			name: "invalid schedule",
+			window: PromotionWindow{
+				Schedule: "bogus",
+				Duration: metav1.Duration{Duration: time.Hour},
+			},
+			t: fridayAfternoon,
+			assertions: func(_ bool, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "invalid schedule")
+			},
+		},
+		{
+			name: "invalid time zone",
+			window: PromotionWindow{
+				Schedule: "0 12 * * 5",
+				Duration: metav1.Duration{Duration: time.Hour},
+				TimeZone: "Bogus/Zone",
+			},
+			t: fridayAfternoon,
+			assertions: func(_ bool, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "invalid time zone")
+			},
+		},
+		{
+			name: "open",
+			window: PromotionWindow{
+				Schedule: "0 12 * * 5",
+				Duration: metav1.Duration{Duration: 12 * time.Hour},
+			},
+			t: fridayAfternoon,
+			assertions: func(open bool, err error) {
+				require.NoError(t, err)
+				require.True(t, open)
+			},
+		},
+		{
+			name: "open at the instant it opens",
+			window: PromotionWindow{
+				Schedule: "0 15 * * 5",
+				Duration: metav1.Duration{Duration: time.Hour},
+			},
+			t: fridayAfternoon,
+			assertions: func(open bool, err error) {
+				require.NoError(t, err)
+				require.True(t, open)
+			},
+		},
+		{
+			name: "closed at the instant it closes",
+			window: PromotionWindow{
+				Schedule: "0 12 * * 5",
+				Duration: metav1.Duration{Duration: 3 * time.Hour},
+			},
+			t: fridayAfternoon,
+			assertions: func(open bool, err error) {
+				require.NoError(t, err)
+				require.False(t, open)
+			},
+		},
+		{
+			name: "not yet open",
+			window: PromotionWindow{
+				Schedule: "0 12 * * 6",
+				Duration: metav1.Duration{Duration: 48 * time.Hour},
+			},
+			t: fridayAfternoon,
+			assertions: func(open bool, err error) {
+				require.NoError(t, err)
+				require.False(t, open)
+			},
+		},
+		{
+			name: "open in another time zone",
+			window: PromotionWindow{
+				// 15:00 UTC is 11:00 in New York during daylight saving time
+				Schedule: "0 11 * * 5",
+				Duration: metav1.Duration{Duration: time.Hour},
+				TimeZone: "America/New_York",
+			},
+			t: fridayAfternoon,
+			assertions: func(open bool, err error) {
+				require.NoError(t, err)
+				require.True(t, open)
+			},
+		},
+		{
+			name: "never opens",
+			window: PromotionWindow{
+				Schedule: "0 0 30 2 *",
+				Duration: metav1.Duration{Duration: time.Hour},
+			},
+			t: fridayAfternoon,
+			assertions: func(open bool, err error) {
+				require.NoError(t, err)
+				require.False(t, open)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(testCase.window.IsOpen(testCase.t))
+		})
+	}
+}
+
+func TestPromotionPolicyIsFrozen(t *testing.T) {
+	now := time.Date(2023, time.October, 13, 15, 0, 0, 0, time.UTC)
+	openWindow := PromotionWindow{
+		Schedule: "0 12 * * 5",
+		Duration: metav1.Duration{Duration: 12 * time.Hour},
+	}
+	closedWindow := PromotionWindow{
+		Schedule: "0 12 * * 1",
+		Duration: metav1.Duration{Duration: time.Hour},
+	}
+	testCases := []struct {
+		name       string
+		policy     PromotionPolicy
+		assertions func(bool, error)
+	}{
+		{
+			name: "no freeze windows",
+			assertions: func(frozen bool, err error) {
+				require.NoError(t, err)
+				require.False(t, frozen)
+			},
+		},
+		{
+			name: "no open freeze windows",
+			policy: PromotionPolicy{
+				FreezeWindows: []PromotionWindow{closedWindow},
+			},
+			assertions: func(frozen bool, err error) {
+				require.NoError(t, err)
+				require.False(t, frozen)
+			},
+		},
+		{
+			name: "open freeze window",
+			policy: PromotionPolicy{
+				FreezeWindows: []PromotionWindow{closedWindow, openWindow},
+			},
+			assertions: func(frozen bool, err error) {
+				require.NoError(t, err)
+				require.True(t, frozen)
+			},
+		},
+		{
+			name: "invalid freeze window",
+			policy: PromotionPolicy{
+				FreezeWindows: []PromotionWindow{{Schedule: "bogus"}},
+			},
+			assertions: func(_ bool, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(testCase.policy.IsFrozen(now))
+		})
+	}
+}
+
+func TestPromotionPolicyIsAutoPromotionWindowOpen(t *testing.T) {
+	now := time.Date(2023, time.October, 13, 15, 0, 0, 0, time.UTC)
+	openWindow := PromotionWindow{
+		Schedule: "0 12 * * 5",
+		Duration: metav1.Duration{Duration: 12 * time.Hour},
+	}
+	closedWindow := PromotionWindow{
+		Schedule: "0 12 * * 1",
+		Duration: metav1.Duration{Duration: time.Hour},
+	}
+	testCases := []struct {
+		name       string
+		policy     PromotionPolicy
+		assertions func(bool, error)
+	}{
+		{
+			name: "no auto-promotion windows",
+			assertions: func(open bool, err error) {
+				require.NoError(t, err)
+				require.True(t, open)
+			},
+		},
+		{
+			name: "no open auto-promotion windows",
+			policy: PromotionPolicy{
+				AutoPromotionWindows: []PromotionWindow{closedWindow},
+			},
+			assertions: func(open bool, err error) {
+				require.NoError(t, err)
+				require.False(t, open)
+			},
+		},
+		{
+			name: "open auto-promotion window",
+			policy: PromotionPolicy{
+				AutoPromotionWindows: []PromotionWindow{closedWindow, openWindow},
+			},
+			assertions: func(open bool, err error) {
+				require.NoError(t, err)
+				require.True(t, open)
+			},
+		},
+		{
+			name: "invalid auto-promotion window",
+			policy: PromotionPolicy{
+				AutoPromotionWindows: []PromotionWindow{{Schedule: "bogus"}},
+			},
+			assertions: func(_ bool, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(testCase.policy.IsAutoPromotionWindowOpen(now))
+		})
+	}
+}
@@ -1,17 +1,21 @@
 package v1alpha1
 
 import (
+	"fmt"
 	"os"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// +kubebuilder:validation:Enum={Image,Tag}
+// +kubebuilder:validation:Enum={Image,Tag,Digest,ImageAndDigest}
 type ImageUpdateValueType string
 
 const (
-	ImageUpdateValueTypeImage ImageUpdateValueType = "Image"
-	ImageUpdateValueTypeTag   ImageUpdateValueType = "Tag"
+	ImageUpdateValueTypeImage          ImageUpdateValueType = "Image"
+	ImageUpdateValueTypeTag            ImageUpdateValueType = "Tag"
+	ImageUpdateValueTypeDigest         ImageUpdateValueType = "Digest"
+	ImageUpdateValueTypeImageAndDigest ImageUpdateValueType = "ImageAndDigest"
 )
 
 type HealthState string
@@ -95,6 +99,61 @@ type StageSpec struct {
 	// single upstream Stage where they may otherwise have subscribed to multiple
 	// upstream Stages.
 	PromotionMechanisms *PromotionMechanisms `json:"promotionMechanisms,omitempty"`
+	// Approval describes the approvals that a Promotion must receive before it
+	// may transition the Stage into new Freight. This applies equally to
+	// Promotions created by users and to those created automatically. This
+	// field is optional. If not specified, Promotions do not require approval.
+	Approval *ApprovalRequirement `json:"approval,omitempty"`
+	// SupersedeStalePromotions indicates whether Pending Promotions to the Stage
+	// should be superseded by newer ones. When true, only the most recently
+	// created of the Stage's Pending Promotions remains in the Stage's queue
+	// and all older Pending Promotions are moved to the terminal Superseded
+	// phase instead of being executed. This is useful for Stages that receive
+	// new Freight more quickly than they can be promoted. This field defaults
+	// to false.
+	SupersedeStalePromotions bool `json:"supersedeStalePromotions,omitempty"`
+}
+
+// ApprovalRequirement describes the approvals that a Promotion must receive
+// before it may be executed.
+type ApprovalRequirement struct {
+	// RequiredApprovals is the number of distinct users who must approve a
+	// Promotion before it may be executed.
+	//
+	//+kubebuilder:validation:Minimum=1
+	RequiredApprovals int32 `json:"requiredApprovals"`
+	// Groups lists the OpenID Connect groups whose members may approve
+	// Promotions. A user must be a member of at least one of these groups for
+	// their approval to be accepted.
+	//
+	//+kubebuilder:validation:MinItems=1
+	Groups []string `json:"groups"`
+}
+
+// IsApprover returns true if a user who is a member of the specified groups
+// may approve Promotions under the ApprovalRequirement.
+func (a *ApprovalRequirement) IsApprover(groups []string) bool {
+	for _, group := range groups {
+		for _, approverGroup := range a.Groups {
+			if group == approverGroup {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsSatisfiedBy returns true if the provided approvals satisfy the
+// ApprovalRequirement. A nil ApprovalRequirement is always satisfied.
+func (a *ApprovalRequirement) IsSatisfiedBy(approvals []PromotionApproval) bool {
+	if a == nil {
+		return true
+	}
+	approvers := make(map[string]struct{}, len(approvals))
+	for _, approval := range approvals {
+		approvers[approval.Username] = struct{}{}
+	}
+	return len(approvers) >= int(a.RequiredApprovals)
 }
 
 // Subscriptions describes a Stage's sources of Freight.
@@ -128,6 +187,150 @@ type PromotionMechanisms struct {
 	// updates specified by the GitRepoUpdates field, if any, are applied BEFORE
 	// these.
 	ArgoCDAppUpdates []ArgoCDAppUpdate `json:"argoCDAppUpdates,omitempty"`
+	// FluxUpdates describes updates that should be applied to Flux resources
+	// to incorporate Freight into the Stage. This field is optional, as such
+	// actions are not required in all cases. Note that all updates specified by
+	// the GitRepoUpdates field, if any, are applied BEFORE these.
+	FluxUpdates []FluxUpdate `json:"fluxUpdates,omitempty"`
+	// KubernetesPatches describes patches that should be applied to arbitrary
+	// Kubernetes resources to incorporate Freight into the Stage. This field is
+	// optional, as such actions are not required in all cases. Note that all
+	// updates specified by the GitRepoUpdates field, if any, are applied BEFORE
+	// these.
+	KubernetesPatches []KubernetesPatch `json:"kubernetesPatches,omitempty"`
+	// Jobs describes Kubernetes Jobs that should be run in the Stage's namespace
+	// to incorporate Freight into the Stage. This field is optional, as such
+	// actions are not required in all cases. Jobs are run in order, one at a
+	// time, after all updates specified by the GitRepoUpdates,
+	// ArgoCDAppUpdates, FluxUpdates, and KubernetesPatches fields, if any, have
+	// been applied.
+	Jobs []PromotionJob `json:"jobs,omitempty"`
+	// HTTPWebhooks describes HTTP requests that should be made to external
+	// endpoints to incorporate Freight into the Stage. This field is optional,
+	// as such actions are not required in all cases. Webhooks are executed in
+	// order, AFTER all other promotion mechanisms.
+	HTTPWebhooks []HTTPWebhook `json:"httpWebhooks,omitempty"`
+	// Timeout is the maximum amount of time a Promotion may spend executing
+	// these promotion mechanisms. A Promotion that exceeds it is aborted, which
+	// allows the next Promotion in the Stage's queue to begin. e.g. "10m" or
+	// "1h30m". This field is optional. If not specified, Promotions are never
+	// timed out.
+	//
+	//+kubebuilder:validation:Type=string
+	//+kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Retry describes how a Promotion that fails while executing these
+	// promotion mechanisms should be retried. This field is optional. If not
+	// specified, a Promotion that fails is not retried.
+	Retry *PromotionRetryPolicy `json:"retry,omitempty"`
+}
+
+// GetTimeout returns the maximum amount of time a Promotion may spend executing
+// the PromotionMechanisms. A value of zero indicates no timeout.
+func (p *PromotionMechanisms) GetTimeout() time.Duration {
+	if p == nil || p.Timeout == nil {
+		return 0
+	}
+	return p.Timeout.Duration
+}
+
+// PromotionErrorClass identifies the promotion mechanism responsible for a
+// failed Promotion.
+//
+// +kubebuilder:validation:Enum={Git,ArgoCD,Flux,Kubernetes,Job,HTTPWebhook}
+type PromotionErrorClass string
+
+const (
+	// PromotionErrorClassGit denotes a failure of a Git-based promotion
+	// mechanism, e.g. a failure to clone, commit to, or push to a Git
+	// repository, or to open a pull request.
+	PromotionErrorClassGit PromotionErrorClass = "Git"
+	// PromotionErrorClassArgoCD denotes a failure to update or sync an Argo CD
+	// Application.
+	PromotionErrorClassArgoCD PromotionErrorClass = "ArgoCD"
+	// PromotionErrorClassFlux denotes a failure to update or reconcile a Flux
+	// resource.
+	PromotionErrorClassFlux PromotionErrorClass = "Flux"
+	// PromotionErrorClassKubernetes denotes a failure to patch a Kubernetes
+	// resource.
+	PromotionErrorClassKubernetes PromotionErrorClass = "Kubernetes"
+	// PromotionErrorClassJob denotes a failure to create a Kubernetes Job or a
+	// Job that did not complete successfully.
+	PromotionErrorClassJob PromotionErrorClass = "Job"
+	// PromotionErrorClassHTTPWebhook denotes a failure to execute an HTTP
+	// webhook.
+	PromotionErrorClassHTTPWebhook PromotionErrorClass = "HTTPWebhook"
+)
+
+const (
+	defaultPromotionRetryBackoff    = 10 * time.Second
+	defaultPromotionRetryMaxBackoff = 5 * time.Minute
+)
+
+// PromotionRetryPolicy describes how a Promotion that fails while executing a
+// Stage's promotion mechanisms should be retried. Every attempt executes the
+// promotion mechanisms from the beginning.
+type PromotionRetryPolicy struct {
+	// MaxAttempts is the maximum number of times the promotion mechanisms will
+	// be executed on behalf of a single Promotion, including the first attempt.
+	// The Promotion is marked as Errored once this many attempts have failed.
+	//
+	//+kubebuilder:validation:Minimum=1
+	MaxAttempts int32 `json:"maxAttempts"`
+	// Backoff is the amount of time to wait after the first failed attempt
+	// before trying again. The wait doubles after each subsequent failed
+	// attempt. e.g. "30s" or "2m". This field is optional. If not specified,
+	// a value of "10s" is used.
+	//
+	//+kubebuilder:validation:Type=string
+	//+kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+	// MaxBackoff is the maximum amount of time to wait between attempts. This
+	// field is optional. If not specified, a value of "5m" is used.
+	//
+	//+kubebuilder:validation:Type=string
+	//+kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+	// RetryOn lists the classes of failure that are retryable. This field is
+	// optional. If not specified, all failures are retryable, including those
+	// that cannot be attributed to any one promotion mechanism, such as the
+	// Freight not being found.
+	RetryOn []PromotionErrorClass `json:"retryOn,omitempty"`
+}
+
+// GetBackoff returns the amount of time to wait after the specified number of
+// failed attempts before trying again.
+func (r *PromotionRetryPolicy) GetBackoff(failedAttempts int) time.Duration {
+	backoff := defaultPromotionRetryBackoff
+	if r.Backoff != nil {
+		backoff = r.Backoff.Duration
+	}
+	maxBackoff := defaultPromotionRetryMaxBackoff
+	if r.MaxBackoff != nil {
+		maxBackoff = r.MaxBackoff.Duration
+	}
+	for i := 1; i < failedAttempts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		return maxBackoff
+	}
+	return backoff
+}
+
+// IsRetryable returns true if a failure of the specified class may be retried
+// under the PromotionRetryPolicy. An empty class denotes a failure that cannot
+// be attributed to any one promotion mechanism.
+func (r *PromotionRetryPolicy) IsRetryable(class PromotionErrorClass) bool {
+	if len(r.RetryOn) == 0 {
+		return true
+	}
+	for _, retryOn := range r.RetryOn {
+		if retryOn == class {
+			return true
+		}
+	}
+	return false
 }
 
 // GitRepoUpdate describes updates that should be applied to a Git repository
@@ -158,14 +361,73 @@ type GitRepoUpdate struct {
 	//+kubebuilder:validation:Pattern=`^\w+([-/]\w+)*$`
 	WriteBranch string `json:"writeBranch"`
 	// Render describes how to use Kargo Render to incorporate Freight into the
-	// Stage. This is mutually exclusive with the Kustomize and Helm fields.
+	// Stage. This is mutually exclusive with the Kustomize, Helm, HelmTemplate,
+	// and YAMLUpdates fields.
 	Render *KargoRenderPromotionMechanism `json:"render,omitempty"`
 	// Kustomize describes how to use Kustomize to incorporate Freight into the
-	// Stage. This is mutually exclusive with the Render and Helm fields.
+	// Stage. This is mutually exclusive with the Render, Helm, HelmTemplate, and
+	// YAMLUpdates fields.
 	Kustomize *KustomizePromotionMechanism `json:"kustomize,omitempty"`
 	// Helm describes how to use Helm to incorporate Freight into the Stage. This
-	// is mutually exclusive with the Render and Kustomize fields.
+	// is mutually exclusive with the Render, Kustomize, HelmTemplate, and
+	// YAMLUpdates fields.
 	Helm *HelmPromotionMechanism `json:"helm,omitempty"`
+	// HelmTemplate describes how to use `helm template` to render a Helm chart
+	// found in the ReadBranch into plain manifests that are then written to the
+	// WriteBranch. When this is specified, the ReadBranch must also be specified
+	// and must differ from the WriteBranch. This is mutually exclusive with the
+	// Render, Kustomize, Helm, and YAMLUpdates fields.
+	HelmTemplate *HelmTemplatePromotionMechanism `json:"helmTemplate,omitempty"`
+	// YAMLUpdates describes updates to arbitrary keys in arbitrary YAML files
+	// that can be used to incorporate Freight into the Stage. This is mutually
+	// exclusive with the Render, Kustomize, Helm, and HelmTemplate fields.
+	YAMLUpdates []YAMLUpdate `json:"yamlUpdates,omitempty"`
+	// PullRequest, when specified, indicates that changes should not be pushed
+	// directly to the branch specified by the WriteBranch field. Instead, they
+	// are pushed to a new branch and a pull request is opened to merge that
+	// branch into the WriteBranch. A Promotion will not complete until the pull
+	// request has been merged or closed. This is not supported in conjunction
+	// with the Render field.
+	PullRequest *PullRequestPromotionMechanism `json:"pullRequest,omitempty"`
+	// CommitMessageTemplate is an optional Go template used to construct the
+	// message of any commit made to the repository. The template has access to
+	// the Project (namespace), Stage, Promotion, and Freight names, the
+	// Commits, Images, and Charts that make up the Freight, and a summary of
+	// the Changes that were made. When not specified, a default message
+	// summarizing the changes is used. Regardless of the template, Kargo-Freight,
+	// Kargo-Stage, and Kargo-Promotion trailers are always appended to the
+	// message.
+	CommitMessageTemplate string `json:"commitMessageTemplate,omitempty"`
+}
+
+// GitProvider identifies a Git hosting provider.
+//
+// +kubebuilder:validation:Enum={github,gitlab,gitea}
+type GitProvider string
+
+const (
+	GitProviderGitHub GitProvider = "github"
+	GitProviderGitLab GitProvider = "gitlab"
+	GitProviderGitea  GitProvider = "gitea"
+)
+
+// PullRequestPromotionMechanism describes how to open a pull request for
+// changes that are to be incorporated into a Stage.
+type PullRequestPromotionMechanism struct {
+	// Provider identifies the Git hosting provider of the repository. This field
+	// is optional when the repository is hosted on github.com, gitlab.com, or
+	// gitea.com, in which case the provider is inferred from the repository
+	// URL.
+	//
+	//+kubebuilder:validation:Optional
+	Provider GitProvider `json:"provider,omitempty"`
+	// APIURL is the base URL of the Git hosting provider's API. This field is
+	// optional. When not specified, a URL is derived from the repository URL
+	// using the provider's conventions.
+	//
+	//+kubebuilder:validation:Optional
+	//+kubebuilder:validation:Pattern=`^https?://(\w+([\.-]\w+)*@)?\w+([\.-]\w+)*(:[\d]+)?(/.*)?$`
+	APIURL string `json:"apiURL,omitempty"`
 }
 
 // KargoRenderPromotionMechanism describes how to use Kargo Render to
@@ -180,6 +442,33 @@ type KustomizePromotionMechanism struct {
 	//
 	//+kubebuilder:validation:MinItems=1
 	Images []KustomizeImageUpdate `json:"images"`
+	// Build, when specified, indicates that after images have been updated,
+	// `kustomize build` should be run and that only the resulting flat
+	// manifests should be written to the WriteBranch. When this is specified,
+	// the ReadBranch must also be specified and must differ from the
+	// WriteBranch. This field is optional.
+	//
+	//+kubebuilder:validation:Optional
+	Build *KustomizeBuild `json:"build,omitempty"`
+}
+
+// KustomizeBuild describes how to run `kustomize build` to render flat
+// manifests.
+type KustomizeBuild struct {
+	// Path specifies a path to the overlay that is to be built. This is a
+	// required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:Pattern=^[\w-\.]+(/[\w-\.]+)*$
+	Path string `json:"path"`
+	// OutputPath specifies a path in the WriteBranch to which built manifests
+	// should be written. When not specified, manifests are written to the root
+	// of the WriteBranch. In either case, the built manifests replace all
+	// existing contents of the WriteBranch.
+	//
+	//+kubebuilder:validation:Optional
+	//+kubebuilder:validation:Pattern=^[\w-\.]+(/[\w-\.]+)*$
+	OutputPath string `json:"outputPath,omitempty"`
 }
 
 // KustomizeImageUpdate describes how to run `kustomize edit set image`
@@ -195,6 +484,10 @@ type KustomizeImageUpdate struct {
 	//+kubebuilder:validation:MinLength=1
 	//+kubebuilder:validation:Pattern=^[\w-\.]+(/[\w-\.]+)*$
 	Path string `json:"path"`
+	// UseDigest specifies whether the image should be referenced by the digest
+	// of its manifest instead of by its tag. If the digest is unknown, no change
+	// is made.
+	UseDigest bool `json:"useDigest,omitempty"`
 }
 
 // HelmPromotionMechanism describes how to use Helm to incorporate Freight into
@@ -229,8 +522,11 @@ type HelmImageUpdate struct {
 	Key string `json:"key"`
 	// Value specifies the new value for the specified key in the specified Helm
 	// values file. Valid values are "Image", which replaces the value of the
-	// specified key with the entire <image name>:<tag>, or "Tag" which replaces
-	// the value of the specified with just the new tag. This is a required field.
+	// specified key with the entire <image name>:<tag>, "Tag" which replaces
+	// the value of the specified with just the new tag, "Digest", which replaces
+	// the value of the specified key with just the new digest, or
+	// "ImageAndDigest", which replaces the value of the specified key with the
+	// entire <image name>@<digest>. This is a required field.
 	Value ImageUpdateValueType `json:"value"`
 }
 
@@ -255,6 +551,134 @@ type HelmChartDependencyUpdate struct {
 	ChartPath string `json:"chartPath"`
 }
 
+// HelmTemplatePromotionMechanism describes how to use `helm template` to render
+// a Helm chart into plain manifests to incorporate Freight into a Stage.
+type HelmTemplatePromotionMechanism struct {
+	// ChartPath is the path to the chart that is to be rendered. If the chart
+	// has dependencies matching any of the Freight's charts, the versions of
+	// those dependencies are updated prior to rendering. This is a required
+	// field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:Pattern=^[\w-\.]+(/[\w-\.]+)*$
+	ChartPath string `json:"chartPath"`
+	// ReleaseName is the release name to use when rendering the chart. This is a
+	// required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	ReleaseName string `json:"releaseName"`
+	// Namespace is the namespace to use when rendering the chart. This field is
+	// optional.
+	//
+	//+kubebuilder:validation:Optional
+	Namespace string `json:"namespace,omitempty"`
+	// ValuesFiles specifies paths to Helm values files that should be used when
+	// rendering the chart. Files are applied in the order specified. This field
+	// is optional.
+	//
+	//+kubebuilder:validation:Optional
+	ValuesFiles []string `json:"valuesFiles,omitempty"`
+	// Images describes how specific image versions can be incorporated into the
+	// values used when rendering the chart. This field is optional.
+	//
+	//+kubebuilder:validation:Optional
+	Images []HelmTemplateImageUpdate `json:"images,omitempty"`
+	// OutputPath specifies a path in the WriteBranch to which rendered manifests
+	// should be written. When not specified, manifests are written to the root
+	// of the WriteBranch. In either case, the rendered manifests replace all
+	// existing contents of the WriteBranch.
+	//
+	//+kubebuilder:validation:Optional
+	//+kubebuilder:validation:Pattern=^[\w-\.]+(/[\w-\.]+)*$
+	OutputPath string `json:"outputPath,omitempty"`
+}
+
+// HelmTemplateImageUpdate describes how a specific image version can be
+// incorporated into the values used when rendering a Helm chart.
+type HelmTemplateImageUpdate struct {
+	// Image specifies a container image (without tag). This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+	// Key specifies a key within the chart's values that is to be set. This is a
+	// required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+	// Value specifies the new value for the specified key. Valid values are
+	// "Image", which sets the value of the specified key to the entire
+	// <image name>:<tag>, "Tag" which sets the value of the specified key to
+	// just the new tag, "Digest", which sets the value of the specified key to
+	// just the new digest, or "ImageAndDigest", which sets the value of the
+	// specified key to the entire <image name>@<digest>. This is a required
+	// field.
+	Value ImageUpdateValueType `json:"value"`
+}
+
+// YAMLUpdateValueType identifies the value from a piece of Freight that is to
+// be written to a key in a YAML file.
+//
+// +kubebuilder:validation:Enum={Image,Tag,Digest,CommitID,ChartVersion}
+type YAMLUpdateValueType string
+
+const (
+	YAMLUpdateValueTypeImage        YAMLUpdateValueType = "Image"
+	YAMLUpdateValueTypeTag          YAMLUpdateValueType = "Tag"
+	YAMLUpdateValueTypeDigest       YAMLUpdateValueType = "Digest"
+	YAMLUpdateValueTypeCommitID     YAMLUpdateValueType = "CommitID"
+	YAMLUpdateValueTypeChartVersion YAMLUpdateValueType = "ChartVersion"
+)
+
+// YAMLUpdate describes how a value taken from a piece of Freight can be written
+// to a specific key in a specific YAML file.
+type YAMLUpdate struct {
+	// File specifies a path to the YAML file that is to be updated. This is a
+	// required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:Pattern=^[\w-\.]+(/[\w-\.]+)*$
+	File string `json:"file"`
+	// Key specifies a key within the YAML file that is to be updated. Keys are of
+	// the form <key 0>.<key 1>...<key n>. Integers may be used as keys in cases
+	// where a specific node needs to be selected from a sequence. This is a
+	// required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+	// Value specifies the new value for the specified key in the specified YAML
+	// file. Valid values are "Image", which replaces the value of the specified
+	// key with the entire <image name>:<tag>, "Tag", which replaces the value of
+	// the specified key with just the image's tag, "Digest", which replaces the
+	// value of the specified key with just the image's digest, "CommitID", which
+	// replaces the value of the specified key with the ID of a Git commit, or
+	// "ChartVersion", which replaces the value of the specified key with the
+	// version of a Helm chart. This is a required field.
+	Value YAMLUpdateValueType `json:"value"`
+	// Image specifies a container image (without tag) whose tag or digest is to
+	// be used. This field is required when the Value field is "Image", "Tag", or
+	// "Digest".
+	//
+	//+kubebuilder:validation:Optional
+	Image string `json:"image,omitempty"`
+	// GitRepoURL specifies the URL of a Git repository whose commit ID is to be
+	// used. This field is required when the Value field is "CommitID".
+	//
+	//+kubebuilder:validation:Optional
+	GitRepoURL string `json:"gitRepoURL,omitempty"`
+	// ChartRegistryURL along with ChartName identify a Helm chart whose version
+	// is to be used. This field is required when the Value field is
+	// "ChartVersion".
+	//
+	//+kubebuilder:validation:Optional
+	ChartRegistryURL string `json:"chartRegistryURL,omitempty"`
+	// ChartName along with ChartRegistryURL identify a Helm chart whose version
+	// is to be used. This field is required when the Value field is
+	// "ChartVersion".
+	//
+	//+kubebuilder:validation:Optional
+	ChartName string `json:"chartName,omitempty"`
+}
+
 // ArgoCDAppUpdate describes updates that should be applied to an Argo CD
 // Application resources to incorporate Freight into a Stage.
 type ArgoCDAppUpdate struct {
@@ -274,6 +698,15 @@ type ArgoCDAppUpdate struct {
 	// SourceUpdates describes updates to be applied to various sources of the
 	// specified Argo CD Application resource.
 	SourceUpdates []ArgoCDSourceUpdate `json:"sourceUpdates,omitempty"`
+	// WaitForSync specifies whether the Promotion should wait for the sync
+	// operation that Kargo initiates on the Argo CD Application resource to
+	// complete. When true, the Promotion remains Running until the operation
+	// reaches a terminal state and is marked Errored if the operation fails.
+	// When false, the Promotion is considered complete as soon as the
+	// Application resource has been updated.
+	//
+	//+kubebuilder:validation:Optional
+	WaitForSync bool `json:"waitForSync,omitempty"`
 }
 
 func (a *ArgoCDAppUpdate) AppNamespaceOrDefault() string {
@@ -325,6 +758,10 @@ type ArgoCDKustomize struct {
 	//
 	//+kubebuilder:validation:MinItems=1
 	Images []string `json:"images"`
+	// UseDigest specifies whether images should be referenced by the digests of
+	// their manifests instead of by their tags. Images whose digests are unknown
+	// are left unchanged.
+	UseDigest bool `json:"useDigest,omitempty"`
 }
 
 // ArgoCDHelm describes updates to an Argo CD Application source's Helm-specific
@@ -351,12 +788,280 @@ type ArgoCDHelmImageUpdate struct {
 	Key string `json:"key"`
 	// Value specifies the new value for the specified key in the Argo CD
 	// Application's Helm parameters. Valid values are "Image", which replaces the
-	// value of the specified key with the entire <image name>:<tag>, or "Tag"
-	// which replaces the value of the specified with just the new tag. This is a
-	// required field.
+	// value of the specified key with the entire <image name>:<tag>, "Tag"
+	// which replaces the value of the specified with just the new tag, "Digest",
+	// which replaces the value of the specified key with just the new digest, or
+	// "ImageAndDigest", which replaces the value of the specified key with the
+	// entire <image name>@<digest>. This is a required field.
 	Value ImageUpdateValueType `json:"value"`
 }
 
+// FluxResourceKind identifies a kind of Flux resource that Kargo knows how to
+// update.
+//
+// +kubebuilder:validation:Enum={GitRepository,OCIRepository,HelmRelease,Kustomization}
+type FluxResourceKind string
+
+const (
+	FluxResourceKindGitRepository FluxResourceKind = "GitRepository"
+	FluxResourceKindOCIRepository FluxResourceKind = "OCIRepository"
+	FluxResourceKindHelmRelease   FluxResourceKind = "HelmRelease"
+	FluxResourceKindKustomization FluxResourceKind = "Kustomization"
+)
+
+// FluxUpdate describes updates that should be applied to a Flux resource to
+// incorporate Freight into a Stage.
+//
+// For a GitRepository, the reference is updated to point at the commit from
+// the Freight that belongs to the repository identified by the
+// GitRepository's URL. For an OCIRepository, the reference is updated to point
+// at the tag of the image from the Freight that belongs to the repository
+// identified by the OCIRepository's URL. HelmReleases and Kustomizations are
+// updated as described by the HelmRelease and Kustomization fields.
+type FluxUpdate struct {
+	// Kind is the kind of the Flux resource to be updated. This is a required
+	// field.
+	Kind FluxResourceKind `json:"kind"`
+	// Name is the name of the Flux resource to be updated. This is a required
+	// field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$
+	Name string `json:"name"`
+	// Namespace is the namespace of the Flux resource to be updated. If left
+	// unspecified, the namespace will be the value of FLUX_NAMESPACE or
+	// "flux-system".
+	//
+	//+kubebuilder:validation:Optional
+	//+kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$
+	Namespace string `json:"namespace,omitempty"`
+	// HelmRelease describes updates to a HelmRelease. It may only be specified
+	// when Kind is HelmRelease.
+	HelmRelease *FluxHelmReleaseUpdate `json:"helmRelease,omitempty"`
+	// Kustomization describes updates to a Kustomization. It may only be
+	// specified when Kind is Kustomization.
+	Kustomization *FluxKustomizationUpdate `json:"kustomization,omitempty"`
+}
+
+func (f *FluxUpdate) NamespaceOrDefault() string {
+	if f.Namespace != "" {
+		return f.Namespace
+	}
+	if envFluxNs := os.Getenv("FLUX_NAMESPACE"); envFluxNs != "" {
+		return envFluxNs
+	}
+	return "flux-system"
+}
+
+// FluxHelmReleaseUpdate describes updates to a Flux HelmRelease to incorporate
+// Freight into a Stage.
+type FluxHelmReleaseUpdate struct {
+	// ChartRegistryURL, if specified, indicates that the HelmRelease's chart
+	// version should be updated to the version, found in the Freight, of the
+	// chart from this registry whose name matches the HelmRelease's chart.
+	//
+	//+kubebuilder:validation:Optional
+	ChartRegistryURL string `json:"chartRegistryURL,omitempty"`
+	// Images describes how specific image versions can be incorporated into the
+	// HelmRelease's values.
+	Images []FluxHelmImageUpdate `json:"images,omitempty"`
+}
+
+// FluxHelmImageUpdate describes how a specific image version can be
+// incorporated into a Flux HelmRelease's values.
+type FluxHelmImageUpdate struct {
+	// Image specifies a container image (without tag). This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+	// Key specifies a key within the HelmRelease's values that is to be updated.
+	// Nested keys are separated by dots. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+	// Value specifies the new value for the specified key in the HelmRelease's
+	// values. Valid values are "Image", which replaces the value of the
+	// specified key with the entire <image name>:<tag>, "Tag" which replaces
+	// the value of the specified with just the new tag, "Digest", which replaces
+	// the value of the specified key with just the new digest, or
+	// "ImageAndDigest", which replaces the value of the specified key with the
+	// entire <image name>@<digest>. This is a required field.
+	Value ImageUpdateValueType `json:"value"`
+}
+
+// FluxKustomizationUpdate describes updates to a Flux Kustomization to
+// incorporate Freight into a Stage.
+type FluxKustomizationUpdate struct {
+	// Images specifies container images (without tags) whose tags should be
+	// overridden by the Kustomization's images field.
+	//
+	//+kubebuilder:validation:MinItems=1
+	Images []string `json:"images"`
+}
+
+// KubernetesPatchType identifies the format of a KubernetesPatch's patch.
+//
+// +kubebuilder:validation:Enum={JSON,StrategicMerge}
+type KubernetesPatchType string
+
+const (
+	// KubernetesPatchTypeJSON indicates an RFC 6902 JSON patch.
+	KubernetesPatchTypeJSON KubernetesPatchType = "JSON"
+	// KubernetesPatchTypeStrategicMerge indicates a Kubernetes strategic merge
+	// patch. Note that custom resources do not support strategic merge patches.
+	KubernetesPatchTypeStrategicMerge KubernetesPatchType = "StrategicMerge"
+)
+
+// KubernetesPatch describes a patch that should be applied to an arbitrary
+// Kubernetes resource to incorporate Freight into a Stage. The resource must
+// be annotated with kargo.akuity.io/authorized-stage: <project>:<stage> to
+// permit the patch.
+type KubernetesPatch struct {
+	// APIVersion is the API version of the resource to be patched. This is a
+	// required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the resource to be patched. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+	// Name is the name of the resource to be patched. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$
+	Name string `json:"name"`
+	// Namespace is the namespace of the resource to be patched. If left
+	// unspecified, the namespace will be the Stage's own namespace. It is
+	// ignored for cluster-scoped resources.
+	//
+	//+kubebuilder:validation:Optional
+	//+kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$
+	Namespace string `json:"namespace,omitempty"`
+	// Type is the format of the patch. This is a required field.
+	Type KubernetesPatchType `json:"type"`
+	// Patch is a Go template that, when rendered, produces the patch to be
+	// applied. The template can reference .Project, .Stage, .Promotion,
+	// .Freight, .Commits, .Images, and .Charts, and can look up values from the
+	// Freight using .ImageTag <repoURL>, .CommitID <repoURL>, and .ChartVersion
+	// <registryURL> <name>. The rendered patch must be valid JSON; values should
+	// be embedded using quote or toJson, which escape them as needed. This is a
+	// required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Patch string `json:"patch"`
+}
+
+// PromotionJob describes a Kubernetes Job that should be run to incorporate
+// Freight into a Stage. The Freight being promoted is made available to the
+// Job's containers as environment variables and as a JSON file.
+type PromotionJob struct {
+	// Name uniquely identifies the Job among the Stage's Jobs. It is also used
+	// to derive the name of the Job that is created for each Promotion. This is
+	// a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:MaxLength=40
+	//+kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	Name string `json:"name"`
+	// Template is a YAML (or JSON) representation of the spec of the Job to be
+	// run, in the same format as the spec field of a batch/v1 Job. If the Pod
+	// template does not specify a restart policy, Never is used. The Pod template
+	// may not specify a service account; the Job always runs as the default
+	// ServiceAccount of the Stage's namespace. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Template string `json:"template"`
+}
+
+// HTTPWebhook describes an HTTP request that should be made to an external
+// endpoint to incorporate Freight into a Stage.
+type HTTPWebhook struct {
+	// Name uniquely identifies the webhook among the Stage's webhooks. The
+	// outcome of the webhook is recorded in the Promotion's status under this
+	// name. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// URL is the URL to which the request should be sent. This is a required
+	// field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	//+kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+	// Method is the HTTP method of the request. If left unspecified, POST is
+	// used.
+	//
+	//+kubebuilder:validation:Optional
+	//+kubebuilder:validation:Enum={GET,POST,PUT,PATCH}
+	Method string `json:"method,omitempty"`
+	// Headers are additional headers to send with the request.
+	Headers []HTTPHeader `json:"headers,omitempty"`
+	// HeadersSecretName optionally names a Secret in the Stage's namespace. Each
+	// key in the Secret is sent as a header whose value is the corresponding
+	// value in the Secret. This is useful for sending credentials. The Secret
+	// must be labeled kargo.akuity.io/secret-type: webhook-headers.
+	//
+	//+kubebuilder:validation:Optional
+	HeadersSecretName string `json:"headersSecretName,omitempty"`
+	// Body is a Go template that, when rendered, produces the JSON body of the
+	// request. It has access to the same data and functions, including quote
+	// and toJson, as a KubernetesPatch's patch. If left unspecified, the request
+	// has no body.
+	//
+	//+kubebuilder:validation:Optional
+	Body string `json:"body,omitempty"`
+	// SuccessCriteria describes how to determine whether the request succeeded.
+	// If left unspecified, any 2xx response is considered successful.
+	SuccessCriteria *HTTPSuccessCriteria `json:"successCriteria,omitempty"`
+	// TimeoutSeconds is the number of seconds to wait for a response before
+	// considering an attempt to have failed. If left unspecified, 30 seconds is
+	// used.
+	//
+	//+kubebuilder:validation:Optional
+	//+kubebuilder:validation:Minimum=1
+	//+kubebuilder:validation:Maximum=300
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+	// Retries is the number of times a failed request will be retried before the
+	// Promotion fails. Retries are attempted each time the Promotion is
+	// re-checked, which happens approximately every 30 seconds.
+	//
+	//+kubebuilder:validation:Optional
+	//+kubebuilder:validation:Minimum=0
+	//+kubebuilder:validation:Maximum=10
+	Retries int32 `json:"retries,omitempty"`
+}
+
+// HTTPHeader describes a single HTTP header.
+type HTTPHeader struct {
+	// Name is the name of the header.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Value is the value of the header.
+	Value string `json:"value"`
+}
+
+// HTTPSuccessCriteria describes how to determine whether a request made by an
+// HTTPWebhook succeeded.
+type HTTPSuccessCriteria struct {
+	// StatusCodes are the response status codes that indicate success. If left
+	// unspecified, any 2xx status code indicates success.
+	StatusCodes []int32 `json:"statusCodes,omitempty"`
+	// JSONPath is a JSONPath expression, in the format used by kubectl (e.g.
+	// {.status}), that is evaluated against the JSON response body. If
+	// specified, the request is only considered successful if the expression
+	// evaluates to the value of the Value field.
+	//
+	//+kubebuilder:validation:Optional
+	JSONPath string `json:"jsonPath,omitempty"`
+	// Value is the value that the JSONPath expression must evaluate to for the
+	// request to be considered successful. If left unspecified, "true" is used.
+	//
+	//+kubebuilder:validation:Optional
+	Value string `json:"value,omitempty"`
+}
+
 // StageStatus describes a Stages's current and recent Freight, health, and
 // more.
 type StageStatus struct {
@@ -452,6 +1157,31 @@ type Image struct {
 	// Tag identifies a specific version of the image in the repository specified
 	// by RepoURL.
 	Tag string `json:"tag,omitempty"`
+	// Digest identifies a specific version of the image in the repository
+	// specified by RepoURL by the digest of its manifest. This field may be
+	// empty if the digest is not known.
+	Digest string `json:"digest,omitempty"`
+}
+
+// GetValue returns the value of the specified type for the Image. An empty
+// string is returned if the value type is unrecognized or if the value type
+// requires a digest and the Image's digest is unknown.
+func (i *Image) GetValue(valueType ImageUpdateValueType) string {
+	switch valueType {
+	case ImageUpdateValueTypeImage:
+		return fmt.Sprintf("%s:%s", i.RepoURL, i.Tag)
+	case ImageUpdateValueTypeTag:
+		return i.Tag
+	case ImageUpdateValueTypeDigest:
+		return i.Digest
+	case ImageUpdateValueTypeImageAndDigest:
+		if i.Digest == "" {
+			return ""
+		}
+		return fmt.Sprintf("%s@%s", i.RepoURL, i.Digest)
+	default:
+		return ""
+	}
 }
 
 // Chart describes a specific version of a Helm chart.
@@ -485,6 +1215,25 @@ type Health struct {
 	Issues []string `json:"issues,omitempty"`
 	// ArgoCDApps describes the current state of any related ArgoCD Applications.
 	ArgoCDApps []ArgoCDAppStatus `json:"argoCDApps,omitempty"`
+	// FluxResources describes the current state of any related Flux resources.
+	FluxResources []FluxResourceStatus `json:"fluxResources,omitempty"`
+}
+
+// FluxResourceStatus describes the current state of a single Flux resource.
+type FluxResourceStatus struct {
+	// Kind is the kind of the Flux resource.
+	Kind FluxResourceKind `json:"kind"`
+	// Namespace is the namespace of the Flux resource.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Flux resource.
+	Name string `json:"name"`
+	// Ready is the status ("True", "False", or "Unknown") of the Flux resource's
+	// Ready condition.
+	Ready string `json:"ready,omitempty"`
+	// Reason is the reason given by the Flux resource's Ready condition.
+	Reason string `json:"reason,omitempty"`
+	// Message is the message given by the Flux resource's Ready condition.
+	Message string `json:"message,omitempty"`
 }
 
 // ArgoCDAppStatus describes the current state of a single ArgoCD Application.
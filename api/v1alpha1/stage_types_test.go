@@ -2,8 +2,10 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestSimpleFreightStackEmpty(t *testing.T) {
@@ -149,3 +151,250 @@ func TestSimpleFreightStackPush(t *testing.T) {
 		})
 	}
 }
+
+func TestPromotionRetryPolicyGetBackoff(t *testing.T) {
+	testCases := []struct {
+		name           string
+		policy         PromotionRetryPolicy
+		failedAttempts int
+		expected       time.Duration
+	}{
+		{
+			name:           "defaults after first failure",
+			failedAttempts: 1,
+			expected:       10 * time.Second,
+		},
+		{
+			name:           "defaults after third failure",
+			failedAttempts: 3,
+			expected:       40 * time.Second,
+		},
+		{
+			name:           "defaults capped",
+			failedAttempts: 10,
+			expected:       5 * time.Minute,
+		},
+		{
+			name: "custom backoff capped",
+			policy: PromotionRetryPolicy{
+				Backoff:    &metav1.Duration{Duration: time.Minute},
+				MaxBackoff: &metav1.Duration{Duration: 3 * time.Minute},
+			},
+			failedAttempts: 3,
+			expected:       3 * time.Minute,
+		},
+		{
+			name: "backoff exceeds max backoff",
+			policy: PromotionRetryPolicy{
+				Backoff:    &metav1.Duration{Duration: time.Hour},
+				MaxBackoff: &metav1.Duration{Duration: time.Minute},
+			},
+			failedAttempts: 1,
+			expected:       time.Minute,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				testCase.policy.GetBackoff(testCase.failedAttempts),
+			)
+		})
+	}
+}
+
+func TestPromotionRetryPolicyIsRetryable(t *testing.T) {
+	testCases := []struct {
+		name     string
+		policy   PromotionRetryPolicy
+		class    PromotionErrorClass
+		expected bool
+	}{
+		{
+			name:     "no classes specified",
+			class:    "",
+			expected: true,
+		},
+		{
+			name: "class is listed",
+			policy: PromotionRetryPolicy{
+				RetryOn: []PromotionErrorClass{
+					PromotionErrorClassArgoCD,
+					PromotionErrorClassGit,
+				},
+			},
+			class:    PromotionErrorClassGit,
+			expected: true,
+		},
+		{
+			name: "class is not listed",
+			policy: PromotionRetryPolicy{
+				RetryOn: []PromotionErrorClass{PromotionErrorClassGit},
+			},
+			class:    PromotionErrorClassJob,
+			expected: false,
+		},
+		{
+			name: "unclassified failure with classes specified",
+			policy: PromotionRetryPolicy{
+				RetryOn: []PromotionErrorClass{PromotionErrorClassGit},
+			},
+			class:    "",
+			expected: false,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				testCase.policy.IsRetryable(testCase.class),
+			)
+		})
+	}
+}
+
+func TestApprovalRequirementIsApprover(t *testing.T) {
+	requirement := &ApprovalRequirement{
+		RequiredApprovals: 1,
+		Groups:            []string{"release-managers", "sre"},
+	}
+	testCases := []struct {
+		name     string
+		groups   []string
+		expected bool
+	}{
+		{
+			name:     "no groups",
+			expected: false,
+		},
+		{
+			name:     "not a member of any approver group",
+			groups:   []string{"developers"},
+			expected: false,
+		},
+		{
+			name:     "member of an approver group",
+			groups:   []string{"developers", "sre"},
+			expected: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				requirement.IsApprover(testCase.groups),
+			)
+		})
+	}
+}
+
+func TestApprovalRequirementIsSatisfiedBy(t *testing.T) {
+	testCases := []struct {
+		name        string
+		requirement *ApprovalRequirement
+		approvals   []PromotionApproval
+		expected    bool
+	}{
+		{
+			name:     "no requirement",
+			expected: true,
+		},
+		{
+			name:        "too few approvals",
+			requirement: &ApprovalRequirement{RequiredApprovals: 2},
+			approvals:   []PromotionApproval{{Username: "alice"}},
+			expected:    false,
+		},
+		{
+			name:        "duplicate approvals are not counted",
+			requirement: &ApprovalRequirement{RequiredApprovals: 2},
+			approvals: []PromotionApproval{
+				{Username: "alice"},
+				{Username: "alice"},
+			},
+			expected: false,
+		},
+		{
+			name:        "enough approvals",
+			requirement: &ApprovalRequirement{RequiredApprovals: 2},
+			approvals: []PromotionApproval{
+				{Username: "alice"},
+				{Username: "bob"},
+			},
+			expected: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				testCase.requirement.IsSatisfiedBy(testCase.approvals),
+			)
+		})
+	}
+}
+
+func TestImageGetValue(t *testing.T) {
+	image := Image{
+		RepoURL: "fake-repo",
+		Tag:     "fake-tag",
+		Digest:  "sha256:fake-digest",
+	}
+	testCases := []struct {
+		name      string
+		image     Image
+		valueType ImageUpdateValueType
+		expected  string
+	}{
+		{
+			name:      "Image",
+			image:     image,
+			valueType: ImageUpdateValueTypeImage,
+			expected:  "fake-repo:fake-tag",
+		},
+		{
+			name:      "Tag",
+			image:     image,
+			valueType: ImageUpdateValueTypeTag,
+			expected:  "fake-tag",
+		},
+		{
+			name:      "Digest",
+			image:     image,
+			valueType: ImageUpdateValueTypeDigest,
+			expected:  "sha256:fake-digest",
+		},
+		{
+			name:      "ImageAndDigest",
+			image:     image,
+			valueType: ImageUpdateValueTypeImageAndDigest,
+			expected:  "fake-repo@sha256:fake-digest",
+		},
+		{
+			name: "ImageAndDigest with unknown digest",
+			image: Image{
+				RepoURL: "fake-repo",
+				Tag:     "fake-tag",
+			},
+			valueType: ImageUpdateValueTypeImageAndDigest,
+		},
+		{
+			name:      "unrecognized value type",
+			image:     image,
+			valueType: "bogus",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				testCase.image.GetValue(testCase.valueType),
+			)
+		})
+	}
+}
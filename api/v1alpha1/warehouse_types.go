@@ -1,6 +1,10 @@
 package v1alpha1
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 // +kubebuilder:validation:Enum={SemVer,NewestBuild,Alphabetical,Digest}
 type ImageUpdateStrategy string
@@ -12,8 +16,44 @@ const (
 	ImageUpdateStrategyDigest       ImageUpdateStrategy = "Digest"
 )
 
+// +kubebuilder:validation:Enum={NewestFromBranch,SemVer,NewestTag}
+type CommitSelectionStrategy string
+
+const (
+	CommitSelectionStrategyNewestFromBranch CommitSelectionStrategy = "NewestFromBranch"
+	CommitSelectionStrategySemVer           CommitSelectionStrategy = "SemVer"
+	CommitSelectionStrategyNewestTag        CommitSelectionStrategy = "NewestTag"
+)
+
+const (
+	// WarehouseConditionTypeReady is the type of the condition that indicates
+	// whether a Warehouse's most recent attempt to discover new Freight
+	// succeeded.
+	WarehouseConditionTypeReady = "Ready"
+
+	// WarehouseConditionReasonDiscoverySucceeded is the reason for a True
+	// Ready condition.
+	WarehouseConditionReasonDiscoverySucceeded = "DiscoverySucceeded"
+	// WarehouseConditionReasonDiscoveryFailed is the reason for a False Ready
+	// condition caused by a failure to discover the latest artifact of one or
+	// more subscriptions.
+	WarehouseConditionReasonDiscoveryFailed = "DiscoveryFailed"
+	// WarehouseConditionReasonFreightCreationFailed is the reason for a False
+	// Ready condition caused by a failure to create Freight from the latest
+	// artifacts.
+	WarehouseConditionReasonFreightCreationFailed = "FreightCreationFailed"
+)
+
+// defaultWarehousePollInterval is the interval at which a Warehouse polls its
+// subscriptions if it does not specify a PollInterval.
+const defaultWarehousePollInterval = 5 * time.Minute
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name=Ready,type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+//+kubebuilder:printcolumn:name=Last Freight,type=string,JSONPath=`.status.lastFreight.name`
+//+kubebuilder:printcolumn:name=Last Polled,type=date,JSONPath=`.status.lastPolledAt`
+//+kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Warehouse is a source of Freight.
 type Warehouse struct {
@@ -39,6 +79,22 @@ type WarehouseSpec struct {
 	//
 	//+kubebuilder:validation:MinItems=1
 	Subscriptions []RepoSubscription `json:"subscriptions"`
+	// PollInterval is the interval at which the Warehouse polls its
+	// subscriptions to discover new Freight. e.g. "1m" or "1h". This field is
+	// optional. If not specified, a value of "5m" is used.
+	//
+	//+kubebuilder:validation:Type=string
+	//+kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
+}
+
+// GetPollInterval returns the interval at which the Warehouse polls its
+// subscriptions.
+func (w *WarehouseSpec) GetPollInterval() time.Duration {
+	if w == nil || w.PollInterval == nil || w.PollInterval.Duration <= 0 {
+		return defaultWarehousePollInterval
+	}
+	return w.PollInterval.Duration
 }
 
 // RepoSubscription describes a subscription to ONE OF a Git repository, a
@@ -66,6 +122,58 @@ type GitSubscription struct {
 	//+kubebuilder:validation:MinLength=1
 	//+kubebuilder:validation:Pattern=`^\w+([-/]\w+)*$`
 	Branch string `json:"branch,omitempty"`
+	// CommitSelectionStrategy specifies the rules for how to identify the
+	// newest commit of interest in the repository specified by the RepoURL
+	// field. "NewestFromBranch" selects the commit at the head of the branch
+	// specified by the Branch field. "SemVer" selects the commit referenced by
+	// the semantically greatest tag that satisfies the SemverConstraint field.
+	// "NewestTag" selects the commit referenced by the most recently created
+	// tag. This field is optional. When left unspecified, the field is
+	// implicitly treated as if its value were "NewestFromBranch".
+	//
+	//+kubebuilder:default=NewestFromBranch
+	CommitSelectionStrategy CommitSelectionStrategy `json:"commitSelectionStrategy,omitempty"`
+	// SemverConstraint specifies constraints on what tags are permissible. The
+	// value in this field only has any effect when the CommitSelectionStrategy
+	// is SemVer. This field is optional. When left unspecified (and the
+	// CommitSelectionStrategy is SemVer), there will be no constraints, which
+	// means the semantically greatest tag will always be used.
+	//
+	//+kubebuilder:validation:Optional
+	SemverConstraint string `json:"semverConstraint,omitempty"`
+	// AllowTags is a regular expression that can optionally be used to limit
+	// the tags that are considered in determining the newest commit of
+	// interest. The value in this field only has any effect when the
+	// CommitSelectionStrategy is SemVer or NewestTag. This field is optional.
+	//
+	//+kubebuilder:validation:Optional
+	AllowTags string `json:"allowTags,omitempty"`
+	// IgnoreTags is a list of tags that must be ignored when determining the
+	// newest commit of interest. No regular expressions or glob patterns are
+	// supported yet. The value in this field only has any effect when the
+	// CommitSelectionStrategy is SemVer or NewestTag. This field is optional.
+	//
+	//+kubebuilder:validation:Optional
+	IgnoreTags []string `json:"ignoreTags,omitempty"`
+	// IncludePaths is a list of glob patterns, relative to the root of the
+	// repository, that limits the commits that are considered to those that
+	// add, modify, or delete at least one matching file. In patterns, "*" does
+	// not match path separators, while "**" does. When this field is left
+	// unspecified, all paths are included. The value in this field only has
+	// any effect when the CommitSelectionStrategy is NewestFromBranch or left
+	// unspecified. This field is optional.
+	//
+	//+kubebuilder:validation:Optional
+	IncludePaths []string `json:"includePaths,omitempty"`
+	// ExcludePaths is a list of glob patterns, relative to the root of the
+	// repository, identifying files whose changes should be disregarded when
+	// determining the newest commit of interest. Exclusions take precedence
+	// over inclusions. The value in this field only has any effect when the
+	// CommitSelectionStrategy is NewestFromBranch or left unspecified. This
+	// field is optional.
+	//
+	//+kubebuilder:validation:Optional
+	ExcludePaths []string `json:"excludePaths,omitempty"`
 }
 
 // ImageSubscription defines a subscription to an image repository.
@@ -126,6 +234,59 @@ type ImageSubscription struct {
 	//
 	//+kubebuilder:validation:Optional
 	Platform string `json:"platform,omitempty"`
+	// Verification optionally specifies how the cosign signatures of images
+	// must be verified. When specified, tags that are unsigned or whose
+	// signatures cannot be verified are disregarded when determining the newest
+	// version of an image. This field is optional.
+	//
+	//+kubebuilder:validation:Optional
+	Verification *ImageVerification `json:"verification,omitempty"`
+}
+
+// ImageVerification describes how the cosign signatures of container images
+// must be verified. Exactly one of PublicKey or Keyless must be specified.
+type ImageVerification struct {
+	// PublicKey is a PEM-encoded public key. When specified, an image is only
+	// considered verified if it has a cosign signature that can be verified
+	// using this key.
+	//
+	//+kubebuilder:validation:Optional
+	PublicKey string `json:"publicKey,omitempty"`
+	// Keyless specifies constraints on the certificate that was used to sign
+	// an image using cosign's keyless signing flow.
+	//
+	//+kubebuilder:validation:Optional
+	Keyless *KeylessVerification `json:"keyless,omitempty"`
+}
+
+// KeylessVerification describes constraints on the short-lived certificate that
+// was used to sign an image using cosign's keyless signing flow, and on the
+// transparency log in which the signature must have been recorded.
+type KeylessVerification struct {
+	// Identity is the subject (an email address or URI) that the signing
+	// certificate must have been issued to. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Identity string `json:"identity"`
+	// Issuer is the URL of the OIDC provider that must have authenticated the
+	// Identity. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	Issuer string `json:"issuer"`
+	// CertificateAuthorities is a PEM-encoded bundle of the root and any
+	// intermediate certificates of the certificate authority (e.g. Fulcio) that
+	// must have issued the signing certificate. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	CertificateAuthorities string `json:"certificateAuthorities"`
+	// TransparencyLogPublicKey is the PEM-encoded public key of the
+	// transparency log (e.g. Rekor) in which the signature must have been
+	// recorded. The time at which the log recorded the signature is used to
+	// establish that the signature was made while the short-lived signing
+	// certificate was valid. This is a required field.
+	//
+	//+kubebuilder:validation:MinLength=1
+	TransparencyLogPublicKey string `json:"transparencyLogPublicKey"`
 }
 
 // ChartSubscription defines a subscription to a Helm chart repository.
@@ -153,12 +314,77 @@ type ChartSubscription struct {
 
 // WarehouseStatus describes a Warehouse's most recently observed state.
 type WarehouseStatus struct {
+	// Conditions contains the latest observations of the Warehouse's state.
+	//
+	//+listType=map
+	//+listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 	// Error describes any errors that are preventing the Warehouse controller
 	// from polling repositories to discover new Freight.
 	Error string `json:"error,omitempty"`
 	// ObservedGeneration represents the .metadata.generation that this Warehouse
 	// was reconciled against.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// ImageVerificationFailures describes image tags that were disregarded
+	// during the most recent attempt to discover new Freight because their
+	// signatures could not be verified.
+	ImageVerificationFailures []ImageVerificationFailure `json:"imageVerificationFailures,omitempty"`
+	// LastPolledAt is the time at which the Warehouse most recently polled its
+	// subscriptions.
+	LastPolledAt *metav1.Time `json:"lastPolledAt,omitempty"`
+	// LastFreight describes the Freight most recently created by the
+	// Warehouse.
+	LastFreight *FreightReference `json:"lastFreight,omitempty"`
+	// Subscriptions describes the most recently observed state of each of the
+	// Warehouse's subscriptions, in the order they are specified.
+	Subscriptions []SubscriptionStatus `json:"subscriptions,omitempty"`
+}
+
+// FreightReference identifies a piece of Freight.
+type FreightReference struct {
+	// Name is the name of the Freight.
+	Name string `json:"name"`
+	// CreatedAt is the time at which the Freight was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+}
+
+// SubscriptionStatus describes the most recently observed state of one of a
+// Warehouse's subscriptions.
+type SubscriptionStatus struct {
+	// RepoURL is the URL of the Git repository, image repository, or Helm chart
+	// registry that is subscribed to.
+	RepoURL string `json:"repoURL"`
+	// ChartName is the name of the Helm chart that is subscribed to. It is only
+	// set for subscriptions to Helm charts.
+	ChartName string `json:"chartName,omitempty"`
+	// LastCommit is the most recently discovered commit of a subscribed Git
+	// repository.
+	LastCommit *GitCommit `json:"lastCommit,omitempty"`
+	// LastImage is the most recently discovered version of a subscribed
+	// container image.
+	LastImage *Image `json:"lastImage,omitempty"`
+	// LastChart is the most recently discovered version of a subscribed Helm
+	// chart.
+	LastChart *Chart `json:"lastChart,omitempty"`
+	// LastDiscoveredAt is the time at which the latest artifact was most
+	// recently discovered successfully.
+	LastDiscoveredAt *metav1.Time `json:"lastDiscoveredAt,omitempty"`
+	// Error describes why the most recent attempt to discover the latest
+	// artifact failed. It is empty if that attempt succeeded.
+	Error string `json:"error,omitempty"`
+}
+
+// ImageVerificationFailure describes an image tag that was disregarded because
+// its signature could not be verified.
+type ImageVerificationFailure struct {
+	// RepoURL is the URL of the image repository.
+	RepoURL string `json:"repoURL"`
+	// Tag is the tag that was disregarded.
+	Tag string `json:"tag"`
+	// Digest is the digest of the manifest the tag referenced.
+	Digest string `json:"digest,omitempty"`
+	// Message explains why the tag's signature could not be verified.
+	Message string `json:"message"`
 }
 
 //+kubebuilder:object:root=true
@@ -0,0 +1,46 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWarehouseSpecGetPollInterval(t *testing.T) {
+	testCases := []struct {
+		name     string
+		spec     *WarehouseSpec
+		expected time.Duration
+	}{
+		{
+			name:     "spec is nil",
+			expected: defaultWarehousePollInterval,
+		},
+		{
+			name:     "poll interval is not specified",
+			spec:     &WarehouseSpec{},
+			expected: defaultWarehousePollInterval,
+		},
+		{
+			name: "poll interval is zero",
+			spec: &WarehouseSpec{
+				PollInterval: &metav1.Duration{},
+			},
+			expected: defaultWarehousePollInterval,
+		},
+		{
+			name: "poll interval is specified",
+			spec: &WarehouseSpec{
+				PollInterval: &metav1.Duration{Duration: time.Minute},
+			},
+			expected: time.Minute,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, testCase.spec.GetPollInterval())
+		})
+	}
+}
@@ -5,9 +5,30 @@
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalRequirement) DeepCopyInto(out *ApprovalRequirement) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalRequirement.
+func (in *ApprovalRequirement) DeepCopy() *ApprovalRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArgoCDAppHealthStatus) DeepCopyInto(out *ArgoCDAppHealthStatus) {
 	*out = *in
@@ -40,6 +61,21 @@ func (in *ArgoCDAppStatus) DeepCopy() *ArgoCDAppStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArgoCDAppSyncInfo) DeepCopyInto(out *ArgoCDAppSyncInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDAppSyncInfo.
+func (in *ArgoCDAppSyncInfo) DeepCopy() *ArgoCDAppSyncInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ArgoCDAppSyncInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArgoCDAppSyncStatus) DeepCopyInto(out *ArgoCDAppSyncStatus) {
 	*out = *in
@@ -192,6 +228,101 @@ func (in *ChartSubscription) DeepCopy() *ChartSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluxHelmImageUpdate) DeepCopyInto(out *FluxHelmImageUpdate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluxHelmImageUpdate.
+func (in *FluxHelmImageUpdate) DeepCopy() *FluxHelmImageUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(FluxHelmImageUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluxHelmReleaseUpdate) DeepCopyInto(out *FluxHelmReleaseUpdate) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]FluxHelmImageUpdate, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluxHelmReleaseUpdate.
+func (in *FluxHelmReleaseUpdate) DeepCopy() *FluxHelmReleaseUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(FluxHelmReleaseUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluxKustomizationUpdate) DeepCopyInto(out *FluxKustomizationUpdate) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluxKustomizationUpdate.
+func (in *FluxKustomizationUpdate) DeepCopy() *FluxKustomizationUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(FluxKustomizationUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluxResourceStatus) DeepCopyInto(out *FluxResourceStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluxResourceStatus.
+func (in *FluxResourceStatus) DeepCopy() *FluxResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FluxResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluxUpdate) DeepCopyInto(out *FluxUpdate) {
+	*out = *in
+	if in.HelmRelease != nil {
+		in, out := &in.HelmRelease, &out.HelmRelease
+		*out = new(FluxHelmReleaseUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kustomization != nil {
+		in, out := &in.Kustomization, &out.Kustomization
+		*out = new(FluxKustomizationUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluxUpdate.
+func (in *FluxUpdate) DeepCopy() *FluxUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(FluxUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Freight) DeepCopyInto(out *Freight) {
 	*out = *in
@@ -265,6 +396,25 @@ func (in *FreightList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreightReference) DeepCopyInto(out *FreightReference) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightReference.
+func (in *FreightReference) DeepCopy() *FreightReference {
+	if in == nil {
+		return nil
+	}
+	out := new(FreightReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FreightStatus) DeepCopyInto(out *FreightStatus) {
 	*out = *in
@@ -320,6 +470,21 @@ func (in *GitRepoUpdate) DeepCopyInto(out *GitRepoUpdate) {
 		*out = new(HelmPromotionMechanism)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HelmTemplate != nil {
+		in, out := &in.HelmTemplate, &out.HelmTemplate
+		*out = new(HelmTemplatePromotionMechanism)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.YAMLUpdates != nil {
+		in, out := &in.YAMLUpdates, &out.YAMLUpdates
+		*out = make([]YAMLUpdate, len(*in))
+		copy(*out, *in)
+	}
+	if in.PullRequest != nil {
+		in, out := &in.PullRequest, &out.PullRequest
+		*out = new(PullRequestPromotionMechanism)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRepoUpdate.
@@ -335,6 +500,21 @@ func (in *GitRepoUpdate) DeepCopy() *GitRepoUpdate {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitSubscription) DeepCopyInto(out *GitSubscription) {
 	*out = *in
+	if in.IgnoreTags != nil {
+		in, out := &in.IgnoreTags, &out.IgnoreTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IncludePaths != nil {
+		in, out := &in.IncludePaths, &out.IncludePaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludePaths != nil {
+		in, out := &in.ExcludePaths, &out.ExcludePaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSubscription.
@@ -347,6 +527,81 @@ func (in *GitSubscription) DeepCopy() *GitSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeader) DeepCopyInto(out *HTTPHeader) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeader.
+func (in *HTTPHeader) DeepCopy() *HTTPHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPSuccessCriteria) DeepCopyInto(out *HTTPSuccessCriteria) {
+	*out = *in
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSuccessCriteria.
+func (in *HTTPSuccessCriteria) DeepCopy() *HTTPSuccessCriteria {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPSuccessCriteria)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPWebhook) DeepCopyInto(out *HTTPWebhook) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HTTPHeader, len(*in))
+		copy(*out, *in)
+	}
+	if in.SuccessCriteria != nil {
+		in, out := &in.SuccessCriteria, &out.SuccessCriteria
+		*out = new(HTTPSuccessCriteria)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPWebhook.
+func (in *HTTPWebhook) DeepCopy() *HTTPWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPWebhookResult) DeepCopyInto(out *HTTPWebhookResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPWebhookResult.
+func (in *HTTPWebhookResult) DeepCopy() *HTTPWebhookResult {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPWebhookResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Health) DeepCopyInto(out *Health) {
 	*out = *in
@@ -362,6 +617,11 @@ func (in *Health) DeepCopyInto(out *Health) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FluxResources != nil {
+		in, out := &in.FluxResources, &out.FluxResources
+		*out = make([]FluxResourceStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Health.
@@ -429,6 +689,46 @@ func (in *HelmPromotionMechanism) DeepCopy() *HelmPromotionMechanism {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmTemplateImageUpdate) DeepCopyInto(out *HelmTemplateImageUpdate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmTemplateImageUpdate.
+func (in *HelmTemplateImageUpdate) DeepCopy() *HelmTemplateImageUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmTemplateImageUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmTemplatePromotionMechanism) DeepCopyInto(out *HelmTemplatePromotionMechanism) {
+	*out = *in
+	if in.ValuesFiles != nil {
+		in, out := &in.ValuesFiles, &out.ValuesFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]HelmTemplateImageUpdate, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmTemplatePromotionMechanism.
+func (in *HelmTemplatePromotionMechanism) DeepCopy() *HelmTemplatePromotionMechanism {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmTemplatePromotionMechanism)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Image) DeepCopyInto(out *Image) {
 	*out = *in
@@ -452,6 +752,11 @@ func (in *ImageSubscription) DeepCopyInto(out *ImageSubscription) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ImageVerification)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSubscription.
@@ -464,6 +769,41 @@ func (in *ImageSubscription) DeepCopy() *ImageSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVerification) DeepCopyInto(out *ImageVerification) {
+	*out = *in
+	if in.Keyless != nil {
+		in, out := &in.Keyless, &out.Keyless
+		*out = new(KeylessVerification)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVerification.
+func (in *ImageVerification) DeepCopy() *ImageVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVerificationFailure) DeepCopyInto(out *ImageVerificationFailure) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVerificationFailure.
+func (in *ImageVerificationFailure) DeepCopy() *ImageVerificationFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVerificationFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KargoRenderPromotionMechanism) DeepCopyInto(out *KargoRenderPromotionMechanism) {
 	*out = *in
@@ -479,6 +819,51 @@ func (in *KargoRenderPromotionMechanism) DeepCopy() *KargoRenderPromotionMechani
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeylessVerification) DeepCopyInto(out *KeylessVerification) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeylessVerification.
+func (in *KeylessVerification) DeepCopy() *KeylessVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(KeylessVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesPatch) DeepCopyInto(out *KubernetesPatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesPatch.
+func (in *KubernetesPatch) DeepCopy() *KubernetesPatch {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesPatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KustomizeBuild) DeepCopyInto(out *KustomizeBuild) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizeBuild.
+func (in *KustomizeBuild) DeepCopy() *KustomizeBuild {
+	if in == nil {
+		return nil
+	}
+	out := new(KustomizeBuild)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KustomizeImageUpdate) DeepCopyInto(out *KustomizeImageUpdate) {
 	*out = *in
@@ -502,6 +887,11 @@ func (in *KustomizePromotionMechanism) DeepCopyInto(out *KustomizePromotionMecha
 		*out = make([]KustomizeImageUpdate, len(*in))
 		copy(*out, *in)
 	}
+	if in.Build != nil {
+		in, out := &in.Build, &out.Build
+		*out = new(KustomizeBuild)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizePromotionMechanism.
@@ -524,7 +914,7 @@ func (in *Promotion) DeepCopyInto(out *Promotion) {
 		*out = new(PromotionSpec)
 		**out = **in
 	}
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Promotion.
@@ -545,6 +935,44 @@ func (in *Promotion) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionApproval) DeepCopyInto(out *PromotionApproval) {
+	*out = *in
+	if in.ApprovedAt != nil {
+		in, out := &in.ApprovedAt, &out.ApprovedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionApproval.
+func (in *PromotionApproval) DeepCopy() *PromotionApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionAttempt) DeepCopyInto(out *PromotionAttempt) {
+	*out = *in
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionAttempt.
+func (in *PromotionAttempt) DeepCopy() *PromotionAttempt {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionInfo) DeepCopyInto(out *PromotionInfo) {
 	*out = *in
@@ -561,6 +989,21 @@ func (in *PromotionInfo) DeepCopy() *PromotionInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionJob) DeepCopyInto(out *PromotionJob) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionJob.
+func (in *PromotionJob) DeepCopy() *PromotionJob {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionList) DeepCopyInto(out *PromotionList) {
 	*out = *in
@@ -610,6 +1053,39 @@ func (in *PromotionMechanisms) DeepCopyInto(out *PromotionMechanisms) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FluxUpdates != nil {
+		in, out := &in.FluxUpdates, &out.FluxUpdates
+		*out = make([]FluxUpdate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.KubernetesPatches != nil {
+		in, out := &in.KubernetesPatches, &out.KubernetesPatches
+		*out = make([]KubernetesPatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.Jobs != nil {
+		in, out := &in.Jobs, &out.Jobs
+		*out = make([]PromotionJob, len(*in))
+		copy(*out, *in)
+	}
+	if in.HTTPWebhooks != nil {
+		in, out := &in.HTTPWebhooks, &out.HTTPWebhooks
+		*out = make([]HTTPWebhook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = (*in).DeepCopy()
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(PromotionRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionMechanisms.
@@ -627,6 +1103,16 @@ func (in *PromotionPolicy) DeepCopyInto(out *PromotionPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.AutoPromotionWindows != nil {
+		in, out := &in.AutoPromotionWindows, &out.AutoPromotionWindows
+		*out = make([]PromotionWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.FreezeWindows != nil {
+		in, out := &in.FreezeWindows, &out.FreezeWindows
+		*out = make([]PromotionWindow, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionPolicy.
@@ -679,6 +1165,34 @@ func (in *PromotionPolicyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionRetryPolicy) DeepCopyInto(out *PromotionRetryPolicy) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = (*in).DeepCopy()
+	}
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = (*in).DeepCopy()
+	}
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = make([]PromotionErrorClass, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionRetryPolicy.
+func (in *PromotionRetryPolicy) DeepCopy() *PromotionRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionSpec) DeepCopyInto(out *PromotionSpec) {
 	*out = *in
@@ -697,6 +1211,46 @@ func (in *PromotionSpec) DeepCopy() *PromotionSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionStatus) DeepCopyInto(out *PromotionStatus) {
 	*out = *in
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]PromotionApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.PullRequests != nil {
+		in, out := &in.PullRequests, &out.PullRequests
+		*out = make([]PullRequestInfo, len(*in))
+		copy(*out, *in)
+	}
+	if in.ArgoCDAppSyncs != nil {
+		in, out := &in.ArgoCDAppSyncs, &out.ArgoCDAppSyncs
+		*out = make([]ArgoCDAppSyncInfo, len(*in))
+		copy(*out, *in)
+	}
+	if in.HTTPWebhookResults != nil {
+		in, out := &in.HTTPWebhookResults, &out.HTTPWebhookResults
+		*out = make([]HTTPWebhookResult, len(*in))
+		copy(*out, *in)
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]PromotionStepResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Attempts != nil {
+		in, out := &in.Attempts, &out.Attempts
+		*out = make([]PromotionAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStatus.
@@ -709,6 +1263,75 @@ func (in *PromotionStatus) DeepCopy() *PromotionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionStepResult) DeepCopyInto(out *PromotionStepResult) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStepResult.
+func (in *PromotionStepResult) DeepCopy() *PromotionStepResult {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionStepResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionWindow) DeepCopyInto(out *PromotionWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionWindow.
+func (in *PromotionWindow) DeepCopy() *PromotionWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullRequestInfo) DeepCopyInto(out *PullRequestInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequestInfo.
+func (in *PullRequestInfo) DeepCopy() *PullRequestInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(PullRequestInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PullRequestPromotionMechanism) DeepCopyInto(out *PullRequestPromotionMechanism) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PullRequestPromotionMechanism.
+func (in *PullRequestPromotionMechanism) DeepCopy() *PullRequestPromotionMechanism {
+	if in == nil {
+		return nil
+	}
+	out := new(PullRequestPromotionMechanism)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Qualification) DeepCopyInto(out *Qualification) {
 	*out = *in
@@ -730,7 +1353,7 @@ func (in *RepoSubscription) DeepCopyInto(out *RepoSubscription) {
 	if in.Git != nil {
 		in, out := &in.Git, &out.Git
 		*out = new(GitSubscription)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Image != nil {
 		in, out := &in.Image, &out.Image
@@ -881,6 +1504,11 @@ func (in *StageSpec) DeepCopyInto(out *StageSpec) {
 		*out = new(PromotionMechanisms)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(ApprovalRequirement)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageSpec.
@@ -945,6 +1573,40 @@ func (in *StageSubscription) DeepCopy() *StageSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscriptionStatus) DeepCopyInto(out *SubscriptionStatus) {
+	*out = *in
+	if in.LastCommit != nil {
+		in, out := &in.LastCommit, &out.LastCommit
+		*out = new(GitCommit)
+		**out = **in
+	}
+	if in.LastImage != nil {
+		in, out := &in.LastImage, &out.LastImage
+		*out = new(Image)
+		**out = **in
+	}
+	if in.LastChart != nil {
+		in, out := &in.LastChart, &out.LastChart
+		*out = new(Chart)
+		**out = **in
+	}
+	if in.LastDiscoveredAt != nil {
+		in, out := &in.LastDiscoveredAt, &out.LastDiscoveredAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionStatus.
+func (in *SubscriptionStatus) DeepCopy() *SubscriptionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscriptionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subscriptions) DeepCopyInto(out *Subscriptions) {
 	*out = *in
@@ -975,7 +1637,7 @@ func (in *Warehouse) DeepCopyInto(out *Warehouse) {
 		*out = new(WarehouseSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Warehouse.
@@ -1038,6 +1700,10 @@ func (in *WarehouseSpec) DeepCopyInto(out *WarehouseSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PollInterval != nil {
+		in, out := &in.PollInterval, &out.PollInterval
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseSpec.
@@ -1053,6 +1719,34 @@ func (in *WarehouseSpec) DeepCopy() *WarehouseSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WarehouseStatus) DeepCopyInto(out *WarehouseStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImageVerificationFailures != nil {
+		in, out := &in.ImageVerificationFailures, &out.ImageVerificationFailures
+		*out = make([]ImageVerificationFailure, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastPolledAt != nil {
+		in, out := &in.LastPolledAt, &out.LastPolledAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastFreight != nil {
+		in, out := &in.LastFreight, &out.LastFreight
+		*out = new(FreightReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Subscriptions != nil {
+		in, out := &in.Subscriptions, &out.Subscriptions
+		*out = make([]SubscriptionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseStatus.
@@ -1064,3 +1758,18 @@ func (in *WarehouseStatus) DeepCopy() *WarehouseStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *YAMLUpdate) DeepCopyInto(out *YAMLUpdate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new YAMLUpdate.
+func (in *YAMLUpdate) DeepCopy() *YAMLUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(YAMLUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
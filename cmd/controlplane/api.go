@@ -8,16 +8,23 @@ import (
 	pkgerrors "github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	kubeclientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	render "github.com/akuity/kargo-render"
 	"github.com/akuity/kargo/internal/api"
 	"github.com/akuity/kargo/internal/api/config"
 	"github.com/akuity/kargo/internal/api/kubernetes"
+	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/promotion"
+	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/internal/kubeclient"
 	"github.com/akuity/kargo/internal/os"
+	"github.com/akuity/kargo/internal/types"
 	versionpkg "github.com/akuity/kargo/internal/version"
 )
 
@@ -60,7 +67,15 @@ func newAPICommand() *cobra.Command {
 				}).Info("SSO via OpenID Connect is enabled")
 			}
 
-			srv := api.NewServer(cfg, kubeClient)
+			var promoMechanisms promotion.Mechanism
+			if types.MustParseBool(os.GetEnv("PROMOTION_PREVIEWS_ENABLED", "false")) {
+				if promoMechanisms, err = newPromotionMechanismsForAPI(ctx, restCfg); err != nil {
+					return pkgerrors.Wrap(err, "error initializing promotion mechanisms")
+				}
+				log.Info("promotion previews are enabled")
+			}
+
+			srv := api.NewServer(cfg, kubeClient, promoMechanisms)
 			l, err := net.Listen(
 				"tcp",
 				fmt.Sprintf(
@@ -106,3 +121,79 @@ func newClientForAPI(ctx context.Context, r *rest.Config, scheme *runtime.Scheme
 	}()
 	return mgr.GetClient(), nil
 }
+
+// newPromotionMechanismsForAPI returns promotion mechanisms that the API server
+// can use for previewing Promotions. Unlike the API server's own client, the
+// clients used by these mechanisms act with the API server's own permissions,
+// since they must read credentials and Argo CD Applications on behalf of
+// users who may not have access to them.
+func newPromotionMechanismsForAPI(
+	ctx context.Context,
+	kargoRestCfg *rest.Config,
+) (promotion.Mechanism, error) {
+	kargoScheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(kargoScheme); err != nil {
+		return nil, pkgerrors.Wrap(err, "error adding Kubernetes core API to scheme")
+	}
+	kargoClient, err := client.New(kargoRestCfg, client.Options{Scheme: kargoScheme})
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "error creating Kargo client")
+	}
+	kargoClientset, err := kubeclientset.NewForConfig(kargoRestCfg)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "error creating Kargo clientset")
+	}
+
+	argoRestCfg, err :=
+		kubernetes.GetRestConfig(ctx, os.GetEnv("ARGOCD_KUBECONFIG", ""))
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "error loading REST config for Argo CD")
+	}
+	argoScheme := runtime.NewScheme()
+	if err = corev1.AddToScheme(argoScheme); err != nil {
+		return nil, pkgerrors.Wrap(err, "error adding Kubernetes core API to scheme")
+	}
+	if err = argocd.AddToScheme(argoScheme); err != nil {
+		return nil, pkgerrors.Wrap(err, "error adding Argo CD API to scheme")
+	}
+	argoClient, err := client.New(argoRestCfg, client.Options{Scheme: argoScheme})
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "error creating Argo CD client")
+	}
+
+	var argoClientForCreds client.Client
+	if types.MustParseBool(
+		os.GetEnv("ARGOCD_ENABLE_CREDENTIAL_BORROWING", "false"),
+	) {
+		argoClientForCreds = argoClient
+	}
+
+	fluxRestCfg, err :=
+		kubernetes.GetRestConfig(ctx, os.GetEnv("FLUX_KUBECONFIG", ""))
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "error loading REST config for Flux")
+	}
+	// Flux resources are always handled as unstructured objects, so the client
+	// needs no scheme beyond the default.
+	fluxClient, err := client.New(fluxRestCfg, client.Options{})
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "error creating Flux client")
+	}
+
+	return promotion.NewMechanisms(
+		argoClient,
+		fluxClient,
+		kargoClient,
+		kargoClientset,
+		credentials.NewKubernetesDatabase(
+			os.GetEnv("ARGOCD_NAMESPACE", "argocd"),
+			kargoClient,
+			argoClientForCreds,
+		),
+		render.NewService(
+			&render.ServiceOptions{
+				LogLevel: render.LogLevel(log.GetLevel()),
+			},
+		),
+	), nil
+}
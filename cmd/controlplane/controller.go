@@ -135,6 +135,21 @@ func newControllerCommand() *cobra.Command {
 				}
 			}
 
+			var fluxClient client.Client
+			{
+				restCfg, err :=
+					kubernetes.GetRestConfig(ctx, os.GetEnv("FLUX_KUBECONFIG", ""))
+				if err != nil {
+					return errors.Wrap(err, "error loading REST config for Flux client")
+				}
+				restCfg.ContentType = runtime.ContentTypeJSON
+				// Flux resources are always handled as unstructured objects, so the
+				// client needs no scheme beyond the default.
+				if fluxClient, err = client.New(restCfg, client.Options{}); err != nil {
+					return errors.Wrap(err, "error initializing Flux client")
+				}
+			}
+
 			var argoClientForCreds client.Client
 			if types.MustParseBool(
 				os.GetEnv("ARGOCD_ENABLE_CREDENTIAL_BORROWING", "false"),
@@ -151,6 +166,7 @@ func newControllerCommand() *cobra.Command {
 				ctx,
 				kargoMgr,
 				appMgr,
+				fluxClient,
 				shardName,
 			); err != nil {
 				return errors.Wrap(err, "error setting up Stages reconciler")
@@ -160,6 +176,7 @@ func newControllerCommand() *cobra.Command {
 				ctx,
 				kargoMgr,
 				appMgr,
+				fluxClient,
 				credentialsDB,
 				render.NewService(
 					&render.ServiceOptions{
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// AbortPromotion requests that a Promotion that has not yet reached a terminal
+// phase be aborted. The Promotion controller cancels any in-progress execution
+// of the Promotion and moves it to the Aborted phase, which allows the next
+// Promotion in the Stage's queue to begin.
+func (s *server) AbortPromotion(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.AbortPromotionRequest],
+) (*connect.Response[svcv1alpha1.AbortPromotionResponse], error) {
+	if req.Msg.GetProject() == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("project should not be empty"),
+		)
+	}
+	if req.Msg.GetName() == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("name should not be empty"),
+		)
+	}
+	if err := s.validateProjectFn(ctx, req.Msg.GetProject()); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+
+	promoKey := types.NamespacedName{
+		Namespace: req.Msg.GetProject(),
+		Name:      req.Msg.GetName(),
+	}
+	promo, err := s.getPromotionFn(ctx, s.client, promoKey)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if promo == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"Promotion %q not found in namespace %q",
+				promoKey.Name,
+				promoKey.Namespace,
+			),
+		)
+	}
+	if promo.Status.Phase.IsTerminal() {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.Errorf(
+				"Promotion %q in namespace %q cannot be aborted; its phase is "+
+					"already %s",
+				promoKey.Name,
+				promoKey.Namespace,
+				promo.Status.Phase,
+			),
+		)
+	}
+
+	if err = s.abortPromotionFn(ctx, s.client, promoKey); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&svcv1alpha1.AbortPromotionResponse{}), nil
+}
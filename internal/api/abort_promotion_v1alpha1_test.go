@@ -0,0 +1,187 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestAbortPromotion(t *testing.T) {
+	validReq := &svcv1alpha1.AbortPromotionRequest{
+		Project: "fake-project",
+		Name:    "fake-promotion",
+	}
+	validProject := func(context.Context, string) error {
+		return nil
+	}
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.AbortPromotionRequest
+		server     *server
+		assertions func(error)
+	}{
+		{
+			name:   "input validation error",
+			req:    &svcv1alpha1.AbortPromotionRequest{},
+			server: &server{},
+			assertions: func(err error) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name: "error validating project",
+			req:  validReq,
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "error getting Promotion",
+			req:  validReq,
+			server: &server{
+				validateProjectFn: validProject,
+				getPromotionFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Promotion, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInternal, connErr.Code())
+			},
+		},
+		{
+			name: "Promotion not found",
+			req:  validReq,
+			server: &server{
+				validateProjectFn: validProject,
+				getPromotionFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Promotion, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+			},
+		},
+		{
+			name: "Promotion already terminal",
+			req:  validReq,
+			server: &server{
+				validateProjectFn: validProject,
+				getPromotionFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Promotion, error) {
+					return &kargoapi.Promotion{
+						Status: kargoapi.PromotionStatus{
+							Phase: kargoapi.PromotionPhaseSucceeded,
+						},
+					}, nil
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeFailedPrecondition, connErr.Code())
+				require.Contains(t, connErr.Message(), "already Succeeded")
+			},
+		},
+		{
+			name: "error aborting Promotion",
+			req:  validReq,
+			server: &server{
+				validateProjectFn: validProject,
+				getPromotionFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Promotion, error) {
+					return &kargoapi.Promotion{}, nil
+				},
+				abortPromotionFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInternal, connErr.Code())
+			},
+		},
+		{
+			name: "success",
+			req:  validReq,
+			server: &server{
+				validateProjectFn: validProject,
+				getPromotionFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Promotion, error) {
+					return &kargoapi.Promotion{
+						Status: kargoapi.PromotionStatus{
+							Phase: kargoapi.PromotionPhaseRunning,
+						},
+					}, nil
+				},
+				abortPromotionFn: func(
+					_ context.Context,
+					_ client.Client,
+					key types.NamespacedName,
+				) error {
+					require.Equal(t, "fake-project", key.Namespace)
+					require.Equal(t, "fake-promotion", key.Name)
+					return nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := testCase.server.AbortPromotion(
+				context.Background(),
+				connect.NewRequest(testCase.req),
+			)
+			testCase.assertions(err)
+		})
+	}
+}
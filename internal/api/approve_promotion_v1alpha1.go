@@ -0,0 +1,152 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// ApprovePromotion records the current user's approval of a Promotion to a
+// Stage that requires Promotions to be approved. Only users authenticated via
+// OpenID Connect may approve Promotions, since the identity of the approver
+// and their group memberships are taken from their verified credentials. Once
+// a Promotion has received the approvals required by its Stage, the Promotion
+// controller allows it to proceed.
+func (s *server) ApprovePromotion(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.ApprovePromotionRequest],
+) (*connect.Response[svcv1alpha1.ApprovePromotionResponse], error) {
+	if req.Msg.GetProject() == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("project should not be empty"),
+		)
+	}
+	if req.Msg.GetName() == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("name should not be empty"),
+		)
+	}
+	if err := s.validateProjectFn(ctx, req.Msg.GetProject()); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+
+	userInfo, ok := user.InfoFromContext(ctx)
+	if !ok || userInfo.Username == "" {
+		return nil, connect.NewError(
+			connect.CodePermissionDenied,
+			errors.New(
+				"only users authenticated via OpenID Connect may approve Promotions",
+			),
+		)
+	}
+
+	promoKey := types.NamespacedName{
+		Namespace: req.Msg.GetProject(),
+		Name:      req.Msg.GetName(),
+	}
+	promo, err := s.getPromotionFn(ctx, s.client, promoKey)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if promo == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"Promotion %q not found in namespace %q",
+				promoKey.Name,
+				promoKey.Namespace,
+			),
+		)
+	}
+	if promo.Status.Phase != "" &&
+		promo.Status.Phase != kargoapi.PromotionPhasePending {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.Errorf(
+				"Promotion %q in namespace %q cannot be approved; its phase is "+
+					"already %s",
+				promoKey.Name,
+				promoKey.Namespace,
+				promo.Status.Phase,
+			),
+		)
+	}
+
+	stage, err := s.getStageFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Stage,
+		},
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if stage == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"Stage %q not found in namespace %q",
+				promo.Spec.Stage,
+				promo.Namespace,
+			),
+		)
+	}
+	if stage.Spec == nil || stage.Spec.Approval == nil {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.Errorf(
+				"Stage %q in namespace %q does not require Promotions to be approved",
+				stage.Name,
+				stage.Namespace,
+			),
+		)
+	}
+	if !stage.Spec.Approval.IsApprover(userInfo.Groups) {
+		return nil, connect.NewError(
+			connect.CodePermissionDenied,
+			errors.Errorf(
+				"user %q is not a member of any group permitted to approve "+
+					"Promotions to Stage %q in namespace %q",
+				userInfo.Username,
+				stage.Name,
+				stage.Namespace,
+			),
+		)
+	}
+	if promo.IsApprovedBy(userInfo.Username) {
+		return nil, connect.NewError(
+			connect.CodeAlreadyExists,
+			errors.Errorf(
+				"Promotion %q in namespace %q has already been approved by user %q",
+				promoKey.Name,
+				promoKey.Namespace,
+				userInfo.Username,
+			),
+		)
+	}
+
+	now := metav1.Now()
+	if err = s.approvePromotionFn(
+		ctx,
+		s.client,
+		promo,
+		kargoapi.PromotionApproval{
+			Username:   userInfo.Username,
+			ApprovedAt: &now,
+		},
+	); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&svcv1alpha1.ApprovePromotionResponse{}), nil
+}
@@ -0,0 +1,289 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestApprovePromotion(t *testing.T) {
+	validReq := &svcv1alpha1.ApprovePromotionRequest{
+		Project: "fake-project",
+		Name:    "fake-promotion",
+	}
+	approver := user.Info{
+		Username: "alice",
+		Groups:   []string{"release-managers"},
+	}
+	validProject := func(context.Context, string) error {
+		return nil
+	}
+	getPendingPromotion := func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Promotion, error) {
+		return &kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "fake-promotion",
+				Namespace: "fake-project",
+			},
+			Spec: &kargoapi.PromotionSpec{
+				Stage: "fake-stage",
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhasePending,
+			},
+		}, nil
+	}
+	getStageRequiringApproval := func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Stage, error) {
+		return &kargoapi.Stage{
+			Spec: &kargoapi.StageSpec{
+				Approval: &kargoapi.ApprovalRequirement{
+					RequiredApprovals: 2,
+					Groups:            []string{"release-managers"},
+				},
+			},
+		}, nil
+	}
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.ApprovePromotionRequest
+		userInfo   *user.Info
+		server     *server
+		assertions func(error)
+	}{
+		{
+			name:   "input validation error",
+			req:    &svcv1alpha1.ApprovePromotionRequest{},
+			server: &server{},
+			assertions: func(err error) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name: "error validating project",
+			req:  validReq,
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "user not authenticated via OIDC",
+			req:  validReq,
+			userInfo: &user.Info{
+				BearerToken: "fake-token",
+			},
+			server: &server{
+				validateProjectFn: validProject,
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodePermissionDenied, connErr.Code())
+			},
+		},
+		{
+			name:     "Promotion not found",
+			req:      validReq,
+			userInfo: &approver,
+			server: &server{
+				validateProjectFn: validProject,
+				getPromotionFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Promotion, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+			},
+		},
+		{
+			name:     "Promotion already running",
+			req:      validReq,
+			userInfo: &approver,
+			server: &server{
+				validateProjectFn: validProject,
+				getPromotionFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Promotion, error) {
+					return &kargoapi.Promotion{
+						Status: kargoapi.PromotionStatus{
+							Phase: kargoapi.PromotionPhaseRunning,
+						},
+					}, nil
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeFailedPrecondition, connErr.Code())
+				require.Contains(t, connErr.Message(), "already Running")
+			},
+		},
+		{
+			name:     "Stage does not require approval",
+			req:      validReq,
+			userInfo: &approver,
+			server: &server{
+				validateProjectFn: validProject,
+				getPromotionFn:    getPendingPromotion,
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{},
+					}, nil
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeFailedPrecondition, connErr.Code())
+				require.Contains(t, connErr.Message(), "does not require")
+			},
+		},
+		{
+			name: "user is not an approver",
+			req:  validReq,
+			userInfo: &user.Info{
+				Username: "bob",
+				Groups:   []string{"developers"},
+			},
+			server: &server{
+				validateProjectFn: validProject,
+				getPromotionFn:    getPendingPromotion,
+				getStageFn:        getStageRequiringApproval,
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodePermissionDenied, connErr.Code())
+			},
+		},
+		{
+			name:     "user has already approved",
+			req:      validReq,
+			userInfo: &approver,
+			server: &server{
+				validateProjectFn: validProject,
+				getPromotionFn: func(
+					ctx context.Context,
+					c client.Client,
+					key types.NamespacedName,
+				) (*kargoapi.Promotion, error) {
+					promo, _ := getPendingPromotion(ctx, c, key)
+					promo.Status.Approvals = []kargoapi.PromotionApproval{
+						{Username: "alice"},
+					}
+					return promo, nil
+				},
+				getStageFn: getStageRequiringApproval,
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeAlreadyExists, connErr.Code())
+			},
+		},
+		{
+			name:     "error recording approval",
+			req:      validReq,
+			userInfo: &approver,
+			server: &server{
+				validateProjectFn: validProject,
+				getPromotionFn:    getPendingPromotion,
+				getStageFn:        getStageRequiringApproval,
+				approvePromotionFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Promotion,
+					kargoapi.PromotionApproval,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInternal, connErr.Code())
+			},
+		},
+		{
+			name:     "success",
+			req:      validReq,
+			userInfo: &approver,
+			server: &server{
+				validateProjectFn: validProject,
+				getPromotionFn:    getPendingPromotion,
+				getStageFn:        getStageRequiringApproval,
+				approvePromotionFn: func(
+					_ context.Context,
+					_ client.Client,
+					promo *kargoapi.Promotion,
+					approval kargoapi.PromotionApproval,
+				) error {
+					require.Equal(t, "fake-promotion", promo.Name)
+					require.Equal(t, "alice", approval.Username)
+					require.NotNil(t, approval.ApprovedAt)
+					return nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			if testCase.userInfo != nil {
+				ctx = user.ContextWithInfo(ctx, *testCase.userInfo)
+			}
+			_, err := testCase.server.ApprovePromotion(
+				ctx,
+				connect.NewRequest(testCase.req),
+			)
+			testCase.assertions(err)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/akuity/kargo/internal/api/dex"
 	"github.com/akuity/kargo/internal/api/oidc"
+	"github.com/akuity/kargo/internal/api/receiver"
 	"github.com/akuity/kargo/internal/os"
 	"github.com/akuity/kargo/internal/types"
 )
@@ -26,6 +27,9 @@ type ServerConfig struct {
 	AdminConfig    *AdminConfig
 	DexProxyConfig *dex.ProxyConfig
 	ArgoCDConfig   ArgoCDConfig
+	// WebhookReceiverConfig is non-nil if the server should refresh Warehouses
+	// upon receiving webhooks from Git hosting providers and registries.
+	WebhookReceiverConfig *receiver.Config
 }
 
 func ServerConfigFromEnv() ServerConfig {
@@ -48,6 +52,10 @@ func ServerConfigFromEnv() ServerConfig {
 		cfg.DexProxyConfig = &dexProxyCfg
 	}
 	envconfig.MustProcess("", &cfg.ArgoCDConfig)
+	if types.MustParseBool(os.GetEnv("WEBHOOK_RECEIVER_ENABLED", "false")) {
+		receiverCfg := receiver.ConfigFromEnv()
+		cfg.WebhookReceiverConfig = &receiverCfg
+	}
 	return cfg
 }
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/errors"
 	authv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -25,6 +26,10 @@ import (
 	"github.com/akuity/kargo/internal/logging"
 )
 
+// errNotAllowed is returned by getAuthorizedClient when the user bound to the
+// context is not permitted to perform the desired operation.
+var errNotAllowed = errors.New("not allowed")
+
 // ClientOptions specifies options for customizing the client returned by the
 // NewClient function.
 type ClientOptions struct {
@@ -89,6 +94,17 @@ type Client interface {
 		namespace string,
 		opts metav1.ListOptions,
 	) (watch.Interface, error)
+	// Authorize returns a Forbidden error if the user bound to the provided
+	// context is not permitted to perform the specified verb on the resource
+	// described by the remaining arguments. This is useful for verbs, such as
+	// "promote", that do not correspond to any operation of the client.
+	Authorize(
+		ctx context.Context,
+		verb string,
+		gvr schema.GroupVersionResource,
+		subresource string,
+		key libClient.ObjectKey,
+	) error
 }
 
 // client implements Client.
@@ -462,6 +478,33 @@ func (c *client) Watch(
 	return ri.Watch(ctx, opts)
 }
 
+func (c *client) Authorize(
+	ctx context.Context,
+	verb string,
+	gvr schema.GroupVersionResource,
+	subresource string,
+	key libClient.ObjectKey,
+) error {
+	if _, err := c.getAuthorizedClientFn(
+		ctx,
+		c.internalClient,
+		verb,
+		gvr,
+		subresource,
+		key,
+	); err != nil {
+		if errors.Is(err, errNotAllowed) {
+			return apierrors.NewForbidden(
+				gvr.GroupResource(),
+				key.Name,
+				errors.Errorf("%s is not permitted", verb),
+			)
+		}
+		return err
+	}
+	return nil
+}
+
 func GetRestConfig(ctx context.Context, path string) (*rest.Config, error) {
 	logger := logging.LoggerFromContext(ctx)
 
@@ -520,7 +563,7 @@ func getAuthorizedClient(
 ) (libClient.Client, error) {
 	userInfo, ok := user.InfoFromContext(ctx)
 	if !ok {
-		return nil, errors.New("not allowed")
+		return nil, errNotAllowed
 	}
 
 	// Admins get to use the Kargo API server's own Kubernetes client. i.e. They
@@ -566,7 +609,7 @@ func getAuthorizedClient(
 			errors.Wrap(err, "error getting user-specific Kubernetes client")
 	}
 	if client == nil {
-		return nil, errors.New("not allowed")
+		return nil, errNotAllowed
 	}
 	// Note: At present, we never return the user-specific client. We always
 	// return the internal client so that most operations are performed by a
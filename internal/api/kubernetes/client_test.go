@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -431,6 +432,74 @@ func TestAllClientOperations(t *testing.T) {
 	}
 }
 
+func TestAuthorize(t *testing.T) {
+	testCases := []struct {
+		name       string
+		authErr    error
+		assertions func(error)
+	}{
+		{
+			name:    "allowed",
+			authErr: nil,
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "not allowed",
+			authErr: errNotAllowed,
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.True(t, apierrors.IsForbidden(err))
+				require.Contains(t, err.Error(), "promote is not permitted")
+			},
+		},
+		{
+			name:    "error authorizing",
+			authErr: errors.New("something went wrong"),
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.False(t, apierrors.IsForbidden(err))
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := &client{
+				getAuthorizedClientFn: func(
+					_ context.Context,
+					_ libClient.Client,
+					verb string,
+					_ schema.GroupVersionResource,
+					_ string,
+					key libClient.ObjectKey,
+				) (libClient.Client, error) {
+					require.Equal(t, "promote", verb)
+					require.Equal(t, "test-name", key.Name)
+					return nil, testCase.authErr
+				},
+			}
+			testCase.assertions(
+				c.Authorize(
+					context.Background(),
+					"promote",
+					schema.GroupVersionResource{
+						Group:    "kargo.akuity.io",
+						Version:  "v1alpha1",
+						Resource: "stages",
+					},
+					"", // No subresource
+					libClient.ObjectKey{
+						Namespace: "test-namespace",
+						Name:      "test-name",
+					},
+				),
+			)
+		})
+	}
+}
+
 func TestGetAuthorizedClient(t *testing.T) {
 	testInternalClient := fake.NewClientBuilder().Build()
 	testCases := []struct {
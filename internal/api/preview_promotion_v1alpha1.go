@@ -0,0 +1,174 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/promotion"
+	"github.com/akuity/kargo/internal/kargo"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// PreviewPromotion executes all of a Stage's promotion mechanisms for the
+// specified Freight without pushing to any Git repositories or patching any
+// Argo CD Applications, and returns the changes that would have been made.
+func (s *server) PreviewPromotion(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.PreviewPromotionRequest],
+) (*connect.Response[svcv1alpha1.PreviewPromotionResponse], error) {
+	if err := validateProjectAndStageNonEmpty(req.Msg.GetProject(), req.Msg.GetStage()); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+	if s.previewPromotionFn == nil {
+		return nil, connect.NewError(
+			connect.CodeUnimplemented,
+			errors.New("promotion previews are not enabled on this server"),
+		)
+	}
+	if err := s.validateProjectFn(ctx, req.Msg.GetProject()); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+
+	// Previews reveal the contents of repositories using credentials that the
+	// user may not otherwise have access to, so only users who are permitted to
+	// promote into the Stage are permitted to preview promotions into it.
+	if err := s.authorizeFn(
+		ctx,
+		"promote",
+		kargoapi.GroupVersion.WithResource("stages"),
+		"", // No subresource
+		client.ObjectKey{
+			Namespace: req.Msg.GetProject(),
+			Name:      req.Msg.GetStage(),
+		},
+	); err != nil {
+		if apierrors.IsForbidden(err) {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	stage, err := s.getStageFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: req.Msg.GetProject(),
+			Name:      req.Msg.GetStage(),
+		},
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if stage == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"Stage %q not found in namespace %q",
+				req.Msg.GetStage(),
+				req.Msg.GetProject(),
+			),
+		)
+	}
+
+	// Get the specified Freight. Expect a nil if it is either not found or is
+	// not qualified for any of the upstream Stages. Errors are internal problems.
+	upstreamStages := make([]string, len(stage.Spec.Subscriptions.UpstreamStages))
+	for i, upstreamStage := range stage.Spec.Subscriptions.UpstreamStages {
+		upstreamStages[i] = upstreamStage.Name
+	}
+	freight, err := s.getQualifiedFreightFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: req.Msg.GetProject(),
+			Name:      req.Msg.GetFreight(),
+		},
+		upstreamStages,
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if freight == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"no qualified Freight %q found in namespace %q",
+				req.Msg.GetFreight(),
+				req.Msg.GetProject(),
+			),
+		)
+	}
+
+	promo := kargo.NewPromotion(*stage, req.Msg.GetFreight())
+	preview, err := s.previewPromotionFn(
+		ctx,
+		stage,
+		&promo,
+		kargoapi.SimpleFreight{
+			ID:      freight.ID,
+			Commits: freight.Commits,
+			Images:  freight.Images,
+			Charts:  freight.Charts,
+		},
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	res := &svcv1alpha1.PreviewPromotionResponse{
+		GitRepoDiffs:   make([]*svcv1alpha1.GitRepoDiff, len(preview.GitRepoDiffs)),
+		ArgoCdAppDiffs: make([]*svcv1alpha1.ArgoCDAppDiff, len(preview.ArgoCDAppDiffs)),
+		Warnings:       preview.Warnings,
+	}
+	for i, diff := range preview.GitRepoDiffs {
+		res.GitRepoDiffs[i] = &svcv1alpha1.GitRepoDiff{
+			RepoUrl:       diff.RepoURL,
+			Branch:        diff.Branch,
+			CommitMessage: diff.CommitMessage,
+			Diff:          diff.Diff,
+		}
+	}
+	for i, diff := range preview.ArgoCDAppDiffs {
+		res.ArgoCdAppDiffs[i] = &svcv1alpha1.ArgoCDAppDiff{
+			Namespace: diff.Namespace,
+			Name:      diff.Name,
+			Diff:      diff.Diff,
+		}
+	}
+	return connect.NewResponse(res), nil
+}
+
+// previewPromotionFn returns a function that closes over the provided
+// promotion mechanisms and, when invoked, executes them in preview mode.
+func previewPromotionFn(
+	promoMechanisms promotion.Mechanism,
+) func(
+	context.Context,
+	*kargoapi.Stage,
+	*kargoapi.Promotion,
+	kargoapi.SimpleFreight,
+) (*promotion.Preview, error) {
+	return func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		promo *kargoapi.Promotion,
+		freight kargoapi.SimpleFreight,
+	) (*promotion.Preview, error) {
+		preview := &promotion.Preview{}
+		if _, _, err := promoMechanisms.Promote(
+			promotion.ContextWithPreview(ctx, preview),
+			stage,
+			promo,
+			freight,
+		); err != nil {
+			return nil, errors.Wrap(err, "error previewing promotion")
+		}
+		return preview, nil
+	}
+}
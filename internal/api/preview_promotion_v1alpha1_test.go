@@ -0,0 +1,352 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/promotion"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestPreviewPromotion(t *testing.T) {
+	testReq := &svcv1alpha1.PreviewPromotionRequest{
+		Project: "fake-project",
+		Stage:   "fake-stage",
+		Freight: "fake-freight",
+	}
+	authorize := func(
+		_ context.Context,
+		verb string,
+		gvr schema.GroupVersionResource,
+		_ string,
+		key client.ObjectKey,
+	) error {
+		require.Equal(t, "promote", verb)
+		require.Equal(t, kargoapi.GroupVersion.WithResource("stages"), gvr)
+		require.Equal(
+			t,
+			client.ObjectKey{Namespace: "fake-project", Name: "fake-stage"},
+			key,
+		)
+		return nil
+	}
+	getStage := func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Stage, error) {
+		return &kargoapi.Stage{
+			Spec: &kargoapi.StageSpec{
+				Subscriptions: &kargoapi.Subscriptions{},
+			},
+		}, nil
+	}
+	getQualifiedFreight := func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+		[]string,
+	) (*kargoapi.Freight, error) {
+		return &kargoapi.Freight{
+			ID: "fake-freight",
+		}, nil
+	}
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.PreviewPromotionRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.PreviewPromotionResponse], error)
+	}{
+		{
+			name:   "input validation error",
+			req:    &svcv1alpha1.PreviewPromotionRequest{},
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PreviewPromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name:   "previews not enabled",
+			req:    testReq,
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PreviewPromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeUnimplemented, connErr.Code())
+			},
+		},
+		{
+			name: "Stage not found",
+			req:  testReq,
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				authorizeFn: authorize,
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return nil, nil
+				},
+				previewPromotionFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.Promotion,
+					kargoapi.SimpleFreight,
+				) (*promotion.Preview, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PreviewPromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+				require.Contains(t, connErr.Message(), "Stage")
+			},
+		},
+		{
+			name: "qualified Freight not found",
+			req:  testReq,
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				authorizeFn: authorize,
+				getStageFn:  getStage,
+				getQualifiedFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+					[]string,
+				) (*kargoapi.Freight, error) {
+					return nil, nil
+				},
+				previewPromotionFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.Promotion,
+					kargoapi.SimpleFreight,
+				) (*promotion.Preview, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PreviewPromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+				require.Contains(t, connErr.Message(), "no qualified Freight")
+			},
+		},
+		{
+			name: "user not permitted to promote",
+			req:  testReq,
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return apierrors.NewForbidden(
+						schema.GroupResource{Group: kargoapi.GroupVersion.Group, Resource: "stages"},
+						"fake-stage",
+						errors.New("promote is not permitted"),
+					)
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					require.Fail(t, "Stage should not have been retrieved")
+					return nil, nil
+				},
+				previewPromotionFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.Promotion,
+					kargoapi.SimpleFreight,
+				) (*promotion.Preview, error) {
+					require.Fail(t, "promotion should not have been previewed")
+					return nil, nil
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PreviewPromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodePermissionDenied, connErr.Code())
+				require.Contains(t, connErr.Message(), "promote is not permitted")
+			},
+		},
+		{
+			name: "error authorizing user",
+			req:  testReq,
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return errors.New("something went wrong")
+				},
+				previewPromotionFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.Promotion,
+					kargoapi.SimpleFreight,
+				) (*promotion.Preview, error) {
+					require.Fail(t, "promotion should not have been previewed")
+					return nil, nil
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PreviewPromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInternal, connErr.Code())
+				require.Equal(t, "something went wrong", connErr.Message())
+			},
+		},
+		{
+			name: "error previewing promotion",
+			req:  testReq,
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				authorizeFn:           authorize,
+				getStageFn:            getStage,
+				getQualifiedFreightFn: getQualifiedFreight,
+				previewPromotionFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.Promotion,
+					kargoapi.SimpleFreight,
+				) (*promotion.Preview, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.PreviewPromotionResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInternal, connErr.Code())
+				require.Equal(t, "something went wrong", connErr.Message())
+			},
+		},
+		{
+			name: "success",
+			req:  testReq,
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return nil
+				},
+				authorizeFn:           authorize,
+				getStageFn:            getStage,
+				getQualifiedFreightFn: getQualifiedFreight,
+				previewPromotionFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					_ *kargoapi.Promotion,
+					freight kargoapi.SimpleFreight,
+				) (*promotion.Preview, error) {
+					require.Equal(t, "fake-freight", freight.ID)
+					return &promotion.Preview{
+						GitRepoDiffs: []promotion.GitRepoDiff{
+							{
+								RepoURL:       "fake-repo-url",
+								Branch:        "fake-branch",
+								CommitMessage: "fake-message",
+								Diff:          "fake-diff",
+							},
+						},
+						ArgoCDAppDiffs: []promotion.ArgoCDAppDiff{
+							{
+								Namespace: "fake-namespace",
+								Name:      "fake-app",
+								Diff:      "fake-diff",
+							},
+						},
+						Warnings: []string{"fake-warning"},
+					}, nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.PreviewPromotionResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Len(t, res.Msg.GetGitRepoDiffs(), 1)
+				require.Equal(t, "fake-repo-url", res.Msg.GetGitRepoDiffs()[0].GetRepoUrl())
+				require.Equal(t, "fake-branch", res.Msg.GetGitRepoDiffs()[0].GetBranch())
+				require.Equal(
+					t,
+					"fake-message",
+					res.Msg.GetGitRepoDiffs()[0].GetCommitMessage(),
+				)
+				require.Equal(t, "fake-diff", res.Msg.GetGitRepoDiffs()[0].GetDiff())
+				require.Len(t, res.Msg.GetArgoCdAppDiffs(), 1)
+				require.Equal(t, "fake-app", res.Msg.GetArgoCdAppDiffs()[0].GetName())
+				require.Equal(t, []string{"fake-warning"}, res.Msg.GetWarnings())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.server.PreviewPromotion(
+					context.Background(),
+					connect.NewRequest(testCase.req),
+				),
+			)
+		})
+	}
+}
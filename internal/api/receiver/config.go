@@ -0,0 +1,18 @@
+package receiver
+
+import "github.com/kelseyhightower/envconfig"
+
+// Config represents configuration for the receiver of inbound webhooks.
+type Config struct {
+	// Secret is used to authenticate inbound webhooks. Depending on the sender,
+	// it is either the key used to compute an HMAC signature of the payload or a
+	// token that must be presented verbatim.
+	Secret string `envconfig:"WEBHOOK_RECEIVER_SECRET" required:"true"`
+}
+
+// ConfigFromEnv returns a Config populated from environment variables.
+func ConfigFromEnv() Config {
+	cfg := Config{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
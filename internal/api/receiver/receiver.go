@@ -0,0 +1,243 @@
+package receiver
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/distribution/distribution/v3/reference"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/internal/git"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// PathPrefix is the path under which the receiver handles inbound webhooks.
+// The remainder of the path identifies the sender. e.g. /webhook/github
+const PathPrefix = "/webhook/"
+
+// maxPayloadBytes is the size of the largest payload that will be accepted.
+// This matches the largest payload GitHub will send.
+const maxPayloadBytes = 25 << 20
+
+// event describes the repositories that an inbound webhook reported changes
+// to.
+type event struct {
+	// gitRepoURLs are the URLs of Git repositories that were pushed to.
+	gitRepoURLs []string
+	// imageRepos are the names, including the registry, of image repositories
+	// that were pushed to. These may also host Helm charts.
+	imageRepos []string
+}
+
+// receiver is an http.Handler that refreshes all Warehouses subscribed to any
+// repository that an inbound webhook reports changes to.
+type receiver struct {
+	cfg    Config
+	client client.Client
+
+	// senders maps the last element of the request path to the sender that is
+	// expected to send requests to it.
+	senders map[string]sender
+
+	// The following behaviors are overridable for testing purposes:
+
+	listWarehousesFn func(
+		context.Context,
+		client.ObjectList,
+		...client.ListOption,
+	) error
+
+	refreshWarehouseFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Warehouse, error)
+}
+
+// NewHandler returns an http.Handler that receives webhooks from Git hosting
+// providers and container registries and refreshes any Warehouses subscribed
+// to the repositories they report changes to. The handler should be mounted
+// at PathPrefix.
+func NewHandler(cfg Config, kubeClient client.Client) http.Handler {
+	return &receiver{
+		cfg:                cfg,
+		client:             kubeClient,
+		senders:            senders,
+		listWarehousesFn:   kubeClient.List,
+		refreshWarehouseFn: kargoapi.RefreshWarehouse,
+	}
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	name := strings.TrimPrefix(req.URL.Path, PathPrefix)
+	logger := logging.LoggerFromContext(req.Context()).WithField("sender", name)
+
+	s, ok := r.senders[name]
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxPayloadBytes))
+	if err != nil {
+		http.Error(w, "error reading payload", http.StatusBadRequest)
+		return
+	}
+	if !s.authenticate(req, body, r.cfg.Secret) {
+		logger.Debug("rejected unauthenticated webhook")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	ev, err := s.parse(req, body)
+	if err != nil {
+		logger.Debugf("rejected malformed webhook: %s", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	refreshed, err := r.refreshWarehouses(req.Context(), ev)
+	if err != nil {
+		logger.Errorf("error refreshing Warehouses: %s", err)
+		http.Error(w, "error refreshing Warehouses", http.StatusInternalServerError)
+		return
+	}
+	logger.WithFields(log.Fields{
+		"gitRepoURLs": ev.gitRepoURLs,
+		"imageRepos":  ev.imageRepos,
+		"warehouses":  refreshed,
+	}).Debug("handled webhook")
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(struct {
+		RefreshedWarehouses []string `json:"refreshedWarehouses"`
+	}{
+		RefreshedWarehouses: refreshed,
+	})
+}
+
+// refreshWarehouses refreshes every Warehouse, in any project, that subscribes
+// to any of the repositories described by the provided event. The namespaced
+// names of all refreshed Warehouses are returned.
+func (r *receiver) refreshWarehouses(
+	ctx context.Context,
+	ev event,
+) ([]string, error) {
+	refreshed := []string{}
+	if len(ev.gitRepoURLs) == 0 && len(ev.imageRepos) == 0 {
+		return refreshed, nil
+	}
+	gitRepoURLs := make(map[string]struct{}, len(ev.gitRepoURLs))
+	for _, u := range ev.gitRepoURLs {
+		gitRepoURLs[normalizeGitURL(u)] = struct{}{}
+	}
+	imageRepos := make(map[string]struct{}, len(ev.imageRepos))
+	for _, repo := range ev.imageRepos {
+		imageRepos[normalizeImageRepo(repo)] = struct{}{}
+	}
+
+	// Senders are authenticated by the shared secret rather than as API users,
+	// so the receiver acts with the API server's own permissions.
+	ctx = user.ContextWithInfo(ctx, user.Info{IsAdmin: true})
+
+	warehouses := &kargoapi.WarehouseList{}
+	if err := r.listWarehousesFn(ctx, warehouses); err != nil {
+		return nil, errors.Wrap(err, "error listing Warehouses")
+	}
+	for _, warehouse := range warehouses.Items {
+		if !subscribesToAny(warehouse, gitRepoURLs, imageRepos) {
+			continue
+		}
+		key := types.NamespacedName{
+			Namespace: warehouse.Namespace,
+			Name:      warehouse.Name,
+		}
+		if _, err := r.refreshWarehouseFn(ctx, r.client, key); err != nil {
+			return refreshed, errors.Wrapf(err, "error refreshing Warehouse %q", key)
+		}
+		refreshed = append(refreshed, key.String())
+	}
+	return refreshed, nil
+}
+
+// subscribesToAny returns true if any of the provided Warehouse's
+// subscriptions is to one of the provided (normalized) Git repository URLs or
+// image repositories. Subscriptions to Helm charts hosted in OCI registries
+// are matched against the image repositories.
+func subscribesToAny(
+	warehouse kargoapi.Warehouse,
+	gitRepoURLs map[string]struct{},
+	imageRepos map[string]struct{},
+) bool {
+	if warehouse.Spec == nil {
+		return false
+	}
+	for _, sub := range warehouse.Spec.Subscriptions {
+		switch {
+		case sub.Git != nil:
+			if _, ok := gitRepoURLs[normalizeGitURL(sub.Git.RepoURL)]; ok {
+				return true
+			}
+		case sub.Image != nil:
+			if _, ok := imageRepos[normalizeImageRepo(sub.Image.RepoURL)]; ok {
+				return true
+			}
+		case sub.Chart != nil:
+			if !strings.HasPrefix(sub.Chart.RegistryURL, "oci://") {
+				continue
+			}
+			repo := strings.TrimSuffix(
+				strings.TrimPrefix(sub.Chart.RegistryURL, "oci://"),
+				"/",
+			) + "/" + sub.Chart.Name
+			if _, ok := imageRepos[normalizeImageRepo(repo)]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// normalizeGitURL returns a form of the provided Git repository URL that is
+// independent of the protocol and user used to access the repository, so that
+// e.g. HTTPS and SSH URLs for the same repository are equal.
+func normalizeGitURL(repoURL string) string {
+	normalized := git.NormalizeGitURL(repoURL)
+	if i := strings.Index(normalized, "://"); i >= 0 {
+		normalized = normalized[i+3:]
+	}
+	if i := strings.Index(normalized, "@"); i >= 0 &&
+		i < strings.Index(normalized, "/") {
+		normalized = normalized[i+1:]
+	}
+	return strings.TrimSuffix(normalized, "/")
+}
+
+// normalizeImageRepo returns the fully qualified name of the provided image
+// repository, without any tag or digest, such that e.g. "nginx" and
+// "docker.io/library/nginx:1.25" are equal.
+func normalizeImageRepo(repo string) string {
+	repo = strings.ToLower(strings.TrimSpace(repo))
+	for _, alias := range []string{"index.docker.io/", "registry-1.docker.io/"} {
+		if strings.HasPrefix(repo, alias) {
+			repo = "docker.io/" + strings.TrimPrefix(repo, alias)
+		}
+	}
+	named, err := reference.ParseNormalizedNamed(repo)
+	if err != nil {
+		return repo
+	}
+	return named.Name()
+}
@@ -0,0 +1,327 @@
+package receiver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewHandler(t *testing.T) {
+	cfg := Config{Secret: testSecret}
+	kubeClient := fake.NewClientBuilder().Build()
+	r, ok := NewHandler(cfg, kubeClient).(*receiver)
+	require.True(t, ok)
+	require.Equal(t, cfg, r.cfg)
+	require.Same(t, kubeClient, r.client)
+	require.NotEmpty(t, r.senders)
+	// Assert that all overridable behaviors were initialized to a default:
+	require.NotNil(t, r.listWarehousesFn)
+	require.NotNil(t, r.refreshWarehouseFn)
+}
+
+func TestServeHTTP(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	newWarehouse := func(
+		namespace string,
+		name string,
+		sub kargoapi.RepoSubscription,
+	) *kargoapi.Warehouse {
+		return &kargoapi.Warehouse{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+			Spec: &kargoapi.WarehouseSpec{
+				Subscriptions: []kargoapi.RepoSubscription{sub},
+			},
+		}
+	}
+	gitPushBody := `{"repository":{"clone_url":"https://github.com/example/repo.git"}}`
+
+	testCases := []struct {
+		name       string
+		method     string
+		path       string
+		headers    map[string]string
+		body       string
+		objects    []client.Object
+		assertions func(*httptest.ResponseRecorder, client.Client)
+	}{
+		{
+			name:   "unknown sender",
+			method: http.MethodPost,
+			path:   PathPrefix + "bogus",
+			assertions: func(rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusNotFound, rr.Code)
+			},
+		},
+		{
+			name:   "method not allowed",
+			method: http.MethodGet,
+			path:   PathPrefix + "github",
+			assertions: func(rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+			},
+		},
+		{
+			name:   "unauthenticated",
+			method: http.MethodPost,
+			path:   PathPrefix + "github",
+			headers: map[string]string{
+				"X-GitHub-Event":      "push",
+				"X-Hub-Signature-256": sign([]byte("bogus")),
+			},
+			body: gitPushBody,
+			assertions: func(rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusUnauthorized, rr.Code)
+			},
+		},
+		{
+			name:   "malformed payload",
+			method: http.MethodPost,
+			path:   PathPrefix + "github",
+			headers: map[string]string{
+				"X-GitHub-Event":      "push",
+				"X-Hub-Signature-256": sign([]byte("bogus")),
+			},
+			body: "bogus",
+			assertions: func(rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusBadRequest, rr.Code)
+			},
+		},
+		{
+			name:   "git push refreshes subscribed Warehouses in all projects",
+			method: http.MethodPost,
+			path:   PathPrefix + "github",
+			headers: map[string]string{
+				"X-GitHub-Event":      "push",
+				"X-Hub-Signature-256": sign([]byte(gitPushBody)),
+			},
+			body: gitPushBody,
+			objects: []client.Object{
+				newWarehouse("project-a", "https", kargoapi.RepoSubscription{
+					Git: &kargoapi.GitSubscription{
+						RepoURL: "https://github.com/example/repo",
+					},
+				}),
+				newWarehouse("project-b", "ssh", kargoapi.RepoSubscription{
+					Git: &kargoapi.GitSubscription{
+						RepoURL: "git@github.com:example/repo.git",
+					},
+				}),
+				newWarehouse("project-a", "other", kargoapi.RepoSubscription{
+					Git: &kargoapi.GitSubscription{
+						RepoURL: "https://github.com/example/other.git",
+					},
+				}),
+			},
+			assertions: func(rr *httptest.ResponseRecorder, c client.Client) {
+				require.Equal(t, http.StatusOK, rr.Code)
+				require.JSONEq(
+					t,
+					`{"refreshedWarehouses":["project-a/https","project-b/ssh"]}`,
+					rr.Body.String(),
+				)
+				for _, key := range []client.ObjectKey{
+					{Namespace: "project-a", Name: "https"},
+					{Namespace: "project-b", Name: "ssh"},
+				} {
+					warehouse := &kargoapi.Warehouse{}
+					require.NoError(t, c.Get(context.Background(), key, warehouse))
+					require.NotEmpty(
+						t,
+						warehouse.Annotations[kargoapi.AnnotationKeyRefresh],
+					)
+				}
+				warehouse := &kargoapi.Warehouse{}
+				require.NoError(
+					t,
+					c.Get(
+						context.Background(),
+						client.ObjectKey{Namespace: "project-a", Name: "other"},
+						warehouse,
+					),
+				)
+				require.Empty(t, warehouse.Annotations[kargoapi.AnnotationKeyRefresh])
+			},
+		},
+		{
+			name:   "image push refreshes subscribed Warehouses",
+			method: http.MethodPost,
+			path:   PathPrefix + "dockerhub?token=" + testSecret,
+			body:   `{"repository":{"repo_name":"library/nginx"}}`,
+			objects: []client.Object{
+				newWarehouse("project-a", "image", kargoapi.RepoSubscription{
+					Image: &kargoapi.ImageSubscription{RepoURL: "nginx"},
+				}),
+			},
+			assertions: func(rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusOK, rr.Code)
+				require.JSONEq(
+					t,
+					`{"refreshedWarehouses":["project-a/image"]}`,
+					rr.Body.String(),
+				)
+			},
+		},
+		{
+			name:   "ping refreshes nothing",
+			method: http.MethodPost,
+			path:   PathPrefix + "github",
+			headers: map[string]string{
+				"X-GitHub-Event":      "ping",
+				"X-Hub-Signature-256": sign([]byte("{}")),
+			},
+			body: "{}",
+			assertions: func(rr *httptest.ResponseRecorder, _ client.Client) {
+				require.Equal(t, http.StatusOK, rr.Code)
+				require.JSONEq(t, `{"refreshedWarehouses":[]}`, rr.Body.String())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			kubeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testCase.objects...).
+				Build()
+			req := httptest.NewRequest(
+				testCase.method,
+				testCase.path,
+				strings.NewReader(testCase.body),
+			)
+			for k, v := range testCase.headers {
+				req.Header.Set(k, v)
+			}
+			rr := httptest.NewRecorder()
+			NewHandler(Config{Secret: testSecret}, kubeClient).ServeHTTP(rr, req)
+			testCase.assertions(rr, kubeClient)
+		})
+	}
+}
+
+func TestRefreshWarehousesError(t *testing.T) {
+	r := &receiver{
+		listWarehousesFn: func(
+			context.Context,
+			client.ObjectList,
+			...client.ListOption,
+		) error {
+			return errors.New("something went wrong")
+		},
+	}
+	_, err := r.refreshWarehouses(
+		context.Background(),
+		event{gitRepoURLs: []string{"https://github.com/example/repo"}},
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "error listing Warehouses")
+	require.Contains(t, err.Error(), "something went wrong")
+}
+
+func TestSubscribesToAny(t *testing.T) {
+	gitRepoURLs := map[string]struct{}{
+		normalizeGitURL("https://github.com/example/repo.git"): {},
+	}
+	imageRepos := map[string]struct{}{
+		normalizeImageRepo("ghcr.io/example/app:1.0.0"):  {},
+		normalizeImageRepo("ghcr.io/example/charts/app"): {},
+	}
+	testCases := []struct {
+		name     string
+		sub      kargoapi.RepoSubscription
+		expected bool
+	}{
+		{
+			name: "git subscription to other repo",
+			sub: kargoapi.RepoSubscription{
+				Git: &kargoapi.GitSubscription{
+					RepoURL: "https://github.com/example/other",
+				},
+			},
+		},
+		{
+			name: "git subscription using SSH",
+			sub: kargoapi.RepoSubscription{
+				Git: &kargoapi.GitSubscription{
+					RepoURL: "ssh://git@github.com/example/repo.git",
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "image subscription",
+			sub: kargoapi.RepoSubscription{
+				Image: &kargoapi.ImageSubscription{
+					RepoURL: "ghcr.io/example/app",
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "chart subscription to OCI registry",
+			sub: kargoapi.RepoSubscription{
+				Chart: &kargoapi.ChartSubscription{
+					RegistryURL: "oci://ghcr.io/example/charts",
+					Name:        "app",
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "chart subscription to classic chart repository",
+			sub: kargoapi.RepoSubscription{
+				Chart: &kargoapi.ChartSubscription{
+					RegistryURL: "https://ghcr.io/example/charts",
+					Name:        "app",
+				},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				subscribesToAny(
+					kargoapi.Warehouse{
+						Spec: &kargoapi.WarehouseSpec{
+							Subscriptions: []kargoapi.RepoSubscription{testCase.sub},
+						},
+					},
+					gitRepoURLs,
+					imageRepos,
+				),
+			)
+		})
+	}
+}
+
+func TestNormalizeImageRepo(t *testing.T) {
+	testCases := map[string]string{
+		"nginx":                         "docker.io/library/nginx",
+		"library/nginx:1.25":            "docker.io/library/nginx",
+		"index.docker.io/library/nginx": "docker.io/library/nginx",
+		"Example/App@sha256:" + strings.Repeat("a", 64): "docker.io/example/app",
+		"harbor.example.com/project/app:1.0.0":          "harbor.example.com/project/app",
+		"localhost:5000/app":                            "localhost:5000/app",
+	}
+	for input, expected := range testCases {
+		t.Run(input, func(t *testing.T) {
+			require.Equal(t, expected, normalizeImageRepo(input))
+		})
+	}
+}
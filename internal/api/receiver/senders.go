@@ -0,0 +1,297 @@
+package receiver
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// sender describes how to authenticate and interpret webhooks from one kind
+// of Git hosting provider or container registry.
+type sender struct {
+	// authenticate returns true if the provided request, whose payload has
+	// already been read, was sent by a party that knows the provided secret.
+	authenticate func(req *http.Request, body []byte, secret string) bool
+	// parse returns an event describing the repositories the provided request
+	// reports changes to. Requests that do not report changes to any
+	// repository, such as pings, result in an empty event.
+	parse func(req *http.Request, body []byte) (event, error)
+}
+
+// senders maps the last element of the request path to the sender that is
+// expected to send requests to it.
+var senders = map[string]sender{
+	"github": {
+		authenticate: hmacSHA256Authenticator("X-Hub-Signature-256"),
+		parse:        parseGitHubEvent,
+	},
+	"gitlab": {
+		authenticate: headerTokenAuthenticator("X-Gitlab-Token"),
+		parse:        parseGitLabEvent,
+	},
+	"bitbucket": {
+		authenticate: hmacSHA256Authenticator("X-Hub-Signature"),
+		parse:        parseBitbucketEvent,
+	},
+	"dockerhub": {
+		// Docker Hub does not support signing or authenticating webhooks, so the
+		// secret must be included in the webhook's URL.
+		authenticate: queryTokenAuthenticator,
+		parse:        parseDockerHubEvent,
+	},
+	"harbor": {
+		authenticate: headerTokenAuthenticator("Authorization"),
+		parse:        parseHarborEvent,
+	},
+	"quay": {
+		// Quay does not support signing or authenticating webhooks, so the secret
+		// must be included in the webhook's URL.
+		authenticate: queryTokenAuthenticator,
+		parse:        parseQuayEvent,
+	},
+	"registry": {
+		authenticate: headerTokenAuthenticator("Authorization"),
+		parse:        parseRegistryEvent,
+	},
+}
+
+// hmacSHA256Authenticator returns a function that authenticates requests
+// bearing, in the specified header, a hex-encoded HMAC-SHA256 signature of the
+// payload prefixed with "sha256=", as sent by GitHub and Bitbucket.
+func hmacSHA256Authenticator(
+	header string,
+) func(*http.Request, []byte, string) bool {
+	return func(req *http.Request, body []byte, secret string) bool {
+		sig, ok := strings.CutPrefix(req.Header.Get(header), "sha256=")
+		if !ok {
+			return false
+		}
+		actual, err := hex.DecodeString(sig)
+		if err != nil {
+			return false
+		}
+		mac := hmac.New(sha256.New, []byte(secret))
+		_, _ = mac.Write(body)
+		return hmac.Equal(actual, mac.Sum(nil))
+	}
+}
+
+// headerTokenAuthenticator returns a function that authenticates requests
+// bearing the secret in the specified header, optionally prefixed with
+// "Bearer ".
+func headerTokenAuthenticator(
+	header string,
+) func(*http.Request, []byte, string) bool {
+	return func(req *http.Request, _ []byte, secret string) bool {
+		token := strings.TrimPrefix(req.Header.Get(header), "Bearer ")
+		return tokensEqual(token, secret)
+	}
+}
+
+// queryTokenAuthenticator authenticates requests bearing the secret in the
+// "token" query parameter.
+func queryTokenAuthenticator(req *http.Request, _ []byte, secret string) bool {
+	return tokensEqual(req.URL.Query().Get("token"), secret)
+}
+
+// tokensEqual compares the provided token to the provided secret in constant
+// time. An empty secret never matches.
+func tokensEqual(token, secret string) bool {
+	return secret != "" &&
+		subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+}
+
+// parseGitHubEvent parses a GitHub push event.
+func parseGitHubEvent(req *http.Request, body []byte) (event, error) {
+	if req.Header.Get("X-GitHub-Event") != "push" {
+		return event{}, nil
+	}
+	payload := struct {
+		Repository struct {
+			CloneURL string `json:"clone_url"`
+			SSHURL   string `json:"ssh_url"`
+			HTMLURL  string `json:"html_url"`
+		} `json:"repository"`
+	}{}
+	if err := unmarshalPayload(body, &payload); err != nil {
+		return event{}, err
+	}
+	return gitEvent(
+		payload.Repository.CloneURL,
+		payload.Repository.SSHURL,
+		payload.Repository.HTMLURL,
+	)
+}
+
+// parseGitLabEvent parses a GitLab push or tag push event.
+func parseGitLabEvent(req *http.Request, body []byte) (event, error) {
+	switch req.Header.Get("X-Gitlab-Event") {
+	case "Push Hook", "Tag Push Hook":
+	default:
+		return event{}, nil
+	}
+	payload := struct {
+		Project struct {
+			GitHTTPURL string `json:"git_http_url"`
+			GitSSHURL  string `json:"git_ssh_url"`
+			WebURL     string `json:"web_url"`
+		} `json:"project"`
+	}{}
+	if err := unmarshalPayload(body, &payload); err != nil {
+		return event{}, err
+	}
+	return gitEvent(
+		payload.Project.GitHTTPURL,
+		payload.Project.GitSSHURL,
+		payload.Project.WebURL,
+	)
+}
+
+// parseBitbucketEvent parses a Bitbucket Cloud push event or a Bitbucket
+// Server (Data Center) refs changed event.
+func parseBitbucketEvent(req *http.Request, body []byte) (event, error) {
+	switch req.Header.Get("X-Event-Key") {
+	case "repo:push", "repo:refs_changed":
+	default:
+		return event{}, nil
+	}
+	payload := struct {
+		Repository struct {
+			Links struct {
+				// Bitbucket Cloud
+				HTML struct {
+					Href string `json:"href"`
+				} `json:"html"`
+				// Bitbucket Server
+				Clone []struct {
+					Href string `json:"href"`
+				} `json:"clone"`
+			} `json:"links"`
+		} `json:"repository"`
+	}{}
+	if err := unmarshalPayload(body, &payload); err != nil {
+		return event{}, err
+	}
+	urls := []string{payload.Repository.Links.HTML.Href}
+	for _, clone := range payload.Repository.Links.Clone {
+		urls = append(urls, clone.Href)
+	}
+	return gitEvent(urls...)
+}
+
+// parseDockerHubEvent parses a Docker Hub push event.
+func parseDockerHubEvent(_ *http.Request, body []byte) (event, error) {
+	payload := struct {
+		Repository struct {
+			RepoName string `json:"repo_name"`
+		} `json:"repository"`
+	}{}
+	if err := unmarshalPayload(body, &payload); err != nil {
+		return event{}, err
+	}
+	if payload.Repository.RepoName == "" {
+		return event{}, errors.New("payload does not specify a repository")
+	}
+	return event{
+		imageRepos: []string{"docker.io/" + payload.Repository.RepoName},
+	}, nil
+}
+
+// parseHarborEvent parses a Harbor artifact push event.
+func parseHarborEvent(_ *http.Request, body []byte) (event, error) {
+	payload := struct {
+		Type      string `json:"type"`
+		EventData struct {
+			Resources []struct {
+				ResourceURL string `json:"resource_url"`
+			} `json:"resources"`
+		} `json:"event_data"`
+	}{}
+	if err := unmarshalPayload(body, &payload); err != nil {
+		return event{}, err
+	}
+	if payload.Type != "PUSH_ARTIFACT" && payload.Type != "pushImage" {
+		return event{}, nil
+	}
+	var ev event
+	for _, resource := range payload.EventData.Resources {
+		if resource.ResourceURL != "" {
+			ev.imageRepos = append(ev.imageRepos, resource.ResourceURL)
+		}
+	}
+	if len(ev.imageRepos) == 0 {
+		return event{}, errors.New("payload does not specify a repository")
+	}
+	return ev, nil
+}
+
+// parseQuayEvent parses a Quay repository push event.
+func parseQuayEvent(_ *http.Request, body []byte) (event, error) {
+	payload := struct {
+		DockerURL string `json:"docker_url"`
+	}{}
+	if err := unmarshalPayload(body, &payload); err != nil {
+		return event{}, err
+	}
+	if payload.DockerURL == "" {
+		return event{}, errors.New("payload does not specify a repository")
+	}
+	return event{imageRepos: []string{payload.DockerURL}}, nil
+}
+
+// parseRegistryEvent parses a notification, as sent by the CNCF Distribution
+// registry and compatible OCI registries, and reports every repository that
+// was pushed to.
+func parseRegistryEvent(_ *http.Request, body []byte) (event, error) {
+	payload := struct {
+		Events []struct {
+			Action string `json:"action"`
+			Target struct {
+				Repository string `json:"repository"`
+			} `json:"target"`
+			Request struct {
+				Host string `json:"host"`
+			} `json:"request"`
+		} `json:"events"`
+	}{}
+	if err := unmarshalPayload(body, &payload); err != nil {
+		return event{}, err
+	}
+	var ev event
+	for _, e := range payload.Events {
+		if e.Action != "push" || e.Target.Repository == "" {
+			continue
+		}
+		repo := e.Target.Repository
+		if e.Request.Host != "" {
+			repo = e.Request.Host + "/" + repo
+		}
+		ev.imageRepos = append(ev.imageRepos, repo)
+	}
+	return ev, nil
+}
+
+// gitEvent returns an event for the Git repository with the provided URLs,
+// ignoring any empty ones. An error is returned if all are empty.
+func gitEvent(urls ...string) (event, error) {
+	var ev event
+	for _, u := range urls {
+		if u != "" {
+			ev.gitRepoURLs = append(ev.gitRepoURLs, u)
+		}
+	}
+	if len(ev.gitRepoURLs) == 0 {
+		return event{}, errors.New("payload does not specify a repository")
+	}
+	return ev, nil
+}
+
+func unmarshalPayload(body []byte, payload any) error {
+	return errors.Wrap(json.Unmarshal(body, payload), "error parsing payload")
+}
@@ -0,0 +1,324 @@
+package receiver
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "fake-secret"
+
+func sign(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(testSecret))
+	_, _ = mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestHMACSHA256Authenticator(t *testing.T) {
+	body := []byte(`{"fake":"payload"}`)
+	testCases := []struct {
+		name      string
+		signature string
+		expected  bool
+	}{
+		{
+			name:     "no signature",
+			expected: false,
+		},
+		{
+			name:      "signature without prefix",
+			signature: sign(body)[len("sha256="):],
+			expected:  false,
+		},
+		{
+			name:      "signature not hex-encoded",
+			signature: "sha256=bogus",
+			expected:  false,
+		},
+		{
+			name:      "signature of other payload",
+			signature: sign([]byte("bogus")),
+			expected:  false,
+		},
+		{
+			name:      "valid signature",
+			signature: sign(body),
+			expected:  true,
+		},
+	}
+	authenticate := hmacSHA256Authenticator("X-Hub-Signature-256")
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			if testCase.signature != "" {
+				req.Header.Set("X-Hub-Signature-256", testCase.signature)
+			}
+			require.Equal(t, testCase.expected, authenticate(req, body, testSecret))
+		})
+	}
+}
+
+func TestHeaderTokenAuthenticator(t *testing.T) {
+	testCases := []struct {
+		name     string
+		token    string
+		secret   string
+		expected bool
+	}{
+		{
+			name:     "no token",
+			secret:   testSecret,
+			expected: false,
+		},
+		{
+			name:     "wrong token",
+			token:    "bogus",
+			secret:   testSecret,
+			expected: false,
+		},
+		{
+			name:     "empty secret",
+			secret:   "",
+			expected: false,
+		},
+		{
+			name:     "valid token",
+			token:    testSecret,
+			secret:   testSecret,
+			expected: true,
+		},
+		{
+			name:     "valid bearer token",
+			token:    "Bearer " + testSecret,
+			secret:   testSecret,
+			expected: true,
+		},
+	}
+	authenticate := headerTokenAuthenticator("Authorization")
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.Header.Set("Authorization", testCase.token)
+			require.Equal(t, testCase.expected, authenticate(req, nil, testCase.secret))
+		})
+	}
+}
+
+func TestQueryTokenAuthenticator(t *testing.T) {
+	require.False(
+		t,
+		queryTokenAuthenticator(
+			httptest.NewRequest(http.MethodPost, "/?token=bogus", nil),
+			nil,
+			testSecret,
+		),
+	)
+	require.True(
+		t,
+		queryTokenAuthenticator(
+			httptest.NewRequest(http.MethodPost, "/?token="+testSecret, nil),
+			nil,
+			testSecret,
+		),
+	)
+}
+
+func TestParseEvents(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sender     string
+		headers    map[string]string
+		body       string
+		assertions func(event, error)
+	}{
+		{
+			name:    "GitHub ping",
+			sender:  "github",
+			headers: map[string]string{"X-GitHub-Event": "ping"},
+			body:    `{"zen":"Keep it logically awesome."}`,
+			assertions: func(ev event, err error) {
+				require.NoError(t, err)
+				require.Empty(t, ev.gitRepoURLs)
+				require.Empty(t, ev.imageRepos)
+			},
+		},
+		{
+			name:    "GitHub push with malformed payload",
+			sender:  "github",
+			headers: map[string]string{"X-GitHub-Event": "push"},
+			body:    `bogus`,
+			assertions: func(_ event, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing payload")
+			},
+		},
+		{
+			name:    "GitHub push without repository",
+			sender:  "github",
+			headers: map[string]string{"X-GitHub-Event": "push"},
+			body:    `{}`,
+			assertions: func(_ event, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "does not specify a repository")
+			},
+		},
+		{
+			name:    "GitHub push",
+			sender:  "github",
+			headers: map[string]string{"X-GitHub-Event": "push"},
+			body: `{"repository":{` +
+				`"clone_url":"https://github.com/example/repo.git",` +
+				`"ssh_url":"git@github.com:example/repo.git",` +
+				`"html_url":"https://github.com/example/repo"}}`,
+			assertions: func(ev event, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"https://github.com/example/repo.git",
+						"git@github.com:example/repo.git",
+						"https://github.com/example/repo",
+					},
+					ev.gitRepoURLs,
+				)
+			},
+		},
+		{
+			name:    "GitLab tag push",
+			sender:  "gitlab",
+			headers: map[string]string{"X-Gitlab-Event": "Tag Push Hook"},
+			body: `{"project":{` +
+				`"git_http_url":"https://gitlab.com/example/repo.git",` +
+				`"git_ssh_url":"git@gitlab.com:example/repo.git"}}`,
+			assertions: func(ev event, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"https://gitlab.com/example/repo.git",
+						"git@gitlab.com:example/repo.git",
+					},
+					ev.gitRepoURLs,
+				)
+			},
+		},
+		{
+			name:    "GitLab merge request",
+			sender:  "gitlab",
+			headers: map[string]string{"X-Gitlab-Event": "Merge Request Hook"},
+			body:    `{}`,
+			assertions: func(ev event, err error) {
+				require.NoError(t, err)
+				require.Empty(t, ev.gitRepoURLs)
+			},
+		},
+		{
+			name:    "Bitbucket Cloud push",
+			sender:  "bitbucket",
+			headers: map[string]string{"X-Event-Key": "repo:push"},
+			body: `{"repository":{"links":{"html":{` +
+				`"href":"https://bitbucket.org/example/repo"}}}}`,
+			assertions: func(ev event, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{"https://bitbucket.org/example/repo"},
+					ev.gitRepoURLs,
+				)
+			},
+		},
+		{
+			name:    "Bitbucket Server refs changed",
+			sender:  "bitbucket",
+			headers: map[string]string{"X-Event-Key": "repo:refs_changed"},
+			body: `{"repository":{"links":{"clone":[` +
+				`{"href":"https://bitbucket.example.com/scm/ex/repo.git"},` +
+				`{"href":"ssh://git@bitbucket.example.com:7999/ex/repo.git"}]}}}`,
+			assertions: func(ev event, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"https://bitbucket.example.com/scm/ex/repo.git",
+						"ssh://git@bitbucket.example.com:7999/ex/repo.git",
+					},
+					ev.gitRepoURLs,
+				)
+			},
+		},
+		{
+			name:   "Docker Hub push",
+			sender: "dockerhub",
+			body:   `{"push_data":{"tag":"1.0.0"},"repository":{"repo_name":"example/app"}}`,
+			assertions: func(ev event, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"docker.io/example/app"}, ev.imageRepos)
+			},
+		},
+		{
+			name:   "Harbor push",
+			sender: "harbor",
+			body: `{"type":"PUSH_ARTIFACT","event_data":{"resources":[` +
+				`{"resource_url":"harbor.example.com/project/app:1.0.0"}]}}`,
+			assertions: func(ev event, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{"harbor.example.com/project/app:1.0.0"},
+					ev.imageRepos,
+				)
+			},
+		},
+		{
+			name:   "Harbor deletion",
+			sender: "harbor",
+			body:   `{"type":"DELETE_ARTIFACT","event_data":{}}`,
+			assertions: func(ev event, err error) {
+				require.NoError(t, err)
+				require.Empty(t, ev.imageRepos)
+			},
+		},
+		{
+			name:   "Quay push",
+			sender: "quay",
+			body:   `{"docker_url":"quay.io/example/app","updated_tags":["1.0.0"]}`,
+			assertions: func(ev event, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"quay.io/example/app"}, ev.imageRepos)
+			},
+		},
+		{
+			name:   "registry notification",
+			sender: "registry",
+			body: `{"events":[` +
+				`{"action":"pull","target":{"repository":"example/other"},` +
+				`"request":{"host":"registry.example.com"}},` +
+				`{"action":"push","target":{"repository":"example/app"},` +
+				`"request":{"host":"registry.example.com"}}]}`,
+			assertions: func(ev event, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{"registry.example.com/example/app"},
+					ev.imageRepos,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			for k, v := range testCase.headers {
+				req.Header.Set(k, v)
+			}
+			s, ok := senders[testCase.sender]
+			require.True(t, ok)
+			testCase.assertions(s.parse(req, []byte(testCase.body)))
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/kargo"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// RollbackStage creates a Promotion resource to return a specified Stage to
+// Freight from its own history. The Freight may be identified either by ID or
+// by the number of steps back in the Stage's history. If neither is specified,
+// the Stage is returned to the Freight it had before its current Freight.
+func (s *server) RollbackStage(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.RollbackStageRequest],
+) (*connect.Response[svcv1alpha1.RollbackStageResponse], error) {
+	if err := validateProjectAndStageNonEmpty(req.Msg.GetProject(), req.Msg.GetName()); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+	if req.Msg.GetSteps() < 0 {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("steps must not be negative"),
+		)
+	}
+	if err := s.validateProjectFn(ctx, req.Msg.GetProject()); err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+	stage, err := s.getStageFn(
+		ctx,
+		s.client,
+		types.NamespacedName{
+			Namespace: req.Msg.GetProject(),
+			Name:      req.Msg.GetName(),
+		},
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if stage == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"Stage %q not found in namespace %q",
+				req.Msg.GetName(),
+				req.Msg.GetProject(),
+			),
+		)
+	}
+	if stage.Spec.PromotionMechanisms == nil {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.Errorf(
+				"Stage %q in namespace %q has no promotion mechanisms and cannot be "+
+					"rolled back",
+				stage.Name,
+				stage.Namespace,
+			),
+		)
+	}
+
+	target, err := getRollbackTarget(stage, req.Msg)
+	if err != nil {
+		return nil, err // This already returns a connect.Error
+	}
+	if stage.Status.CurrentFreight != nil &&
+		stage.Status.CurrentFreight.ID == target.ID {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.Errorf(
+				"Stage %q in namespace %q already has Freight %q",
+				stage.Name,
+				stage.Namespace,
+				target.ID,
+			),
+		)
+	}
+
+	promotion := kargo.NewPromotion(*stage, target.ID)
+	promotion.Spec.Rollback = true
+	if err := s.createPromotionFn(ctx, &promotion); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&svcv1alpha1.RollbackStageResponse{
+		Promotion: typesv1alpha1.ToPromotionProto(promotion),
+	}), nil
+}
+
+// getRollbackTarget returns the Freight from the provided Stage's history that
+// the provided request identifies.
+func getRollbackTarget(
+	stage *kargoapi.Stage,
+	req *svcv1alpha1.RollbackStageRequest,
+) (*kargoapi.SimpleFreight, error) {
+	history := stage.Status.History
+	if freightID := req.GetFreight(); freightID != "" {
+		for i := range history {
+			if history[i].ID == freightID {
+				return &history[i], nil
+			}
+		}
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.Errorf(
+				"Freight %q not found in the history of Stage %q in namespace %q",
+				freightID,
+				stage.Name,
+				stage.Namespace,
+			),
+		)
+	}
+	steps := int(req.GetSteps())
+	if steps == 0 {
+		steps = 1
+	}
+	if steps >= len(history) {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.Errorf(
+				"cannot roll back Stage %q in namespace %q by %d step(s); its "+
+					"history contains only %d Freight",
+				stage.Name,
+				stage.Namespace,
+				steps,
+				len(history),
+			),
+		)
+	}
+	return &history[steps], nil
+}
@@ -0,0 +1,349 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestRollbackStage(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-stage",
+			Namespace: "fake-project",
+		},
+		Spec: &kargoapi.StageSpec{
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+		},
+		Status: kargoapi.StageStatus{
+			CurrentFreight: &kargoapi.SimpleFreight{ID: "freight-3"},
+			History: kargoapi.SimpleFreightStack{
+				{ID: "freight-3"},
+				{ID: "freight-2"},
+				{ID: "freight-1"},
+			},
+		},
+	}
+	getTestStage := func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Stage, error) {
+		return testStage.DeepCopy(), nil
+	}
+	validProject := func(context.Context, string) error {
+		return nil
+	}
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.RollbackStageRequest
+		server     *server
+		assertions func(*connect.Response[svcv1alpha1.RollbackStageResponse], error)
+	}{
+		{
+			name:   "input validation error",
+			req:    &svcv1alpha1.RollbackStageRequest{},
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.RollbackStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name: "negative steps",
+			req: &svcv1alpha1.RollbackStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Target:  &svcv1alpha1.RollbackStageRequest_Steps{Steps: -1},
+			},
+			server: &server{},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.RollbackStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name: "error validating project",
+			req: &svcv1alpha1.RollbackStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: func(context.Context, string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.RollbackStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "Stage not found",
+			req: &svcv1alpha1.RollbackStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: validProject,
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.RollbackStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+			},
+		},
+		{
+			name: "Stage has no promotion mechanisms",
+			req: &svcv1alpha1.RollbackStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: validProject,
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						Spec: &kargoapi.StageSpec{},
+					}, nil
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.RollbackStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeFailedPrecondition, connErr.Code())
+				require.Contains(t, connErr.Message(), "cannot be rolled back")
+			},
+		},
+		{
+			name: "Freight not in history",
+			req: &svcv1alpha1.RollbackStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Target: &svcv1alpha1.RollbackStageRequest_Freight{
+					Freight: "freight-0",
+				},
+			},
+			server: &server{
+				validateProjectFn: validProject,
+				getStageFn:        getTestStage,
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.RollbackStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+				require.Contains(t, connErr.Message(), "not found in the history")
+			},
+		},
+		{
+			name: "too many steps",
+			req: &svcv1alpha1.RollbackStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Target:  &svcv1alpha1.RollbackStageRequest_Steps{Steps: 3},
+			},
+			server: &server{
+				validateProjectFn: validProject,
+				getStageFn:        getTestStage,
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.RollbackStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeFailedPrecondition, connErr.Code())
+				require.Contains(t, connErr.Message(), "contains only 3 Freight")
+			},
+		},
+		{
+			name: "target is current Freight",
+			req: &svcv1alpha1.RollbackStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Target: &svcv1alpha1.RollbackStageRequest_Freight{
+					Freight: "freight-3",
+				},
+			},
+			server: &server{
+				validateProjectFn: validProject,
+				getStageFn:        getTestStage,
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.RollbackStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeFailedPrecondition, connErr.Code())
+				require.Contains(t, connErr.Message(), "already has Freight")
+			},
+		},
+		{
+			name: "error creating Promotion",
+			req: &svcv1alpha1.RollbackStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: validProject,
+				getStageFn:        getTestStage,
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *connect.Response[svcv1alpha1.RollbackStageResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				connErr, ok := err.(*connect.Error)
+				require.True(t, ok)
+				require.Equal(t, connect.CodeInternal, connErr.Code())
+			},
+		},
+		{
+			name: "success with default steps",
+			req: &svcv1alpha1.RollbackStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+			},
+			server: &server{
+				validateProjectFn: validProject,
+				getStageFn:        getTestStage,
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.RollbackStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				spec := res.Msg.GetPromotion().GetSpec()
+				require.Equal(t, "fake-stage", spec.GetStage())
+				require.Equal(t, "freight-2", spec.GetFreight())
+				require.True(t, spec.GetRollback())
+			},
+		},
+		{
+			name: "success with steps",
+			req: &svcv1alpha1.RollbackStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Target:  &svcv1alpha1.RollbackStageRequest_Steps{Steps: 2},
+			},
+			server: &server{
+				validateProjectFn: validProject,
+				getStageFn:        getTestStage,
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.RollbackStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, "freight-1", res.Msg.GetPromotion().GetSpec().GetFreight())
+			},
+		},
+		{
+			name: "success with Freight",
+			req: &svcv1alpha1.RollbackStageRequest{
+				Project: "fake-project",
+				Name:    "fake-stage",
+				Target: &svcv1alpha1.RollbackStageRequest_Freight{
+					Freight: "freight-1",
+				},
+			},
+			server: &server{
+				validateProjectFn: validProject,
+				getStageFn:        getTestStage,
+				createPromotionFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					promo := obj.(*kargoapi.Promotion) // nolint: forcetypeassert
+					require.True(t, promo.Spec.Rollback)
+					return nil
+				},
+			},
+			assertions: func(
+				res *connect.Response[svcv1alpha1.RollbackStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, "freight-1", res.Msg.GetPromotion().GetSpec().GetFreight())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := testCase.server.RollbackStage(
+				context.Background(),
+				connect.NewRequest(testCase.req),
+			)
+			testCase.assertions(res, err)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -20,7 +21,9 @@ import (
 	"github.com/akuity/kargo/internal/api/dex"
 	"github.com/akuity/kargo/internal/api/kubernetes"
 	"github.com/akuity/kargo/internal/api/option"
+	"github.com/akuity/kargo/internal/api/receiver"
 	"github.com/akuity/kargo/internal/api/validation"
+	"github.com/akuity/kargo/internal/controller/promotion"
 	httputil "github.com/akuity/kargo/internal/http"
 	"github.com/akuity/kargo/internal/kubeclient/manifest"
 	"github.com/akuity/kargo/internal/logging"
@@ -62,6 +65,15 @@ type server struct {
 		stages []string,
 	) (*kargoapi.Freight, error)
 
+	// Common authorization:
+	authorizeFn func(
+		ctx context.Context,
+		verb string,
+		gvr schema.GroupVersionResource,
+		subresource string,
+		key client.ObjectKey,
+	) error
+
 	// Common Promotions:
 	createPromotionFn func(
 		context.Context,
@@ -69,6 +81,34 @@ type server struct {
 		...client.CreateOption,
 	) error
 
+	// AbortPromotion API:
+	getPromotionFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Promotion, error)
+	abortPromotionFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) error
+
+	// ApprovePromotion API:
+	approvePromotionFn func(
+		context.Context,
+		client.Client,
+		*kargoapi.Promotion,
+		kargoapi.PromotionApproval,
+	) error
+
+	// PreviewPromotion API:
+	previewPromotionFn func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		promo *kargoapi.Promotion,
+		freight kargoapi.SimpleFreight,
+	) (*promotion.Preview, error)
+
 	// Promote subscribers:
 	findStageSubscribersFn func(ctx context.Context, stage *kargoapi.Stage) ([]kargoapi.Stage, error)
 
@@ -102,9 +142,12 @@ type Server interface {
 	Serve(ctx context.Context, l net.Listener) error
 }
 
+// NewServer returns a Server. The provided promotion mechanisms are used for
+// previewing Promotions. They may be nil, in which case previews are disabled.
 func NewServer(
 	cfg config.ServerConfig,
 	kubeClient kubernetes.Client,
+	promoMechanisms promotion.Mechanism,
 ) Server {
 	s := &server{
 		cfg:    cfg,
@@ -115,7 +158,14 @@ func NewServer(
 	s.externalValidateProjectFn = validation.ValidateProject
 	s.getStageFn = kargoapi.GetStage
 	s.getQualifiedFreightFn = kargoapi.GetQualifiedFreight
+	s.authorizeFn = kubeClient.Authorize
 	s.createPromotionFn = kubeClient.Create
+	s.getPromotionFn = kargoapi.GetPromotion
+	s.abortPromotionFn = kargoapi.AbortPromotion
+	s.approvePromotionFn = kargoapi.AddPromotionApproval
+	if promoMechanisms != nil {
+		s.previewPromotionFn = previewPromotionFn(promoMechanisms)
+	}
 	s.findStageSubscribersFn = s.findStageSubscribers
 	s.listFreightFn = kubeClient.List
 	s.getAvailableFreightForStageFn = s.getAvailableFreightForStage
@@ -146,6 +196,12 @@ func (s *server) Serve(ctx context.Context, l net.Listener) error {
 		}
 		mux.Handle("/dex/", dexProxy)
 	}
+	if s.cfg.WebhookReceiverConfig != nil {
+		mux.Handle(
+			receiver.PathPrefix,
+			receiver.NewHandler(*s.cfg.WebhookReceiverConfig, s.client),
+		)
+	}
 
 	srv := &http.Server{
 		Handler:           h2c.NewHandler(mux, &http2.Server{}),
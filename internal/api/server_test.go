@@ -30,7 +30,7 @@ func TestNewServer(t *testing.T) {
 		},
 	)
 	require.NoError(t, err)
-	s, ok := NewServer(testServerConfig, testClient).(*server)
+	s, ok := NewServer(testServerConfig, testClient, nil).(*server)
 	require.True(t, ok)
 	require.NotNil(t, s)
 	require.Same(t, testClient, s.client)
@@ -39,6 +39,7 @@ func TestNewServer(t *testing.T) {
 	require.NotNil(t, s.externalValidateProjectFn)
 	require.NotNil(t, s.getStageFn)
 	require.NotNil(t, s.getQualifiedFreightFn)
+	require.NotNil(t, s.authorizeFn)
 	require.NotNil(t, s.createPromotionFn)
 	require.NotNil(t, s.findStageSubscribersFn)
 	require.NotNil(t, s.listFreightFn)
@@ -72,6 +72,24 @@ func FromOwnerReferenceProto(r *metav1.OwnerReference) *kubemetav1.OwnerReferenc
 	}
 }
 
+func FromConditionProto(c *metav1.Condition) *kubemetav1.Condition {
+	if c == nil {
+		return nil
+	}
+	var lastTransitionTime kubemetav1.Time
+	if c.GetLastTransitionTime() != nil {
+		lastTransitionTime = kubemetav1.NewTime(c.GetLastTransitionTime().AsTime())
+	}
+	return &kubemetav1.Condition{
+		Type:               c.GetType(),
+		Status:             kubemetav1.ConditionStatus(c.GetStatus()),
+		ObservedGeneration: c.GetObservedGeneration(),
+		LastTransitionTime: lastTransitionTime,
+		Reason:             c.GetReason(),
+		Message:            c.GetMessage(),
+	}
+}
+
 func FromFieldsV1Proto(f *metav1.FieldsV1) *kubemetav1.FieldsV1 {
 	if f == nil {
 		return nil
@@ -169,6 +187,17 @@ func ToManagedFieldsEntryProto(e kubemetav1.ManagedFieldsEntry) *metav1.ManagedF
 	}
 }
 
+func ToConditionProto(c kubemetav1.Condition) *metav1.Condition {
+	return &metav1.Condition{
+		Type:               proto.String(c.Type),
+		Status:             proto.String(string(c.Status)),
+		ObservedGeneration: proto.Int64(c.ObservedGeneration),
+		LastTransitionTime: timestamppb.New(c.LastTransitionTime.Time),
+		Reason:             proto.String(c.Reason),
+		Message:            proto.String(c.Message),
+	}
+}
+
 func ToFieldsV1Proto(f kubemetav1.FieldsV1) *metav1.FieldsV1 {
 	return &metav1.FieldsV1{
 		Raw: f.Raw,
@@ -11,6 +11,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	typesmetav1 "github.com/akuity/kargo/internal/api/types/metav1"
 	"github.com/akuity/kargo/internal/version"
+	"github.com/akuity/kargo/pkg/api/metav1"
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 	"github.com/akuity/kargo/pkg/api/v1alpha1"
 )
@@ -52,8 +53,22 @@ func FromStageProto(s *v1alpha1.Stage) *kargoapi.Stage {
 
 func FromStageSpecProto(s *v1alpha1.StageSpec) *kargoapi.StageSpec {
 	return &kargoapi.StageSpec{
-		Subscriptions:       FromSubscriptionsProto(s.GetSubscriptions()),
-		PromotionMechanisms: FromPromotionMechanismsProto(s.GetPromotionMechanisms()),
+		Subscriptions:            FromSubscriptionsProto(s.GetSubscriptions()),
+		PromotionMechanisms:      FromPromotionMechanismsProto(s.GetPromotionMechanisms()),
+		Approval:                 FromApprovalRequirementProto(s.GetApproval()),
+		SupersedeStalePromotions: s.GetSupersedeStalePromotions(),
+	}
+}
+
+func FromApprovalRequirementProto(
+	a *v1alpha1.ApprovalRequirement,
+) *kargoapi.ApprovalRequirement {
+	if a == nil {
+		return nil
+	}
+	return &kargoapi.ApprovalRequirement{
+		RequiredApprovals: a.GetRequiredApprovals(),
+		Groups:            a.GetGroups(),
 	}
 }
 
@@ -172,8 +187,15 @@ func FromWarehouseSpecProto(s *v1alpha1.WarehouseSpec) *kargoapi.WarehouseSpec {
 		}
 		subscriptions = append(subscriptions, *FromRepoSubscriptionProto(subscription))
 	}
+	var pollInterval *kubemetav1.Duration
+	if s.PollInterval != nil {
+		if d, err := time.ParseDuration(s.GetPollInterval()); err == nil {
+			pollInterval = &kubemetav1.Duration{Duration: d}
+		}
+	}
 	return &kargoapi.WarehouseSpec{
 		Subscriptions: subscriptions,
+		PollInterval:  pollInterval,
 	}
 }
 
@@ -181,7 +203,84 @@ func FromWarehouseStatusProto(s *v1alpha1.WarehouseStatus) *kargoapi.WarehouseSt
 	if s == nil {
 		return nil
 	}
-	return &kargoapi.WarehouseStatus{}
+	var failures []kargoapi.ImageVerificationFailure
+	if len(s.GetImageVerificationFailures()) > 0 {
+		failures = make(
+			[]kargoapi.ImageVerificationFailure,
+			len(s.GetImageVerificationFailures()),
+		)
+		for idx, f := range s.GetImageVerificationFailures() {
+			failures[idx] = kargoapi.ImageVerificationFailure{
+				RepoURL: f.GetRepoUrl(),
+				Tag:     f.GetTag(),
+				Digest:  f.GetDigest(),
+				Message: f.GetMessage(),
+			}
+		}
+	}
+	var conditions []kubemetav1.Condition
+	if len(s.GetConditions()) > 0 {
+		conditions = make([]kubemetav1.Condition, len(s.GetConditions()))
+		for idx, c := range s.GetConditions() {
+			conditions[idx] = *typesmetav1.FromConditionProto(c)
+		}
+	}
+	var lastPolledAt *kubemetav1.Time
+	if s.GetLastPolledAt() != nil {
+		t := kubemetav1.NewTime(s.GetLastPolledAt().AsTime())
+		lastPolledAt = &t
+	}
+	var subscriptions []kargoapi.SubscriptionStatus
+	if len(s.GetSubscriptions()) > 0 {
+		subscriptions = make([]kargoapi.SubscriptionStatus, len(s.GetSubscriptions()))
+		for idx, sub := range s.GetSubscriptions() {
+			subscriptions[idx] = *FromSubscriptionStatusProto(sub)
+		}
+	}
+	return &kargoapi.WarehouseStatus{
+		Conditions:                conditions,
+		Error:                     s.GetError(),
+		ObservedGeneration:        s.GetObservedGeneration(),
+		ImageVerificationFailures: failures,
+		LastPolledAt:              lastPolledAt,
+		LastFreight:               FromFreightReferenceProto(s.GetLastFreight()),
+		Subscriptions:             subscriptions,
+	}
+}
+
+func FromFreightReferenceProto(r *v1alpha1.FreightReference) *kargoapi.FreightReference {
+	if r == nil {
+		return nil
+	}
+	var createdAt *kubemetav1.Time
+	if r.GetCreatedAt() != nil {
+		t := kubemetav1.NewTime(r.GetCreatedAt().AsTime())
+		createdAt = &t
+	}
+	return &kargoapi.FreightReference{
+		Name:      r.GetName(),
+		CreatedAt: createdAt,
+	}
+}
+
+func FromSubscriptionStatusProto(s *v1alpha1.SubscriptionStatus) *kargoapi.SubscriptionStatus {
+	if s == nil {
+		return nil
+	}
+	var lastDiscoveredAt *kubemetav1.Time
+	if s.GetLastDiscoveredAt() != nil {
+		t := kubemetav1.NewTime(s.GetLastDiscoveredAt().AsTime())
+		lastDiscoveredAt = &t
+	}
+	return &kargoapi.SubscriptionStatus{
+		RepoURL:          s.GetRepoUrl(),
+		ChartName:        s.GetChartName(),
+		LastCommit:       FromGitCommitProto(s.GetLastCommit()),
+		LastImage:        FromImageProto(s.GetLastImage()),
+		LastChart:        FromChartProto(s.GetLastChart()),
+		LastDiscoveredAt: lastDiscoveredAt,
+		Error:            s.GetError(),
+	}
 }
 
 func FromGitCommitProto(g *v1alpha1.GitCommit) *kargoapi.GitCommit {
@@ -192,6 +291,7 @@ func FromGitCommitProto(g *v1alpha1.GitCommit) *kargoapi.GitCommit {
 		RepoURL:           g.GetRepoUrl(),
 		ID:                g.GetId(),
 		Branch:            g.GetBranch(),
+		Tag:               g.GetTag(),
 		HealthCheckCommit: g.GetHealthCheckCommit(),
 	}
 }
@@ -203,6 +303,7 @@ func FromImageProto(i *v1alpha1.Image) *kargoapi.Image {
 	return &kargoapi.Image{
 		RepoURL: i.GetRepoUrl(),
 		Tag:     i.GetTag(),
+		Digest:  i.GetDigest(),
 	}
 }
 
@@ -225,10 +326,28 @@ func FromHealthProto(h *v1alpha1.Health) *kargoapi.Health {
 	for i, argocdAppState := range h.GetArgocdApps() {
 		argocdAppStates[i] = FromArgoCDAppStateProto(argocdAppState)
 	}
+	fluxResources := make([]kargoapi.FluxResourceStatus, len(h.GetFluxResources()))
+	for i, fluxResource := range h.GetFluxResources() {
+		fluxResources[i] = FromFluxResourceStatusProto(fluxResource)
+	}
 	return &kargoapi.Health{
-		Status:     kargoapi.HealthState(h.GetStatus()),
-		Issues:     h.GetIssues(),
-		ArgoCDApps: argocdAppStates,
+		Status:        kargoapi.HealthState(h.GetStatus()),
+		Issues:        h.GetIssues(),
+		ArgoCDApps:    argocdAppStates,
+		FluxResources: fluxResources,
+	}
+}
+
+func FromFluxResourceStatusProto(
+	f *v1alpha1.FluxResourceStatus,
+) kargoapi.FluxResourceStatus {
+	return kargoapi.FluxResourceStatus{
+		Kind:      kargoapi.FluxResourceKind(f.GetKind()),
+		Namespace: f.GetNamespace(),
+		Name:      f.GetName(),
+		Ready:     f.GetReady(),
+		Reason:    f.GetReason(),
+		Message:   f.GetMessage(),
 	}
 }
 
@@ -294,6 +413,14 @@ func FromGitSubscriptionProto(s *v1alpha1.GitSubscription) *kargoapi.GitSubscrip
 	return &kargoapi.GitSubscription{
 		RepoURL: s.GetRepoUrl(),
 		Branch:  s.GetBranch(),
+		CommitSelectionStrategy: kargoapi.CommitSelectionStrategy(
+			s.GetCommitSelectionStrategy(),
+		),
+		SemverConstraint: s.GetSemverConstraint(),
+		AllowTags:        s.GetAllowTags(),
+		IgnoreTags:       s.GetIgnoreTags(),
+		IncludePaths:     s.GetIncludePaths(),
+		ExcludePaths:     s.GetExcludePaths(),
 	}
 }
 
@@ -308,6 +435,26 @@ func FromImageSubscriptionProto(s *v1alpha1.ImageSubscription) *kargoapi.ImageSu
 		AllowTags:        s.GetAllowTags(),
 		IgnoreTags:       s.GetIgnoreTags(),
 		Platform:         s.GetPlatform(),
+		Verification:     FromImageVerificationProto(s.GetVerification()),
+	}
+}
+
+func FromImageVerificationProto(v *v1alpha1.ImageVerification) *kargoapi.ImageVerification {
+	if v == nil {
+		return nil
+	}
+	var keyless *kargoapi.KeylessVerification
+	if k := v.GetKeyless(); k != nil {
+		keyless = &kargoapi.KeylessVerification{
+			Identity:                 k.GetIdentity(),
+			Issuer:                   k.GetIssuer(),
+			CertificateAuthorities:   k.GetCertificateAuthorities(),
+			TransparencyLogPublicKey: k.GetTransparencyLogPublicKey(),
+		}
+	}
+	return &kargoapi.ImageVerification{
+		PublicKey: v.GetPublicKey(),
+		Keyless:   keyless,
 	}
 }
 
@@ -334,9 +481,159 @@ func FromPromotionMechanismsProto(m *v1alpha1.PromotionMechanisms) *kargoapi.Pro
 	for idx, argo := range m.GetArgocdAppUpdates() {
 		argoUpdates[idx] = *FromArgoCDAppUpdatesProto(argo)
 	}
+	fluxUpdates := make([]kargoapi.FluxUpdate, len(m.GetFluxUpdates()))
+	for idx, flux := range m.GetFluxUpdates() {
+		fluxUpdates[idx] = *FromFluxUpdateProto(flux)
+	}
+	kubernetesPatches := make([]kargoapi.KubernetesPatch, len(m.GetKubernetesPatches()))
+	for idx, patch := range m.GetKubernetesPatches() {
+		kubernetesPatches[idx] = *FromKubernetesPatchProto(patch)
+	}
+	jobs := make([]kargoapi.PromotionJob, len(m.GetJobs()))
+	for idx, job := range m.GetJobs() {
+		jobs[idx] = kargoapi.PromotionJob{
+			Name:     job.GetName(),
+			Template: job.GetTemplate(),
+		}
+	}
+	httpWebhooks := make([]kargoapi.HTTPWebhook, len(m.GetHttpWebhooks()))
+	for idx, webhook := range m.GetHttpWebhooks() {
+		httpWebhooks[idx] = *FromHTTPWebhookProto(webhook)
+	}
+	var timeout *kubemetav1.Duration
+	if m.Timeout != nil {
+		if d, err := time.ParseDuration(m.GetTimeout()); err == nil {
+			timeout = &kubemetav1.Duration{Duration: d}
+		}
+	}
 	return &kargoapi.PromotionMechanisms{
-		GitRepoUpdates:   gitUpdates,
-		ArgoCDAppUpdates: argoUpdates,
+		GitRepoUpdates:    gitUpdates,
+		ArgoCDAppUpdates:  argoUpdates,
+		FluxUpdates:       fluxUpdates,
+		KubernetesPatches: kubernetesPatches,
+		Jobs:              jobs,
+		HTTPWebhooks:      httpWebhooks,
+		Timeout:           timeout,
+		Retry:             FromPromotionRetryPolicyProto(m.GetRetry()),
+	}
+}
+
+func FromPromotionRetryPolicyProto(
+	p *v1alpha1.PromotionRetryPolicy,
+) *kargoapi.PromotionRetryPolicy {
+	if p == nil {
+		return nil
+	}
+	var backoff, maxBackoff *kubemetav1.Duration
+	if p.Backoff != nil {
+		if d, err := time.ParseDuration(p.GetBackoff()); err == nil {
+			backoff = &kubemetav1.Duration{Duration: d}
+		}
+	}
+	if p.MaxBackoff != nil {
+		if d, err := time.ParseDuration(p.GetMaxBackoff()); err == nil {
+			maxBackoff = &kubemetav1.Duration{Duration: d}
+		}
+	}
+	retryOn := make([]kargoapi.PromotionErrorClass, len(p.GetRetryOn()))
+	for idx, class := range p.GetRetryOn() {
+		retryOn[idx] = kargoapi.PromotionErrorClass(class)
+	}
+	return &kargoapi.PromotionRetryPolicy{
+		MaxAttempts: p.GetMaxAttempts(),
+		Backoff:     backoff,
+		MaxBackoff:  maxBackoff,
+		RetryOn:     retryOn,
+	}
+}
+
+func FromHTTPWebhookProto(w *v1alpha1.HTTPWebhook) *kargoapi.HTTPWebhook {
+	if w == nil {
+		return nil
+	}
+	headers := make([]kargoapi.HTTPHeader, len(w.GetHeaders()))
+	for idx, header := range w.GetHeaders() {
+		headers[idx] = kargoapi.HTTPHeader{
+			Name:  header.GetName(),
+			Value: header.GetValue(),
+		}
+	}
+	var successCriteria *kargoapi.HTTPSuccessCriteria
+	if c := w.GetSuccessCriteria(); c != nil {
+		successCriteria = &kargoapi.HTTPSuccessCriteria{
+			StatusCodes: c.GetStatusCodes(),
+			JSONPath:    c.GetJsonPath(),
+			Value:       c.GetValue(),
+		}
+	}
+	return &kargoapi.HTTPWebhook{
+		Name:              w.GetName(),
+		URL:               w.GetUrl(),
+		Method:            w.GetMethod(),
+		Headers:           headers,
+		HeadersSecretName: w.GetHeadersSecretName(),
+		Body:              w.GetBody(),
+		SuccessCriteria:   successCriteria,
+		TimeoutSeconds:    w.GetTimeoutSeconds(),
+		Retries:           w.GetRetries(),
+	}
+}
+
+func FromKubernetesPatchProto(p *v1alpha1.KubernetesPatch) *kargoapi.KubernetesPatch {
+	if p == nil {
+		return nil
+	}
+	return &kargoapi.KubernetesPatch{
+		APIVersion: p.GetApiVersion(),
+		Kind:       p.GetKind(),
+		Name:       p.GetName(),
+		Namespace:  p.GetNamespace(),
+		Type:       kargoapi.KubernetesPatchType(p.GetType()),
+		Patch:      p.GetPatch(),
+	}
+}
+
+func FromFluxUpdateProto(u *v1alpha1.FluxUpdate) *kargoapi.FluxUpdate {
+	if u == nil {
+		return nil
+	}
+	return &kargoapi.FluxUpdate{
+		Kind:          kargoapi.FluxResourceKind(u.GetKind()),
+		Name:          u.GetName(),
+		Namespace:     u.GetNamespace(),
+		HelmRelease:   FromFluxHelmReleaseUpdateProto(u.GetHelmRelease()),
+		Kustomization: FromFluxKustomizationUpdateProto(u.GetKustomization()),
+	}
+}
+
+func FromFluxHelmReleaseUpdateProto(
+	u *v1alpha1.FluxHelmReleaseUpdate,
+) *kargoapi.FluxHelmReleaseUpdate {
+	if u == nil {
+		return nil
+	}
+	images := make([]kargoapi.FluxHelmImageUpdate, len(u.GetImages()))
+	for idx, image := range u.GetImages() {
+		images[idx] = kargoapi.FluxHelmImageUpdate{
+			Image: image.GetImage(),
+			Key:   image.GetKey(),
+			Value: kargoapi.ImageUpdateValueType(image.GetValue()),
+		}
+	}
+	return &kargoapi.FluxHelmReleaseUpdate{
+		ChartRegistryURL: u.GetChartRegistryUrl(),
+		Images:           images,
+	}
+}
+
+func FromFluxKustomizationUpdateProto(
+	u *v1alpha1.FluxKustomizationUpdate,
+) *kargoapi.FluxKustomizationUpdate {
+	if u == nil {
+		return nil
+	}
+	return &kargoapi.FluxKustomizationUpdate{
+		Images: u.GetImages(),
 	}
 }
 
@@ -344,6 +641,10 @@ func FromGitRepoUpdateProto(u *v1alpha1.GitRepoUpdate) *kargoapi.GitRepoUpdate {
 	if u == nil {
 		return nil
 	}
+	yamlUpdates := make([]kargoapi.YAMLUpdate, len(u.GetYamlUpdates()))
+	for idx, yamlUpdate := range u.GetYamlUpdates() {
+		yamlUpdates[idx] = *FromYAMLUpdateProto(yamlUpdate)
+	}
 	return &kargoapi.GitRepoUpdate{
 		RepoURL:     u.GetRepoUrl(),
 		ReadBranch:  u.GetReadBranch(),
@@ -351,6 +652,39 @@ func FromGitRepoUpdateProto(u *v1alpha1.GitRepoUpdate) *kargoapi.GitRepoUpdate {
 		Render:      FromKargoRenderPromotionMechanismProto(u.GetRender()),
 		Kustomize:   FromKustomizePromotionMechanismProto(u.GetKustomize()),
 		Helm:        FromHelmPromotionMechanismProto(u.GetHelm()),
+		HelmTemplate: FromHelmTemplatePromotionMechanismProto(
+			u.GetHelmTemplate(),
+		),
+		YAMLUpdates:           yamlUpdates,
+		PullRequest:           FromPullRequestPromotionMechanismProto(u.GetPullRequest()),
+		CommitMessageTemplate: u.GetCommitMessageTemplate(),
+	}
+}
+
+func FromYAMLUpdateProto(u *v1alpha1.YAMLUpdate) *kargoapi.YAMLUpdate {
+	if u == nil {
+		return nil
+	}
+	return &kargoapi.YAMLUpdate{
+		File:             u.GetFile(),
+		Key:              u.GetKey(),
+		Value:            kargoapi.YAMLUpdateValueType(u.GetValue()),
+		Image:            u.GetImage(),
+		GitRepoURL:       u.GetGitRepoUrl(),
+		ChartRegistryURL: u.GetChartRegistryUrl(),
+		ChartName:        u.GetChartName(),
+	}
+}
+
+func FromPullRequestPromotionMechanismProto(
+	m *v1alpha1.PullRequestPromotionMechanism,
+) *kargoapi.PullRequestPromotionMechanism {
+	if m == nil {
+		return nil
+	}
+	return &kargoapi.PullRequestPromotionMechanism{
+		Provider: kargoapi.GitProvider(m.GetProvider()),
+		APIURL:   m.GetApiUrl(),
 	}
 }
 
@@ -375,6 +709,17 @@ func FromKustomizePromotionMechanismProto(
 	}
 	return &kargoapi.KustomizePromotionMechanism{
 		Images: images,
+		Build:  FromKustomizeBuildProto(m.GetBuild()),
+	}
+}
+
+func FromKustomizeBuildProto(b *v1alpha1.KustomizeBuild) *kargoapi.KustomizeBuild {
+	if b == nil {
+		return nil
+	}
+	return &kargoapi.KustomizeBuild{
+		Path:       b.GetPath(),
+		OutputPath: b.GetOutputPath(),
 	}
 }
 
@@ -383,8 +728,9 @@ func FromKustomizeImageUpdateProto(u *v1alpha1.KustomizeImageUpdate) *kargoapi.K
 		return nil
 	}
 	return &kargoapi.KustomizeImageUpdate{
-		Image: u.GetImage(),
-		Path:  u.GetPath(),
+		Image:     u.GetImage(),
+		Path:      u.GetPath(),
+		UseDigest: u.GetUseDigest(),
 	}
 }
 
@@ -408,6 +754,39 @@ func FromHelmPromotionMechanismProto(
 	}
 }
 
+func FromHelmTemplatePromotionMechanismProto(
+	m *v1alpha1.HelmTemplatePromotionMechanism,
+) *kargoapi.HelmTemplatePromotionMechanism {
+	if m == nil {
+		return nil
+	}
+	images := make([]kargoapi.HelmTemplateImageUpdate, len(m.GetImages()))
+	for idx, image := range m.GetImages() {
+		images[idx] = *FromHelmTemplateImageUpdateProto(image)
+	}
+	return &kargoapi.HelmTemplatePromotionMechanism{
+		ChartPath:   m.GetChartPath(),
+		ReleaseName: m.GetReleaseName(),
+		Namespace:   m.GetNamespace(),
+		ValuesFiles: m.GetValuesFiles(),
+		Images:      images,
+		OutputPath:  m.GetOutputPath(),
+	}
+}
+
+func FromHelmTemplateImageUpdateProto(
+	u *v1alpha1.HelmTemplateImageUpdate,
+) *kargoapi.HelmTemplateImageUpdate {
+	if u == nil {
+		return nil
+	}
+	return &kargoapi.HelmTemplateImageUpdate{
+		Image: u.GetImage(),
+		Key:   u.GetKey(),
+		Value: kargoapi.ImageUpdateValueType(u.GetValue()),
+	}
+}
+
 func FromHelmImageUpdateProto(u *v1alpha1.HelmImageUpdate) *kargoapi.HelmImageUpdate {
 	if u == nil {
 		return nil
@@ -445,6 +824,7 @@ func FromArgoCDAppUpdatesProto(u *v1alpha1.ArgoCDAppUpdate) *kargoapi.ArgoCDAppU
 		AppName:       u.GetAppName(),
 		AppNamespace:  u.GetAppNamespace(),
 		SourceUpdates: sourceUpdates,
+		WaitForSync:   u.GetWaitForSync(),
 	}
 }
 
@@ -466,7 +846,8 @@ func FromArgoCDKustomizeProto(k *v1alpha1.ArgoCDKustomize) *kargoapi.ArgoCDKusto
 		return nil
 	}
 	return &kargoapi.ArgoCDKustomize{
-		Images: k.GetImages(),
+		Images:    k.GetImages(),
+		UseDigest: k.GetUseDigest(),
 	}
 }
 
@@ -531,8 +912,9 @@ func FromPromotionSpecProto(s *v1alpha1.PromotionSpec) *kargoapi.PromotionSpec {
 		return nil
 	}
 	return &kargoapi.PromotionSpec{
-		Stage:   s.GetStage(),
-		Freight: s.GetFreight(),
+		Stage:    s.GetStage(),
+		Freight:  s.GetFreight(),
+		Rollback: s.GetRollback(),
 	}
 }
 
@@ -540,9 +922,145 @@ func FromPromotionStatusProto(s *v1alpha1.PromotionStatus) *kargoapi.PromotionSt
 	if s == nil {
 		return nil
 	}
+	pullRequests := make([]kargoapi.PullRequestInfo, len(s.GetPullRequests()))
+	for idx, pr := range s.GetPullRequests() {
+		pullRequests[idx] = *FromPullRequestInfoProto(pr)
+	}
+	argoCDAppSyncs := make([]kargoapi.ArgoCDAppSyncInfo, len(s.GetArgoCdAppSyncs()))
+	for idx, sync := range s.GetArgoCdAppSyncs() {
+		argoCDAppSyncs[idx] = *FromArgoCDAppSyncInfoProto(sync)
+	}
+	httpWebhookResults := make([]kargoapi.HTTPWebhookResult, len(s.GetHttpWebhookResults()))
+	for idx, result := range s.GetHttpWebhookResults() {
+		httpWebhookResults[idx] = *FromHTTPWebhookResultProto(result)
+	}
+	steps := make([]kargoapi.PromotionStepResult, len(s.GetSteps()))
+	for idx, step := range s.GetSteps() {
+		steps[idx] = *FromPromotionStepResultProto(step)
+	}
+	attempts := make([]kargoapi.PromotionAttempt, len(s.GetAttempts()))
+	for idx, attempt := range s.GetAttempts() {
+		attempts[idx] = *FromPromotionAttemptProto(attempt)
+	}
+	approvals := make([]kargoapi.PromotionApproval, len(s.GetApprovals()))
+	for idx, approval := range s.GetApprovals() {
+		approvals[idx] = *FromPromotionApprovalProto(approval)
+	}
+	var startedAt *kubemetav1.Time
+	if s.GetStartedAt() != nil {
+		t := kubemetav1.NewTime(s.GetStartedAt().AsTime())
+		startedAt = &t
+	}
 	return &kargoapi.PromotionStatus{
-		Phase: kargoapi.PromotionPhase(s.GetPhase()),
-		Error: s.GetError(),
+		Phase:              kargoapi.PromotionPhase(s.GetPhase()),
+		AwaitingApproval:   s.GetAwaitingApproval(),
+		Approvals:          approvals,
+		StartedAt:          startedAt,
+		Error:              s.GetError(),
+		PullRequests:       pullRequests,
+		SigningKeyID:       s.GetSigningKeyId(),
+		ArgoCDAppSyncs:     argoCDAppSyncs,
+		HTTPWebhookResults: httpWebhookResults,
+		Steps:              steps,
+		Attempts:           attempts,
+		SupersededBy:       s.GetSupersededBy(),
+	}
+}
+
+func FromPromotionApprovalProto(a *v1alpha1.PromotionApproval) *kargoapi.PromotionApproval {
+	if a == nil {
+		return nil
+	}
+	var approvedAt *kubemetav1.Time
+	if a.GetApprovedAt() != nil {
+		t := kubemetav1.NewTime(a.GetApprovedAt().AsTime())
+		approvedAt = &t
+	}
+	return &kargoapi.PromotionApproval{
+		Username:   a.GetUsername(),
+		ApprovedAt: approvedAt,
+	}
+}
+
+func FromPromotionAttemptProto(a *v1alpha1.PromotionAttempt) *kargoapi.PromotionAttempt {
+	if a == nil {
+		return nil
+	}
+	var finishedAt *kubemetav1.Time
+	if a.GetFinishedAt() != nil {
+		t := kubemetav1.NewTime(a.GetFinishedAt().AsTime())
+		finishedAt = &t
+	}
+	return &kargoapi.PromotionAttempt{
+		Phase:      kargoapi.PromotionPhase(a.GetPhase()),
+		FinishedAt: finishedAt,
+		Class:      kargoapi.PromotionErrorClass(a.GetClass()),
+		Error:      a.GetError(),
+	}
+}
+
+func FromPromotionStepResultProto(r *v1alpha1.PromotionStepResult) *kargoapi.PromotionStepResult {
+	if r == nil {
+		return nil
+	}
+	var startedAt, finishedAt *kubemetav1.Time
+	if r.GetStartedAt() != nil {
+		t := kubemetav1.NewTime(r.GetStartedAt().AsTime())
+		startedAt = &t
+	}
+	if r.GetFinishedAt() != nil {
+		t := kubemetav1.NewTime(r.GetFinishedAt().AsTime())
+		finishedAt = &t
+	}
+	return &kargoapi.PromotionStepResult{
+		Mechanism:  r.GetMechanism(),
+		Target:     r.GetTarget(),
+		StartedAt:  startedAt,
+		FinishedAt: finishedAt,
+		Commit:     r.GetCommit(),
+		Error:      r.GetError(),
+		Message:    r.GetMessage(),
+	}
+}
+
+func FromHTTPWebhookResultProto(r *v1alpha1.HTTPWebhookResult) *kargoapi.HTTPWebhookResult {
+	if r == nil {
+		return nil
+	}
+	return &kargoapi.HTTPWebhookResult{
+		Name:       r.GetName(),
+		Attempts:   r.GetAttempts(),
+		Succeeded:  r.GetSucceeded(),
+		StatusCode: r.GetStatusCode(),
+		Response:   r.GetResponse(),
+		Message:    r.GetMessage(),
+	}
+}
+
+func FromArgoCDAppSyncInfoProto(i *v1alpha1.ArgoCDAppSyncInfo) *kargoapi.ArgoCDAppSyncInfo {
+	if i == nil {
+		return nil
+	}
+	return &kargoapi.ArgoCDAppSyncInfo{
+		Namespace: i.GetNamespace(),
+		Name:      i.GetName(),
+		Phase:     i.GetPhase(),
+		Message:   i.GetMessage(),
+	}
+}
+
+func FromPullRequestInfoProto(p *v1alpha1.PullRequestInfo) *kargoapi.PullRequestInfo {
+	if p == nil {
+		return nil
+	}
+	return &kargoapi.PullRequestInfo{
+		RepoURL:      p.GetRepoUrl(),
+		SourceBranch: p.GetSourceBranch(),
+		TargetBranch: p.GetTargetBranch(),
+		Number:       p.GetNumber(),
+		URL:          p.GetUrl(),
+		State:        kargoapi.PullRequestState(p.GetState()),
+		MergeCommit:  p.GetMergeCommit(),
 	}
 }
 
@@ -559,12 +1077,35 @@ func FromPromotionPolicyProto(p *v1alpha1.PromotionPolicy) *kargoapi.PromotionPo
 			APIVersion: kargoapi.GroupVersion.String(),
 			Kind:       "PromotionPolicy",
 		},
-		ObjectMeta:          objectMeta,
-		Stage:               p.GetStage(),
-		EnableAutoPromotion: p.GetEnableAutoPromotion(),
+		ObjectMeta:           objectMeta,
+		Stage:                p.GetStage(),
+		EnableAutoPromotion:  p.GetEnableAutoPromotion(),
+		AutoPromotionWindows: FromPromotionWindowsProto(p.GetAutoPromotionWindows()),
+		FreezeWindows:        FromPromotionWindowsProto(p.GetFreezeWindows()),
 	}
 }
 
+func FromPromotionWindowsProto(
+	windows []*v1alpha1.PromotionWindow,
+) []kargoapi.PromotionWindow {
+	if windows == nil {
+		return nil
+	}
+	res := make([]kargoapi.PromotionWindow, len(windows))
+	for idx, window := range windows {
+		var duration kubemetav1.Duration
+		if d, err := time.ParseDuration(window.GetDuration()); err == nil {
+			duration = kubemetav1.Duration{Duration: d}
+		}
+		res[idx] = kargoapi.PromotionWindow{
+			Schedule: window.GetSchedule(),
+			Duration: duration,
+			TimeZone: window.GetTimeZone(),
+		}
+	}
+	return res
+}
+
 func ToStageProto(e kargoapi.Stage) *v1alpha1.Stage {
 	// Status
 	var currentFreight *v1alpha1.SimpleFreight
@@ -605,8 +1146,10 @@ func ToStageProto(e kargoapi.Stage) *v1alpha1.Stage {
 		Kind:       e.Kind,
 		Metadata:   typesmetav1.ToObjectMetaProto(*metadata),
 		Spec: &v1alpha1.StageSpec{
-			Subscriptions:       ToSubscriptionsProto(*e.Spec.Subscriptions),
-			PromotionMechanisms: promotionMechanisms,
+			Subscriptions:            ToSubscriptionsProto(*e.Spec.Subscriptions),
+			PromotionMechanisms:      promotionMechanisms,
+			Approval:                 ToApprovalRequirementProto(e.Spec.Approval),
+			SupersedeStalePromotions: e.Spec.SupersedeStalePromotions,
 		},
 		Status: &v1alpha1.StageStatus{
 			CurrentFreight:   currentFreight,
@@ -651,8 +1194,14 @@ func ToSubscriptionsProto(s kargoapi.Subscriptions) *v1alpha1.Subscriptions {
 
 func ToGitSubscriptionProto(g kargoapi.GitSubscription) *v1alpha1.GitSubscription {
 	return &v1alpha1.GitSubscription{
-		RepoUrl: g.RepoURL,
-		Branch:  g.Branch,
+		RepoUrl:                 g.RepoURL,
+		Branch:                  g.Branch,
+		CommitSelectionStrategy: proto.String(string(g.CommitSelectionStrategy)),
+		SemverConstraint:        proto.String(g.SemverConstraint),
+		AllowTags:               proto.String(g.AllowTags),
+		IgnoreTags:              g.IgnoreTags,
+		IncludePaths:            g.IncludePaths,
+		ExcludePaths:            g.ExcludePaths,
 	}
 }
 
@@ -664,6 +1213,26 @@ func ToImageSubscriptionProto(i kargoapi.ImageSubscription) *v1alpha1.ImageSubsc
 		AllowTags:        proto.String(i.AllowTags),
 		IgnoreTags:       i.IgnoreTags,
 		Platform:         proto.String(i.Platform),
+		Verification:     ToImageVerificationProto(i.Verification),
+	}
+}
+
+func ToImageVerificationProto(v *kargoapi.ImageVerification) *v1alpha1.ImageVerification {
+	if v == nil {
+		return nil
+	}
+	var keyless *v1alpha1.KeylessVerification
+	if v.Keyless != nil {
+		keyless = &v1alpha1.KeylessVerification{
+			Identity:                 v.Keyless.Identity,
+			Issuer:                   v.Keyless.Issuer,
+			CertificateAuthorities:   v.Keyless.CertificateAuthorities,
+			TransparencyLogPublicKey: v.Keyless.TransparencyLogPublicKey,
+		}
+	}
+	return &v1alpha1.ImageVerification{
+		PublicKey: proto.String(v.PublicKey),
+		Keyless:   keyless,
 	}
 }
 
@@ -690,9 +1259,152 @@ func ToPromotionMechanismsProto(p kargoapi.PromotionMechanisms) *v1alpha1.Promot
 	for idx := range p.ArgoCDAppUpdates {
 		argoCDAppUpdates[idx] = ToArgoCDAppUpdateProto(p.ArgoCDAppUpdates[idx])
 	}
+	fluxUpdates := make([]*v1alpha1.FluxUpdate, len(p.FluxUpdates))
+	for idx := range p.FluxUpdates {
+		fluxUpdates[idx] = ToFluxUpdateProto(p.FluxUpdates[idx])
+	}
+	kubernetesPatches := make([]*v1alpha1.KubernetesPatch, len(p.KubernetesPatches))
+	for idx := range p.KubernetesPatches {
+		kubernetesPatches[idx] = ToKubernetesPatchProto(p.KubernetesPatches[idx])
+	}
+	jobs := make([]*v1alpha1.PromotionJob, len(p.Jobs))
+	for idx, job := range p.Jobs {
+		jobs[idx] = &v1alpha1.PromotionJob{
+			Name:     job.Name,
+			Template: job.Template,
+		}
+	}
+	httpWebhooks := make([]*v1alpha1.HTTPWebhook, len(p.HTTPWebhooks))
+	for idx := range p.HTTPWebhooks {
+		httpWebhooks[idx] = ToHTTPWebhookProto(p.HTTPWebhooks[idx])
+	}
+	var timeout *string
+	if p.Timeout != nil {
+		timeout = proto.String(p.Timeout.Duration.String())
+	}
 	return &v1alpha1.PromotionMechanisms{
-		GitRepoUpdates:   gitRepoUpdates,
-		ArgocdAppUpdates: argoCDAppUpdates,
+		GitRepoUpdates:    gitRepoUpdates,
+		ArgocdAppUpdates:  argoCDAppUpdates,
+		FluxUpdates:       fluxUpdates,
+		KubernetesPatches: kubernetesPatches,
+		Jobs:              jobs,
+		HttpWebhooks:      httpWebhooks,
+		Timeout:           timeout,
+		Retry:             ToPromotionRetryPolicyProto(p.Retry),
+	}
+}
+
+func ToPromotionRetryPolicyProto(
+	p *kargoapi.PromotionRetryPolicy,
+) *v1alpha1.PromotionRetryPolicy {
+	if p == nil {
+		return nil
+	}
+	var backoff, maxBackoff *string
+	if p.Backoff != nil {
+		backoff = proto.String(p.Backoff.Duration.String())
+	}
+	if p.MaxBackoff != nil {
+		maxBackoff = proto.String(p.MaxBackoff.Duration.String())
+	}
+	retryOn := make([]string, len(p.RetryOn))
+	for idx, class := range p.RetryOn {
+		retryOn[idx] = string(class)
+	}
+	return &v1alpha1.PromotionRetryPolicy{
+		MaxAttempts: p.MaxAttempts,
+		Backoff:     backoff,
+		MaxBackoff:  maxBackoff,
+		RetryOn:     retryOn,
+	}
+}
+
+func ToApprovalRequirementProto(
+	a *kargoapi.ApprovalRequirement,
+) *v1alpha1.ApprovalRequirement {
+	if a == nil {
+		return nil
+	}
+	return &v1alpha1.ApprovalRequirement{
+		RequiredApprovals: a.RequiredApprovals,
+		Groups:            a.Groups,
+	}
+}
+
+func ToHTTPWebhookProto(w kargoapi.HTTPWebhook) *v1alpha1.HTTPWebhook {
+	headers := make([]*v1alpha1.HTTPHeader, len(w.Headers))
+	for idx, header := range w.Headers {
+		headers[idx] = &v1alpha1.HTTPHeader{
+			Name:  header.Name,
+			Value: header.Value,
+		}
+	}
+	var successCriteria *v1alpha1.HTTPSuccessCriteria
+	if w.SuccessCriteria != nil {
+		successCriteria = &v1alpha1.HTTPSuccessCriteria{
+			StatusCodes: w.SuccessCriteria.StatusCodes,
+			JsonPath:    proto.String(w.SuccessCriteria.JSONPath),
+			Value:       proto.String(w.SuccessCriteria.Value),
+		}
+	}
+	return &v1alpha1.HTTPWebhook{
+		Name:              w.Name,
+		Url:               w.URL,
+		Method:            proto.String(w.Method),
+		Headers:           headers,
+		HeadersSecretName: proto.String(w.HeadersSecretName),
+		Body:              proto.String(w.Body),
+		SuccessCriteria:   successCriteria,
+		TimeoutSeconds:    proto.Int32(w.TimeoutSeconds),
+		Retries:           proto.Int32(w.Retries),
+	}
+}
+
+func ToKubernetesPatchProto(p kargoapi.KubernetesPatch) *v1alpha1.KubernetesPatch {
+	return &v1alpha1.KubernetesPatch{
+		ApiVersion: p.APIVersion,
+		Kind:       p.Kind,
+		Name:       p.Name,
+		Namespace:  proto.String(p.Namespace),
+		Type:       string(p.Type),
+		Patch:      p.Patch,
+	}
+}
+
+func ToFluxUpdateProto(u kargoapi.FluxUpdate) *v1alpha1.FluxUpdate {
+	var helmRelease *v1alpha1.FluxHelmReleaseUpdate
+	if u.HelmRelease != nil {
+		helmRelease = ToFluxHelmReleaseUpdateProto(*u.HelmRelease)
+	}
+	var kustomization *v1alpha1.FluxKustomizationUpdate
+	if u.Kustomization != nil {
+		kustomization = &v1alpha1.FluxKustomizationUpdate{
+			Images: u.Kustomization.Images,
+		}
+	}
+	return &v1alpha1.FluxUpdate{
+		Kind:          string(u.Kind),
+		Name:          u.Name,
+		Namespace:     proto.String(u.Namespace),
+		HelmRelease:   helmRelease,
+		Kustomization: kustomization,
+	}
+}
+
+func ToFluxHelmReleaseUpdateProto(
+	u kargoapi.FluxHelmReleaseUpdate,
+) *v1alpha1.FluxHelmReleaseUpdate {
+	images := make([]*v1alpha1.FluxHelmImageUpdate, len(u.Images))
+	for idx, image := range u.Images {
+		images[idx] = &v1alpha1.FluxHelmImageUpdate{
+			Image: image.Image,
+			Key:   image.Key,
+			Value: string(image.Value),
+		}
+	}
+	return &v1alpha1.FluxHelmReleaseUpdate{
+		ChartRegistryUrl: proto.String(u.ChartRegistryURL),
+		Images:           images,
 	}
 }
 
@@ -709,13 +1421,50 @@ func ToGitRepoUpdateProto(g kargoapi.GitRepoUpdate) *v1alpha1.GitRepoUpdate {
 	if g.Helm != nil {
 		helm = ToHelmPromotionMechanismProto(*g.Helm)
 	}
+	var helmTemplate *v1alpha1.HelmTemplatePromotionMechanism
+	if g.HelmTemplate != nil {
+		helmTemplate = ToHelmTemplatePromotionMechanismProto(*g.HelmTemplate)
+	}
+	yamlUpdates := make([]*v1alpha1.YAMLUpdate, len(g.YAMLUpdates))
+	for idx := range g.YAMLUpdates {
+		yamlUpdates[idx] = ToYAMLUpdateProto(g.YAMLUpdates[idx])
+	}
+	var pullRequest *v1alpha1.PullRequestPromotionMechanism
+	if g.PullRequest != nil {
+		pullRequest = ToPullRequestPromotionMechanismProto(*g.PullRequest)
+	}
 	return &v1alpha1.GitRepoUpdate{
-		RepoUrl:     g.RepoURL,
-		ReadBranch:  proto.String(g.ReadBranch),
-		WriteBranch: g.WriteBranch,
-		Render:      render,
-		Kustomize:   kustomize,
-		Helm:        helm,
+		RepoUrl:               g.RepoURL,
+		ReadBranch:            proto.String(g.ReadBranch),
+		WriteBranch:           g.WriteBranch,
+		Render:                render,
+		Kustomize:             kustomize,
+		Helm:                  helm,
+		HelmTemplate:          helmTemplate,
+		YamlUpdates:           yamlUpdates,
+		PullRequest:           pullRequest,
+		CommitMessageTemplate: proto.String(g.CommitMessageTemplate),
+	}
+}
+
+func ToYAMLUpdateProto(y kargoapi.YAMLUpdate) *v1alpha1.YAMLUpdate {
+	return &v1alpha1.YAMLUpdate{
+		File:             y.File,
+		Key:              y.Key,
+		Value:            string(y.Value),
+		Image:            proto.String(y.Image),
+		GitRepoUrl:       proto.String(y.GitRepoURL),
+		ChartRegistryUrl: proto.String(y.ChartRegistryURL),
+		ChartName:        proto.String(y.ChartName),
+	}
+}
+
+func ToPullRequestPromotionMechanismProto(
+	p kargoapi.PullRequestPromotionMechanism,
+) *v1alpha1.PullRequestPromotionMechanism {
+	return &v1alpha1.PullRequestPromotionMechanism{
+		Provider: proto.String(string(p.Provider)),
+		ApiUrl:   proto.String(p.APIURL),
 	}
 }
 
@@ -732,15 +1481,28 @@ func ToKustomizePromotionMechanismProto(
 	for idx := range k.Images {
 		images[idx] = ToKustomizeImageUpdateProto(k.Images[idx])
 	}
+	var build *v1alpha1.KustomizeBuild
+	if k.Build != nil {
+		build = ToKustomizeBuildProto(*k.Build)
+	}
 	return &v1alpha1.KustomizePromotionMechanism{
 		Images: images,
+		Build:  build,
+	}
+}
+
+func ToKustomizeBuildProto(k kargoapi.KustomizeBuild) *v1alpha1.KustomizeBuild {
+	return &v1alpha1.KustomizeBuild{
+		Path:       k.Path,
+		OutputPath: proto.String(k.OutputPath),
 	}
 }
 
 func ToKustomizeImageUpdateProto(k kargoapi.KustomizeImageUpdate) *v1alpha1.KustomizeImageUpdate {
 	return &v1alpha1.KustomizeImageUpdate{
-		Image: k.Image,
-		Path:  k.Path,
+		Image:     k.Image,
+		Path:      k.Path,
+		UseDigest: proto.Bool(k.UseDigest),
 	}
 }
 
@@ -759,6 +1521,33 @@ func ToHelmPromotionMechanismProto(h kargoapi.HelmPromotionMechanism) *v1alpha1.
 	}
 }
 
+func ToHelmTemplatePromotionMechanismProto(
+	h kargoapi.HelmTemplatePromotionMechanism,
+) *v1alpha1.HelmTemplatePromotionMechanism {
+	images := make([]*v1alpha1.HelmTemplateImageUpdate, len(h.Images))
+	for idx := range h.Images {
+		images[idx] = ToHelmTemplateImageUpdateProto(h.Images[idx])
+	}
+	return &v1alpha1.HelmTemplatePromotionMechanism{
+		ChartPath:   h.ChartPath,
+		ReleaseName: h.ReleaseName,
+		Namespace:   proto.String(h.Namespace),
+		ValuesFiles: h.ValuesFiles,
+		Images:      images,
+		OutputPath:  proto.String(h.OutputPath),
+	}
+}
+
+func ToHelmTemplateImageUpdateProto(
+	h kargoapi.HelmTemplateImageUpdate,
+) *v1alpha1.HelmTemplateImageUpdate {
+	return &v1alpha1.HelmTemplateImageUpdate{
+		Image: h.Image,
+		Key:   h.Key,
+		Value: string(h.Value),
+	}
+}
+
 func ToHelmImageUpdateProto(h kargoapi.HelmImageUpdate) *v1alpha1.HelmImageUpdate {
 	return &v1alpha1.HelmImageUpdate{
 		Image:          h.Image,
@@ -785,6 +1574,7 @@ func ToArgoCDAppUpdateProto(h kargoapi.ArgoCDAppUpdate) *v1alpha1.ArgoCDAppUpdat
 		AppName:       h.AppName,
 		AppNamespace:  proto.String(h.AppNamespace),
 		SourceUpdates: sourceUpdates,
+		WaitForSync:   proto.Bool(h.WaitForSync),
 	}
 }
 
@@ -808,7 +1598,8 @@ func ToArgoCDSourceUpdateProto(a kargoapi.ArgoCDSourceUpdate) *v1alpha1.ArgoCDSo
 
 func ToArgoCDKustomizeProto(a kargoapi.ArgoCDKustomize) *v1alpha1.ArgoCDKustomize {
 	return &v1alpha1.ArgoCDKustomize{
-		Images: a.Images,
+		Images:    a.Images,
+		UseDigest: proto.Bool(a.UseDigest),
 	}
 }
 
@@ -897,27 +1688,111 @@ func ToWarehouseProto(w kargoapi.Warehouse) *v1alpha1.Warehouse {
 	}
 	var status *v1alpha1.WarehouseStatus
 	if w.GetStatus() != nil {
+		failures := make(
+			[]*v1alpha1.ImageVerificationFailure,
+			len(w.GetStatus().ImageVerificationFailures),
+		)
+		for idx, f := range w.GetStatus().ImageVerificationFailures {
+			failures[idx] = &v1alpha1.ImageVerificationFailure{
+				RepoUrl: f.RepoURL,
+				Tag:     f.Tag,
+				Digest:  proto.String(f.Digest),
+				Message: f.Message,
+			}
+		}
+		conditions := make(
+			[]*metav1.Condition,
+			len(w.GetStatus().Conditions),
+		)
+		for idx, c := range w.GetStatus().Conditions {
+			conditions[idx] = typesmetav1.ToConditionProto(c)
+		}
+		var lastPolledAt *timestamppb.Timestamp
+		if w.GetStatus().LastPolledAt != nil {
+			lastPolledAt = timestamppb.New(w.GetStatus().LastPolledAt.Time)
+		}
+		var lastFreight *v1alpha1.FreightReference
+		if w.GetStatus().LastFreight != nil {
+			lastFreight = ToFreightReferenceProto(*w.GetStatus().LastFreight)
+		}
+		subscriptionStatuses := make(
+			[]*v1alpha1.SubscriptionStatus,
+			len(w.GetStatus().Subscriptions),
+		)
+		for idx, sub := range w.GetStatus().Subscriptions {
+			subscriptionStatuses[idx] = ToSubscriptionStatusProto(sub)
+		}
 		status = &v1alpha1.WarehouseStatus{
-			Error:              w.GetStatus().Error,
-			ObservedGeneration: w.GetStatus().ObservedGeneration,
+			Error:                     w.GetStatus().Error,
+			ObservedGeneration:        w.GetStatus().ObservedGeneration,
+			ImageVerificationFailures: failures,
+			Conditions:                conditions,
+			LastPolledAt:              lastPolledAt,
+			LastFreight:               lastFreight,
+			Subscriptions:             subscriptionStatuses,
 		}
 	}
+	var pollInterval *string
+	if w.Spec.PollInterval != nil {
+		pollInterval = proto.String(w.Spec.PollInterval.Duration.String())
+	}
 	return &v1alpha1.Warehouse{
 		ApiVersion: w.APIVersion,
 		Kind:       w.Kind,
 		Metadata:   typesmetav1.ToObjectMetaProto(w.ObjectMeta),
 		Spec: &v1alpha1.WarehouseSpec{
 			Subscriptions: subscriptions,
+			PollInterval:  pollInterval,
 		},
 		Status: status,
 	}
 }
 
+func ToFreightReferenceProto(r kargoapi.FreightReference) *v1alpha1.FreightReference {
+	var createdAt *timestamppb.Timestamp
+	if r.CreatedAt != nil {
+		createdAt = timestamppb.New(r.CreatedAt.Time)
+	}
+	return &v1alpha1.FreightReference{
+		Name:      r.Name,
+		CreatedAt: createdAt,
+	}
+}
+
+func ToSubscriptionStatusProto(s kargoapi.SubscriptionStatus) *v1alpha1.SubscriptionStatus {
+	var lastCommit *v1alpha1.GitCommit
+	if s.LastCommit != nil {
+		lastCommit = ToGitCommitProto(*s.LastCommit)
+	}
+	var lastImage *v1alpha1.Image
+	if s.LastImage != nil {
+		lastImage = ToImageProto(*s.LastImage)
+	}
+	var lastChart *v1alpha1.Chart
+	if s.LastChart != nil {
+		lastChart = ToChartProto(*s.LastChart)
+	}
+	var lastDiscoveredAt *timestamppb.Timestamp
+	if s.LastDiscoveredAt != nil {
+		lastDiscoveredAt = timestamppb.New(s.LastDiscoveredAt.Time)
+	}
+	return &v1alpha1.SubscriptionStatus{
+		RepoUrl:          s.RepoURL,
+		ChartName:        proto.String(s.ChartName),
+		LastCommit:       lastCommit,
+		LastImage:        lastImage,
+		LastChart:        lastChart,
+		LastDiscoveredAt: lastDiscoveredAt,
+		Error:            proto.String(s.Error),
+	}
+}
+
 func ToGitCommitProto(g kargoapi.GitCommit) *v1alpha1.GitCommit {
 	return &v1alpha1.GitCommit{
 		RepoUrl:           g.RepoURL,
 		Id:                g.ID,
 		Branch:            g.Branch,
+		Tag:               proto.String(g.Tag),
 		HealthCheckCommit: proto.String(g.HealthCheckCommit),
 		Message:           g.Message,
 		Author:            g.Author,
@@ -928,6 +1803,7 @@ func ToImageProto(i kargoapi.Image) *v1alpha1.Image {
 	return &v1alpha1.Image{
 		RepoUrl: i.RepoURL,
 		Tag:     i.Tag,
+		Digest:  proto.String(i.Digest),
 	}
 }
 
@@ -944,10 +1820,28 @@ func ToHealthProto(h kargoapi.Health) *v1alpha1.Health {
 	for i, argocdAppState := range h.ArgoCDApps {
 		argocdAppStates[i] = ToArgoCDAppStateProto(argocdAppState)
 	}
+	fluxResources := make([]*v1alpha1.FluxResourceStatus, len(h.FluxResources))
+	for i, fluxResource := range h.FluxResources {
+		fluxResources[i] = ToFluxResourceStatusProto(fluxResource)
+	}
 	return &v1alpha1.Health{
-		Status:     string(h.Status),
-		Issues:     h.Issues,
-		ArgocdApps: argocdAppStates,
+		Status:        string(h.Status),
+		Issues:        h.Issues,
+		ArgocdApps:    argocdAppStates,
+		FluxResources: fluxResources,
+	}
+}
+
+func ToFluxResourceStatusProto(
+	f kargoapi.FluxResourceStatus,
+) *v1alpha1.FluxResourceStatus {
+	return &v1alpha1.FluxResourceStatus{
+		Kind:      string(f.Kind),
+		Namespace: f.Namespace,
+		Name:      f.Name,
+		Ready:     f.Ready,
+		Reason:    f.Reason,
+		Message:   f.Message,
 	}
 }
 
@@ -990,13 +1884,131 @@ func ToPromotionProto(p kargoapi.Promotion) *v1alpha1.Promotion {
 		Kind:       p.Kind,
 		Metadata:   typesmetav1.ToObjectMetaProto(*metadata),
 		Spec: &v1alpha1.PromotionSpec{
-			Stage:   p.Spec.Stage,
-			Freight: p.Spec.Freight,
-		},
-		Status: &v1alpha1.PromotionStatus{
-			Phase: string(p.Status.Phase),
-			Error: p.Status.Error,
+			Stage:    p.Spec.Stage,
+			Freight:  p.Spec.Freight,
+			Rollback: p.Spec.Rollback,
 		},
+		Status: ToPromotionStatusProto(p.Status),
+	}
+}
+
+func ToPromotionStatusProto(s kargoapi.PromotionStatus) *v1alpha1.PromotionStatus {
+	pullRequests := make([]*v1alpha1.PullRequestInfo, len(s.PullRequests))
+	for idx := range s.PullRequests {
+		pullRequests[idx] = ToPullRequestInfoProto(s.PullRequests[idx])
+	}
+	argoCDAppSyncs := make([]*v1alpha1.ArgoCDAppSyncInfo, len(s.ArgoCDAppSyncs))
+	for idx := range s.ArgoCDAppSyncs {
+		argoCDAppSyncs[idx] = ToArgoCDAppSyncInfoProto(s.ArgoCDAppSyncs[idx])
+	}
+	httpWebhookResults := make([]*v1alpha1.HTTPWebhookResult, len(s.HTTPWebhookResults))
+	for idx := range s.HTTPWebhookResults {
+		httpWebhookResults[idx] = ToHTTPWebhookResultProto(s.HTTPWebhookResults[idx])
+	}
+	steps := make([]*v1alpha1.PromotionStepResult, len(s.Steps))
+	for idx := range s.Steps {
+		steps[idx] = ToPromotionStepResultProto(s.Steps[idx])
+	}
+	attempts := make([]*v1alpha1.PromotionAttempt, len(s.Attempts))
+	for idx := range s.Attempts {
+		attempts[idx] = ToPromotionAttemptProto(s.Attempts[idx])
+	}
+	approvals := make([]*v1alpha1.PromotionApproval, len(s.Approvals))
+	for idx := range s.Approvals {
+		approvals[idx] = ToPromotionApprovalProto(s.Approvals[idx])
+	}
+	var startedAt *timestamppb.Timestamp
+	if s.StartedAt != nil {
+		startedAt = timestamppb.New(s.StartedAt.Time)
+	}
+	return &v1alpha1.PromotionStatus{
+		Phase:              string(s.Phase),
+		AwaitingApproval:   s.AwaitingApproval,
+		Approvals:          approvals,
+		StartedAt:          startedAt,
+		Error:              s.Error,
+		PullRequests:       pullRequests,
+		SigningKeyId:       s.SigningKeyID,
+		ArgoCdAppSyncs:     argoCDAppSyncs,
+		HttpWebhookResults: httpWebhookResults,
+		Steps:              steps,
+		Attempts:           attempts,
+		SupersededBy:       s.SupersededBy,
+	}
+}
+
+func ToPromotionApprovalProto(a kargoapi.PromotionApproval) *v1alpha1.PromotionApproval {
+	var approvedAt *timestamppb.Timestamp
+	if a.ApprovedAt != nil {
+		approvedAt = timestamppb.New(a.ApprovedAt.Time)
+	}
+	return &v1alpha1.PromotionApproval{
+		Username:   a.Username,
+		ApprovedAt: approvedAt,
+	}
+}
+
+func ToPromotionAttemptProto(a kargoapi.PromotionAttempt) *v1alpha1.PromotionAttempt {
+	var finishedAt *timestamppb.Timestamp
+	if a.FinishedAt != nil {
+		finishedAt = timestamppb.New(a.FinishedAt.Time)
+	}
+	return &v1alpha1.PromotionAttempt{
+		FinishedAt: finishedAt,
+		Class:      string(a.Class),
+		Error:      a.Error,
+		Phase:      string(a.Phase),
+	}
+}
+
+func ToPromotionStepResultProto(r kargoapi.PromotionStepResult) *v1alpha1.PromotionStepResult {
+	var startedAt, finishedAt *timestamppb.Timestamp
+	if r.StartedAt != nil {
+		startedAt = timestamppb.New(r.StartedAt.Time)
+	}
+	if r.FinishedAt != nil {
+		finishedAt = timestamppb.New(r.FinishedAt.Time)
+	}
+	return &v1alpha1.PromotionStepResult{
+		Mechanism:  r.Mechanism,
+		Target:     proto.String(r.Target),
+		StartedAt:  startedAt,
+		FinishedAt: finishedAt,
+		Commit:     proto.String(r.Commit),
+		Error:      proto.String(r.Error),
+		Message:    proto.String(r.Message),
+	}
+}
+
+func ToHTTPWebhookResultProto(r kargoapi.HTTPWebhookResult) *v1alpha1.HTTPWebhookResult {
+	return &v1alpha1.HTTPWebhookResult{
+		Name:       r.Name,
+		Attempts:   r.Attempts,
+		Succeeded:  r.Succeeded,
+		StatusCode: r.StatusCode,
+		Response:   r.Response,
+		Message:    r.Message,
+	}
+}
+
+func ToArgoCDAppSyncInfoProto(i kargoapi.ArgoCDAppSyncInfo) *v1alpha1.ArgoCDAppSyncInfo {
+	return &v1alpha1.ArgoCDAppSyncInfo{
+		Namespace: i.Namespace,
+		Name:      i.Name,
+		Phase:     proto.String(i.Phase),
+		Message:   proto.String(i.Message),
+	}
+}
+
+func ToPullRequestInfoProto(p kargoapi.PullRequestInfo) *v1alpha1.PullRequestInfo {
+	return &v1alpha1.PullRequestInfo{
+		RepoUrl:      p.RepoURL,
+		SourceBranch: p.SourceBranch,
+		TargetBranch: p.TargetBranch,
+		Number:       p.Number,
+		Url:          p.URL,
+		State:        string(p.State),
+		MergeCommit:  proto.String(p.MergeCommit),
 	}
 }
 
@@ -1005,12 +2017,35 @@ func ToPromotionPolicyProto(p kargoapi.PromotionPolicy) *v1alpha1.PromotionPolic
 	metadata.SetManagedFields(nil)
 
 	return &v1alpha1.PromotionPolicy{
-		ApiVersion:          p.APIVersion,
-		Kind:                p.Kind,
-		Metadata:            typesmetav1.ToObjectMetaProto(*metadata),
-		Stage:               p.Stage,
-		EnableAutoPromotion: p.EnableAutoPromotion,
+		ApiVersion:           p.APIVersion,
+		Kind:                 p.Kind,
+		Metadata:             typesmetav1.ToObjectMetaProto(*metadata),
+		Stage:                p.Stage,
+		EnableAutoPromotion:  p.EnableAutoPromotion,
+		AutoPromotionWindows: ToPromotionWindowsProto(p.AutoPromotionWindows),
+		FreezeWindows:        ToPromotionWindowsProto(p.FreezeWindows),
+	}
+}
+
+func ToPromotionWindowsProto(
+	windows []kargoapi.PromotionWindow,
+) []*v1alpha1.PromotionWindow {
+	if windows == nil {
+		return nil
+	}
+	res := make([]*v1alpha1.PromotionWindow, len(windows))
+	for idx, window := range windows {
+		var timeZone *string
+		if window.TimeZone != "" {
+			timeZone = proto.String(window.TimeZone)
+		}
+		res[idx] = &v1alpha1.PromotionWindow{
+			Schedule: window.Schedule,
+			Duration: window.Duration.Duration.String(),
+			TimeZone: timeZone,
+		}
 	}
+	return res
 }
 
 func ToVersionProto(v version.Version) *svcv1alpha1.VersionInfo {
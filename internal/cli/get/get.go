@@ -48,7 +48,10 @@ func printObjects[T runtime.Object](opt *option.Option, objects []T) error {
 		Items: items,
 	}
 
-	if pointer.StringDeref(opt.PrintFlags.OutputFormat, "") != "" {
+	outputFormat := pointer.StringDeref(opt.PrintFlags.OutputFormat, "")
+	// "wide" is not a format known to the printers obtained from PrintFlags.
+	// Instead, it is handled here by printing additional table columns.
+	if outputFormat != "" && outputFormat != "wide" {
 		printer, err := opt.PrintFlags.ToPrinter()
 		if err != nil {
 			return errors.Wrap(err, "new printer")
@@ -59,15 +62,16 @@ func printObjects[T runtime.Object](opt *option.Option, objects []T) error {
 		return printer.PrintObj(list, opt.IOStreams.Out)
 	}
 
+	printOpts := printers.PrintOptions{Wide: outputFormat == "wide"}
 	var t T
 	switch any(t).(type) {
 	case *kargoapi.Stage:
 		table := newStageTable(list)
-		return printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(table, opt.IOStreams.Out)
+		return printers.NewTablePrinter(printOpts).PrintObj(table, opt.IOStreams.Out)
 	case *kargoapi.Promotion:
 		table := newPromotionTable(list)
-		return printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(table, opt.IOStreams.Out)
+		return printers.NewTablePrinter(printOpts).PrintObj(table, opt.IOStreams.Out)
 	default:
-		return printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(list, opt.IOStreams.Out)
+		return printers.NewTablePrinter(printOpts).PrintObj(list, opt.IOStreams.Out)
 	}
 }
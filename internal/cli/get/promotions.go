@@ -2,6 +2,8 @@ package get
 
 import (
 	goerrors "errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
@@ -38,6 +40,9 @@ kargo get promotions --project=my-project
 # List all promotions in JSON output format
 kargo get promotions --project=my-project -o json
 
+# List all promotions along with a summary of their steps
+kargo get promotions --project=my-project -o wide
+
 # List all promotions for the stage
 kargo get promotions --project=my-project --stage=my-stage
 
@@ -110,6 +115,10 @@ func newPromotionTable(list *metav1.List) *metav1.Table {
 				promo.Spec.Freight,
 				promo.GetStatus().Phase,
 				duration.HumanDuration(time.Since(promo.CreationTimestamp.Time)),
+				getPromotionStepsSummary(promo.GetStatus().Steps),
+				getPromotionCurrentStep(promo.GetStatus().Steps),
+				getPromotionStepCommits(promo.GetStatus().Steps),
+				len(promo.GetStatus().Attempts),
 			},
 			Object: list.Items[i],
 		}
@@ -121,7 +130,61 @@ func newPromotionTable(list *metav1.List) *metav1.Table {
 			{Name: "Freight", Type: "string"},
 			{Name: "Phase", Type: "string"},
 			{Name: "Age", Type: "string"},
+			// The following columns are only printed with -o wide
+			{Name: "Steps", Type: "string", Priority: 1},
+			{Name: "Current Step", Type: "string", Priority: 1},
+			{Name: "Commits", Type: "string", Priority: 1},
+			{Name: "Failed Attempts", Type: "integer", Priority: 1},
 		},
 		Rows: rows,
 	}
 }
+
+// getPromotionStepsSummary returns the number of finished steps out of the
+// number of steps recorded in the provided step results, e.g. "2/3".
+func getPromotionStepsSummary(steps []kargoapi.PromotionStepResult) string {
+	var finished int
+	for _, step := range steps {
+		if step.FinishedAt != nil {
+			finished++
+		}
+	}
+	return fmt.Sprintf("%d/%d", finished, len(steps))
+}
+
+// getPromotionCurrentStep returns a description of the first step that either
+// failed or has not finished yet. If there is no such step, the last step is
+// described instead.
+func getPromotionCurrentStep(steps []kargoapi.PromotionStepResult) string {
+	if len(steps) == 0 {
+		return ""
+	}
+	current := steps[len(steps)-1]
+	for _, step := range steps {
+		if step.FinishedAt == nil || step.Error != "" {
+			current = step
+			break
+		}
+	}
+	if current.Target == "" {
+		return current.Mechanism
+	}
+	return fmt.Sprintf("%s (%s)", current.Mechanism, current.Target)
+}
+
+// getPromotionStepCommits returns a comma-separated list of the abbreviated
+// IDs of all commits pushed by the provided steps.
+func getPromotionStepCommits(steps []kargoapi.PromotionStepResult) string {
+	commits := make([]string, 0, len(steps))
+	for _, step := range steps {
+		if step.Commit == "" {
+			continue
+		}
+		commit := step.Commit
+		if len(commit) > 7 {
+			commit = commit[:7]
+		}
+		commits = append(commits, commit)
+	}
+	return strings.Join(commits, ",")
+}
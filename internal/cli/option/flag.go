@@ -43,3 +43,16 @@ func Freight(v *string) FlagFn {
 		fs.StringVar(v, "freight", "", "Freight ID")
 	}
 }
+
+func RollbackSteps(v *int32) FlagFn {
+	return func(fs *pflag.FlagSet) {
+		fs.Int32Var(v, "to", 0,
+			"Number of entries in the Stage's history to go back by (defaults to 1)")
+	}
+}
+
+func DryRun(v *bool) FlagFn {
+	return func(fs *pflag.FlagSet) {
+		fs.BoolVar(v, "dry-run", false, "Preview the changes without making them")
+	}
+}
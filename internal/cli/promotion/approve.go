@@ -0,0 +1,50 @@
+package promotion
+
+import (
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func newApproveCommand(opt *option.Option) *cobra.Command {
+	return &cobra.Command{
+		Use:     "approve",
+		Args:    cobra.ExactArgs(2),
+		Example: "kargo promotion approve (PROJECT) (NAME)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return err
+			}
+
+			project := strings.TrimSpace(args[0])
+			if project == "" {
+				return errors.New("project is required")
+			}
+			name := strings.TrimSpace(args[1])
+			if name == "" {
+				return errors.New("name is required")
+			}
+
+			if _, err = kargoSvcCli.ApprovePromotion(
+				ctx,
+				connect.NewRequest(&v1alpha1.ApprovePromotionRequest{
+					Project: project,
+					Name:    name,
+				}),
+			); err != nil {
+				return errors.Wrap(err, "approve promotion")
+			}
+			fmt.Fprintf(opt.IOStreams.Out, "Promotion Approved: %q\n", name)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,17 @@
+package promotion
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/akuity/kargo/internal/cli/option"
+)
+
+func NewCommand(opt *option.Option) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "promotion",
+		Short: "Manage promotions",
+	}
+	cmd.AddCommand(newAbortCommand(opt))
+	cmd.AddCommand(newApproveCommand(opt))
+	return cmd
+}
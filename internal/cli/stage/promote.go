@@ -1,6 +1,7 @@
 package stage
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -13,10 +14,12 @@ import (
 	"github.com/akuity/kargo/internal/cli/client"
 	"github.com/akuity/kargo/internal/cli/option"
 	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
 )
 
 type PromoteFlags struct {
 	Freight string
+	DryRun  bool
 }
 
 func newPromoteCommand(opt *option.Option) *cobra.Command {
@@ -24,7 +27,7 @@ func newPromoteCommand(opt *option.Option) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "promote",
 		Args:    cobra.ExactArgs(2),
-		Example: "kargo stage promote (PROJECT) (NAME) [(--freight=)freight-id]",
+		Example: "kargo stage promote (PROJECT) (NAME) [(--freight=)freight-id] [--dry-run]",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := cmd.Context()
 			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
@@ -46,6 +49,10 @@ func newPromoteCommand(opt *option.Option) *cobra.Command {
 				return errors.New("freight is required")
 			}
 
+			if flag.DryRun {
+				return previewPromotion(ctx, kargoSvcCli, opt, project, name, freight)
+			}
+
 			res, err := kargoSvcCli.PromoteStage(ctx, connect.NewRequest(&v1alpha1.PromoteStageRequest{
 				Project: project,
 				Name:    name,
@@ -70,5 +77,50 @@ func newPromoteCommand(opt *option.Option) *cobra.Command {
 	}
 	opt.PrintFlags.AddFlags(cmd)
 	option.Freight(&flag.Freight)(cmd.Flags())
+	option.DryRun(&flag.DryRun)(cmd.Flags())
 	return cmd
 }
+
+// previewPromotion prints the changes that promoting the specified Freight to
+// the specified Stage would make, without making them.
+func previewPromotion(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	opt *option.Option,
+	project string,
+	stage string,
+	freight string,
+) error {
+	if pointer.StringDeref(opt.PrintFlags.OutputFormat, "") != "" {
+		return errors.New("output format is not supported with --dry-run")
+	}
+	res, err := kargoSvcCli.PreviewPromotion(ctx, connect.NewRequest(&v1alpha1.PreviewPromotionRequest{
+		Project: project,
+		Stage:   stage,
+		Freight: freight,
+	}))
+	if err != nil {
+		return errors.Wrap(err, "preview promotion")
+	}
+	out := opt.IOStreams.Out
+	for _, diff := range res.Msg.GetGitRepoDiffs() {
+		fmt.Fprintf(out, "Git repository %q, branch %q:\n", diff.GetRepoUrl(), diff.GetBranch())
+		if diff.GetDiff() == "" {
+			fmt.Fprint(out, "No changes\n\n")
+			continue
+		}
+		fmt.Fprintf(out, "Commit message:\n%s\n\n%s\n", diff.GetCommitMessage(), diff.GetDiff())
+	}
+	for _, diff := range res.Msg.GetArgoCdAppDiffs() {
+		fmt.Fprintf(out, "Argo CD Application %q in namespace %q:\n", diff.GetName(), diff.GetNamespace())
+		if diff.GetDiff() == "" {
+			fmt.Fprint(out, "No changes\n\n")
+			continue
+		}
+		fmt.Fprintf(out, "%s\n", diff.GetDiff())
+	}
+	for _, warning := range res.Msg.GetWarnings() {
+		fmt.Fprintf(opt.IOStreams.ErrOut, "Warning: %s\n", warning)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package stage
+
+import (
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/utils/pointer"
+
+	typesv1alpha1 "github.com/akuity/kargo/internal/api/types/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/option"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+type RollbackFlags struct {
+	Freight string
+	Steps   int32
+}
+
+func newRollbackCommand(opt *option.Option) *cobra.Command {
+	var flag RollbackFlags
+	cmd := &cobra.Command{
+		Use:     "rollback",
+		Args:    cobra.ExactArgs(2),
+		Example: "kargo stage rollback (PROJECT) (NAME) [--to=N | --freight=freight-id]",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			kargoSvcCli, err := client.GetClientFromConfig(ctx, opt)
+			if err != nil {
+				return err
+			}
+
+			project := strings.TrimSpace(args[0])
+			if project == "" {
+				return errors.New("project is required")
+			}
+			name := strings.TrimSpace(args[1])
+			if name == "" {
+				return errors.New("name is required")
+			}
+			freight := strings.TrimSpace(flag.Freight)
+			if freight != "" && cmd.Flags().Changed("to") {
+				return errors.New("only one of --to or --freight may be specified")
+			}
+			if flag.Steps < 0 {
+				return errors.New("--to must not be negative")
+			}
+
+			req := &v1alpha1.RollbackStageRequest{
+				Project: project,
+				Name:    name,
+			}
+			if freight != "" {
+				req.Target = &v1alpha1.RollbackStageRequest_Freight{Freight: freight}
+			} else if flag.Steps > 0 {
+				req.Target = &v1alpha1.RollbackStageRequest_Steps{Steps: flag.Steps}
+			}
+			res, err := kargoSvcCli.RollbackStage(ctx, connect.NewRequest(req))
+			if err != nil {
+				return errors.Wrap(err, "rollback stage")
+			}
+			if pointer.StringDeref(opt.PrintFlags.OutputFormat, "") == "" {
+				fmt.Fprintf(opt.IOStreams.Out,
+					"Rollback Promotion Created: %q (Freight %q)\n",
+					res.Msg.GetPromotion().GetMetadata().GetName(),
+					res.Msg.GetPromotion().GetSpec().GetFreight())
+				return nil
+			}
+			printer, err := opt.PrintFlags.ToPrinter()
+			if err != nil {
+				return errors.Wrap(err, "new printer")
+			}
+			promo := typesv1alpha1.FromPromotionProto(res.Msg.GetPromotion())
+			_ = printer.PrintObj(promo, opt.IOStreams.Out)
+			return nil
+		},
+	}
+	opt.PrintFlags.AddFlags(cmd)
+	option.Freight(&flag.Freight)(cmd.Flags())
+	option.RollbackSteps(&flag.Steps)(cmd.Flags())
+	return cmd
+}
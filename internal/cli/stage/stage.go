@@ -12,6 +12,7 @@ func NewCommand(opt *option.Option) *cobra.Command {
 		Short: "Manage stages",
 	}
 	cmd.AddCommand(newPromoteCommand(opt))
+	cmd.AddCommand(newRollbackCommand(opt))
 	cmd.AddCommand(newEnableAutoPromotion(opt))
 	cmd.AddCommand(newDisableAutoPromotion(opt))
 	cmd.AddCommand(newPromoteSubscribersCommand(opt))
@@ -55,8 +55,17 @@ type ApplicationSourceKustomize struct {
 }
 
 type ApplicationStatus struct {
-	Health HealthStatus `json:"health,omitempty"`
-	Sync   SyncStatus   `json:"sync,omitempty"`
+	Health         HealthStatus    `json:"health,omitempty"`
+	Sync           SyncStatus      `json:"sync,omitempty"`
+	OperationState *OperationState `json:"operationState,omitempty"`
+}
+
+type OperationState struct {
+	Operation  Operation      `json:"operation"`
+	Phase      OperationPhase `json:"phase"`
+	Message    string         `json:"message,omitempty"`
+	StartedAt  metav1.Time    `json:"startedAt"`
+	FinishedAt *metav1.Time   `json:"finishedAt,omitempty"`
 }
 
 type OperationInitiator struct {
@@ -0,0 +1,138 @@
+package flux
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// AnnotationKeyReconcileRequestedAt is the key of the annotation that, when its
+// value changes, causes a Flux controller to immediately reconcile the
+// annotated resource.
+const AnnotationKeyReconcileRequestedAt = "reconcile.fluxcd.io/requestedAt"
+
+// ConditionTypeReady is the type of the condition used by all Flux resources to
+// summarize their readiness.
+const ConditionTypeReady = "Ready"
+
+var groupVersionKinds = map[kargoapi.FluxResourceKind]schema.GroupVersionKind{
+	kargoapi.FluxResourceKindGitRepository: {
+		Group:   "source.toolkit.fluxcd.io",
+		Version: "v1",
+		Kind:    string(kargoapi.FluxResourceKindGitRepository),
+	},
+	kargoapi.FluxResourceKindOCIRepository: {
+		Group:   "source.toolkit.fluxcd.io",
+		Version: "v1beta2",
+		Kind:    string(kargoapi.FluxResourceKindOCIRepository),
+	},
+	kargoapi.FluxResourceKindHelmRelease: {
+		Group:   "helm.toolkit.fluxcd.io",
+		Version: "v2beta1",
+		Kind:    string(kargoapi.FluxResourceKindHelmRelease),
+	},
+	kargoapi.FluxResourceKindKustomization: {
+		Group:   "kustomize.toolkit.fluxcd.io",
+		Version: "v1",
+		Kind:    string(kargoapi.FluxResourceKindKustomization),
+	},
+}
+
+// GroupVersionKindFor returns the GroupVersionKind that Kargo uses for the
+// specified kind of Flux resource.
+func GroupVersionKindFor(
+	kind kargoapi.FluxResourceKind,
+) (schema.GroupVersionKind, error) {
+	gvk, ok := groupVersionKinds[kind]
+	if !ok {
+		return schema.GroupVersionKind{},
+			errors.Errorf("unsupported Flux resource kind %q", kind)
+	}
+	return gvk, nil
+}
+
+// GetResource returns the specified Flux resource. If the resource is not
+// found, nil is returned.
+func GetResource(
+	ctx context.Context,
+	ctrlRuntimeClient client.Client,
+	kind kargoapi.FluxResourceKind,
+	namespace string,
+	name string,
+) (*unstructured.Unstructured, error) {
+	gvk, err := GroupVersionKindFor(kind)
+	if err != nil {
+		return nil, err
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	if err = ctrlRuntimeClient.Get(
+		ctx,
+		client.ObjectKey{
+			Namespace: namespace,
+			Name:      name,
+		},
+		obj,
+	); err != nil {
+		if err = client.IgnoreNotFound(err); err == nil {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(
+			err,
+			"error getting Flux %s %q in namespace %q",
+			kind,
+			name,
+			namespace,
+		)
+	}
+	return obj, nil
+}
+
+// Condition is a simplified representation of a condition found in a Flux
+// resource's status.
+type Condition struct {
+	Status  string
+	Reason  string
+	Message string
+}
+
+// GetCondition returns the condition of the specified type from the provided
+// Flux resource's status. If no such condition exists, nil is returned.
+func GetCondition(
+	obj *unstructured.Unstructured,
+	conditionType string,
+) *Condition {
+	conditions, _, _ :=
+		unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		if t, _, _ := unstructured.NestedString(condition, "type"); t != conditionType {
+			continue
+		}
+		status, _, _ := unstructured.NestedString(condition, "status")
+		reason, _, _ := unstructured.NestedString(condition, "reason")
+		message, _, _ := unstructured.NestedString(condition, "message")
+		return &Condition{
+			Status:  status,
+			Reason:  reason,
+			Message: message,
+		}
+	}
+	return nil
+}
+
+// IsReconciled returns true if the Flux controller responsible for the
+// provided resource has observed the resource's most recent generation.
+func IsReconciled(obj *unstructured.Unstructured) bool {
+	observedGeneration, _, _ :=
+		unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	return observedGeneration >= obj.GetGeneration()
+}
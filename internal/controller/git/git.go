@@ -10,7 +10,9 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
 	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
 
 	libExec "github.com/akuity/kargo/internal/exec"
 )
@@ -31,6 +33,81 @@ type RepoCredentials struct {
 	Password string `json:"password,omitempty"`
 }
 
+// SigningKeyType is a string type used to represent a type of key with which
+// commits may be signed.
+type SigningKeyType string
+
+const (
+	// SigningKeyTypeGPG represents an ASCII-armored GPG private key.
+	SigningKeyTypeGPG SigningKeyType = "gpg"
+	// SigningKeyTypeSSH represents an SSH private key.
+	SigningKeyTypeSSH SigningKeyType = "ssh"
+)
+
+// User represents the identity with which commits are authored and,
+// optionally, the key with which they are signed.
+type User struct {
+	// Name is the name of the commit author.
+	Name string
+	// Email is the email address of the commit author.
+	Email string
+	// SigningKeyType indicates the type of the key specified by the SigningKey
+	// field.
+	SigningKeyType SigningKeyType
+	// SigningKey is a private key with which commits should be signed. If
+	// empty, commits are not signed.
+	SigningKey string
+}
+
+// SigningKeyID returns an identifier for the user's signing key. For GPG keys,
+// this is the key ID of the primary key. For SSH keys, this is the SHA256
+// fingerprint of the public key. If the user has no signing key, the empty
+// string is returned.
+func (u *User) SigningKeyID() (string, error) {
+	if u == nil || u.SigningKey == "" {
+		return "", nil
+	}
+	switch u.SigningKeyType {
+	case SigningKeyTypeGPG:
+		entities, err :=
+			openpgp.ReadArmoredKeyRing(strings.NewReader(u.SigningKey))
+		if err != nil {
+			return "", errors.Wrap(err, "error reading GPG signing key")
+		}
+		if len(entities) == 0 || entities[0].PrivateKey == nil {
+			return "", errors.New("GPG signing key does not contain a private key")
+		}
+		return entities[0].PrimaryKey.KeyIdString(), nil
+	case SigningKeyTypeSSH:
+		signer, err := ssh.ParsePrivateKey([]byte(u.SigningKey))
+		if err != nil {
+			return "", errors.Wrap(err, "error parsing SSH signing key")
+		}
+		return ssh.FingerprintSHA256(signer.PublicKey()), nil
+	default:
+		return "", errors.Errorf(
+			"unsupported signing key type %q",
+			u.SigningKeyType,
+		)
+	}
+}
+
+// CommitPaths identifies a commit and the paths, relative to the root of the
+// repository, of any files that were added, modified, or deleted by it.
+type CommitPaths struct {
+	// ID is the ID (sha) of the commit.
+	ID string
+	// Paths are the paths of the files changed by the commit.
+	Paths []string
+}
+
+// CloneOptions represents options for cloning a git repository.
+type CloneOptions struct {
+	// User is the identity with which commits are authored and, optionally,
+	// signed. If nil, a default identity is used and commits are not signed.
+	User *User
+}
+
 // Repo is an interface for interacting with a git repository.
 type Repo interface {
 	// AddAll stages pending changes for commit.
@@ -39,6 +116,10 @@ type Repo interface {
 	// commit to the current branch and then commits them using the provided
 	// commit message.
 	AddAllAndCommit(message string) error
+	// AddAllAndDiff is a convenience function that stages pending changes for
+	// commit to the current branch and then returns a unified diff of those
+	// changes against the head of the current branch.
+	AddAllAndDiff() (string, error)
 	// Clean cleans the working directory.
 	Clean() error
 	// Close cleans up file system resources used by this repository. This should
@@ -64,6 +145,14 @@ type Repo interface {
 	// LastCommitID returns the ID (sha) of the most recent commit to the current
 	// branch.
 	LastCommitID() (string, error)
+	// ListCommitPaths returns up to limit commits from the first-parent history
+	// of the current branch, ordered from newest to oldest, after skipping the
+	// specified number of newest commits. Each is returned along with the paths
+	// of the files it changed. Merge commits are compared to their first parent.
+	ListCommitPaths(limit uint, skip uint) ([]CommitPaths, error)
+	// ListTags fetches all tags from the remote repository and returns their
+	// names, ordered from most to least recently created.
+	ListTags() ([]string, error)
 	// CommitMessage returns the text of the most recent commit message associated
 	// with the specified commit ID.
 	CommitMessage(id string) (string, error)
@@ -99,11 +188,16 @@ type repo struct {
 // URL and returns an implementation of the Repo interface that is stateful and
 // NOT suitable for use across multiple goroutines. This function will also
 // perform any setup that is required for successfully authenticating to the
-// remote repository.
+// remote repository and for authoring and signing commits. The provided
+// options may be nil.
 func Clone(
 	repoURL string,
 	repoCreds RepoCredentials,
+	opts *CloneOptions,
 ) (Repo, error) {
+	if opts == nil {
+		opts = &CloneOptions{}
+	}
 	homeDir, err := os.MkdirTemp("", "")
 	if err != nil {
 		return nil, errors.Wrapf(
@@ -117,6 +211,9 @@ func Clone(
 		homeDir: homeDir,
 		dir:     filepath.Join(homeDir, "repo"),
 	}
+	if err = r.setupUser(opts.User); err != nil {
+		return nil, err
+	}
 	if err = r.setupAuth(repoCreds); err != nil {
 		return nil, err
 	}
@@ -135,6 +232,15 @@ func (r *repo) AddAllAndCommit(message string) error {
 	return r.Commit(message)
 }
 
+func (r *repo) AddAllAndDiff() (string, error) {
+	if err := r.AddAll(); err != nil {
+		return "", err
+	}
+	resBytes, err := libExec.Exec(r.buildCommand("diff", "--cached"))
+	return string(resBytes),
+		errors.Wrapf(err, "error diffing staged changes on branch %q", r.currentBranch)
+}
+
 func (r *repo) Clean() error {
 	_, err := libExec.Exec(r.buildCommand("clean", "-fd"))
 	return errors.Wrapf(err, "error cleaning branch %q", r.currentBranch)
@@ -251,6 +357,59 @@ func (r *repo) LastCommitID() (string, error) {
 		errors.Wrap(err, "error obtaining ID of last commit")
 }
 
+func (r *repo) ListCommitPaths(limit uint, skip uint) ([]CommitPaths, error) {
+	// Each commit's ID is preceded by a NUL byte and followed by the paths it
+	// changed, one per line. Rename detection is disabled so that both the old
+	// and the new path of a renamed file are listed.
+	resBytes, err := libExec.Exec(r.buildCommand(
+		"log",
+		"--first-parent",
+		"-m",
+		"--name-only",
+		"--no-renames",
+		"--pretty=format:%x00%H",
+		fmt.Sprintf("--max-count=%d", limit),
+		fmt.Sprintf("--skip=%d", skip),
+	))
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error listing commits on branch %q",
+			r.currentBranch,
+		)
+	}
+	return parseCommitPaths(resBytes), nil
+}
+
+// parseCommitPaths parses the output of ListCommitPaths' git log command.
+func parseCommitPaths(output []byte) []CommitPaths {
+	commits := []CommitPaths{}
+	for _, entry := range strings.Split(string(output), "\x00") {
+		lines := splitLines([]byte(entry))
+		if len(lines) == 0 {
+			continue
+		}
+		commits = append(commits, CommitPaths{ID: lines[0], Paths: lines[1:]})
+	}
+	return commits
+}
+
+func (r *repo) ListTags() ([]string, error) {
+	// The repository is cloned without tags, so they must be fetched first.
+	if _, err := libExec.Exec(
+		r.buildCommand("fetch", "--tags", "--force", "origin"),
+	); err != nil {
+		return nil, errors.Wrapf(err, "error fetching tags from repo %q", r.url)
+	}
+	tagsBytes, err := libExec.Exec(
+		r.buildCommand("tag", "--list", "--sort=-creatordate"),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error listing tags for repo %q", r.url)
+	}
+	return splitLines(tagsBytes), nil
+}
+
 func (r *repo) CommitMessage(id string) (string, error) {
 	msgBytes, err := libExec.Exec(
 		r.buildCommand("log", "-n", "1", "--pretty=format:%s", id),
@@ -333,22 +492,86 @@ func (r *repo) WorkingDir() string {
 	return r.dir
 }
 
-// SetupAuth configures the git CLI for authentication using either SSH or the
-// "store" (username/password-based) credential helper.
-func (r *repo) setupAuth(repoCreds RepoCredentials) error {
-	// Configure the git client
-	cmd := r.buildCommand("config", "--global", "user.name", "Kargo Render")
-	cmd.Dir = r.homeDir // Override the cmd.Dir that's set by r.buildCommand()
-	if _, err := libExec.Exec(cmd); err != nil {
+// setupUser configures the git CLI to author commits using the provided
+// identity and, if the identity includes a signing key, to sign them. If the
+// provided identity is nil, a default identity is used.
+func (r *repo) setupUser(user *User) error {
+	name := "Kargo Render"
+	email := "kargo-render@akuity.io"
+	if user != nil && user.Name != "" {
+		name = user.Name
+	}
+	if user != nil && user.Email != "" {
+		email = user.Email
+	}
+	if err := r.setGlobalConfig("user.name", name); err != nil {
 		return errors.Wrapf(err, "error configuring git username")
 	}
-	cmd =
-		r.buildCommand("config", "--global", "user.email", "kargo-render@akuity.io")
-	cmd.Dir = r.homeDir // Override the cmd.Dir that's set by r.buildCommand()
-	if _, err := libExec.Exec(cmd); err != nil {
+	if err := r.setGlobalConfig("user.email", email); err != nil {
 		return errors.Wrapf(err, "error configuring git user email address")
 	}
+	if user == nil || user.SigningKey == "" {
+		return nil // We're done
+	}
 
+	keyID, err := user.SigningKeyID()
+	if err != nil {
+		return err
+	}
+	switch user.SigningKeyType {
+	case SigningKeyTypeGPG:
+		// Import the key into the keyring in the home directory
+		cmd := exec.Command("gpg", "--batch", "--import")
+		cmd.Env = []string{fmt.Sprintf("HOME=%s", r.homeDir)}
+		cmd.Dir = r.homeDir
+		cmd.Stdin = strings.NewReader(user.SigningKey)
+		if _, err = libExec.Exec(cmd); err != nil {
+			return errors.Wrap(err, "error importing GPG signing key")
+		}
+		if err = r.setGlobalConfig("gpg.format", "openpgp"); err != nil {
+			return errors.Wrap(err, "error configuring git signing key format")
+		}
+		if err = r.setGlobalConfig("user.signingkey", keyID); err != nil {
+			return errors.Wrap(err, "error configuring git signing key")
+		}
+	case SigningKeyTypeSSH:
+		sshDir := filepath.Join(r.homeDir, ".ssh")
+		if err = os.MkdirAll(sshDir, 0700); err != nil {
+			return errors.Wrapf(err, "error creating directory %q", sshDir)
+		}
+		keyPath := filepath.Join(sshDir, "signing_key")
+		if err = os.WriteFile(
+			keyPath,
+			[]byte(user.SigningKey),
+			0600,
+		); err != nil {
+			return errors.Wrapf(err, "error writing SSH signing key to %q", keyPath)
+		}
+		if err = r.setGlobalConfig("gpg.format", "ssh"); err != nil {
+			return errors.Wrap(err, "error configuring git signing key format")
+		}
+		if err = r.setGlobalConfig("user.signingkey", keyPath); err != nil {
+			return errors.Wrap(err, "error configuring git signing key")
+		}
+	}
+	return errors.Wrap(
+		r.setGlobalConfig("commit.gpgsign", "true"),
+		"error configuring git to sign commits",
+	)
+}
+
+// setGlobalConfig sets the specified key to the specified value in the git
+// CLI's global configuration.
+func (r *repo) setGlobalConfig(key, value string) error {
+	cmd := r.buildCommand("config", "--global", key, value)
+	cmd.Dir = r.homeDir // Override the cmd.Dir that's set by r.buildCommand()
+	_, err := libExec.Exec(cmd)
+	return err
+}
+
+// SetupAuth configures the git CLI for authentication using either SSH or the
+// "store" (username/password-based) credential helper.
+func (r *repo) setupAuth(repoCreds RepoCredentials) error {
 	// If an SSH key was provided, use that.
 	if repoCreds.SSHPrivateKey != "" {
 		sshConfigPath := filepath.Join(r.homeDir, ".ssh", "config")
@@ -373,9 +596,7 @@ func (r *repo) setupAuth(repoCreds RepoCredentials) error {
 	// If we get to here, we're authenticating using a password
 
 	// Set up the credential helper
-	cmd = r.buildCommand("config", "--global", "credential.helper", "store")
-	cmd.Dir = r.homeDir // Override the cmd.Dir that's set by r.buildCommand()
-	if _, err := libExec.Exec(cmd); err != nil {
+	if err := r.setGlobalConfig("credential.helper", "store"); err != nil {
 		return errors.Wrapf(err, "error configuring git credential helper")
 	}
 
@@ -422,3 +643,16 @@ func (r *repo) buildCommand(arg ...string) *exec.Cmd {
 	cmd.Dir = r.dir
 	return cmd
 }
+
+// splitLines splits the provided command output into its non-empty lines.
+func splitLines(output []byte) []string {
+	lines := []string{}
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	scanner.Split(bufio.ScanLines)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
@@ -0,0 +1,158 @@
+package git
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestUserSigningKeyID(t *testing.T) {
+	// Generate a GPG key
+	entity, err := openpgp.NewEntity("fake-name", "", "fake-email", nil)
+	require.NoError(t, err)
+	gpgKeyBuf := &bytes.Buffer{}
+	armorWriter, err := armor.Encode(gpgKeyBuf, openpgp.PrivateKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.SerializePrivate(armorWriter, nil))
+	require.NoError(t, armorWriter.Close())
+
+	// Generate an SSH key
+	_, sshPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	sshKeyBlock, err := ssh.MarshalPrivateKey(sshPrivateKey, "")
+	require.NoError(t, err)
+	sshSigner, err := ssh.NewSignerFromKey(sshPrivateKey)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		user       *User
+		assertions func(keyID string, err error)
+	}{
+		{
+			name: "nil user",
+			assertions: func(keyID string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, keyID)
+			},
+		},
+		{
+			name: "no signing key",
+			user: &User{
+				Name:  "fake-name",
+				Email: "fake-email",
+			},
+			assertions: func(keyID string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, keyID)
+			},
+		},
+		{
+			name: "unsupported signing key type",
+			user: &User{
+				SigningKeyType: "bogus",
+				SigningKey:     "fake-key",
+			},
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "unsupported signing key type")
+			},
+		},
+		{
+			name: "invalid GPG key",
+			user: &User{
+				SigningKeyType: SigningKeyTypeGPG,
+				SigningKey:     "fake-key",
+			},
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error reading GPG signing key")
+			},
+		},
+		{
+			name: "valid GPG key",
+			user: &User{
+				SigningKeyType: SigningKeyTypeGPG,
+				SigningKey:     gpgKeyBuf.String(),
+			},
+			assertions: func(keyID string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, entity.PrimaryKey.KeyIdString(), keyID)
+			},
+		},
+		{
+			name: "invalid SSH key",
+			user: &User{
+				SigningKeyType: SigningKeyTypeSSH,
+				SigningKey:     "fake-key",
+			},
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing SSH signing key")
+			},
+		},
+		{
+			name: "valid SSH key",
+			user: &User{
+				SigningKeyType: SigningKeyTypeSSH,
+				SigningKey:     string(pem.EncodeToMemory(sshKeyBlock)),
+			},
+			assertions: func(keyID string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					ssh.FingerprintSHA256(sshSigner.PublicKey()),
+					keyID,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(testCase.user.SigningKeyID())
+		})
+	}
+}
+
+func TestParseCommitPaths(t *testing.T) {
+	require.Equal(t, []CommitPaths{}, parseCommitPaths(nil))
+	require.Equal(
+		t,
+		[]CommitPaths{
+			{
+				ID:    "fake-commit-1",
+				Paths: []string{"apps/foo/values.yaml", "docs/README.md"},
+			},
+			{
+				// e.g. An empty commit
+				ID:    "fake-commit-2",
+				Paths: []string{},
+			},
+			{
+				ID:    "fake-commit-3",
+				Paths: []string{"apps/bar/values.yaml"},
+			},
+		},
+		parseCommitPaths([]byte(
+			"\x00fake-commit-1\napps/foo/values.yaml\ndocs/README.md\n" +
+				"\x00fake-commit-2\n" +
+				"\x00fake-commit-3\napps/bar/values.yaml",
+		)),
+	)
+}
+
+func TestSplitLines(t *testing.T) {
+	require.Equal(t, []string{}, splitLines(nil))
+	require.Equal(
+		t,
+		[]string{"apps/foo/values.yaml", "docs/README.md"},
+		splitLines([]byte("apps/foo/values.yaml\n\n  docs/README.md  \n")),
+	)
+}
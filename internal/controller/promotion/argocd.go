@@ -3,9 +3,8 @@ package promotion
 import (
 	"context"
 	"fmt"
-	"strings"
+	"strconv"
 
-	"github.com/gobwas/glob"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -15,7 +14,16 @@ import (
 	"github.com/akuity/kargo/internal/logging"
 )
 
-const authorizedStageAnnotationKey = "kargo.akuity.io/authorized-stage"
+const (
+	// promotionInfoKey is the key of the info entry that identifies the
+	// Promotion that initiated a sync operation on an Argo CD Application.
+	promotionInfoKey = "Kargo-Promotion"
+	// promotionAttemptInfoKey is the key of the info entry that identifies the
+	// attempt at executing a Promotion that initiated a sync operation on an
+	// Argo CD Application. An operation without such an entry was initiated by
+	// the Promotion's first attempt.
+	promotionAttemptInfoKey = "Kargo-Promotion-Attempt"
+)
 
 // argoCDMechanism is an implementation of the Mechanism interface that updates
 // Argo CD Application resources.
@@ -24,9 +32,10 @@ type argoCDMechanism struct {
 	doSingleUpdateFn func(
 		ctx context.Context,
 		stageMeta metav1.ObjectMeta,
+		promo *kargoapi.Promotion,
 		update kargoapi.ArgoCDAppUpdate,
 		newFreight kargoapi.SimpleFreight,
-	) error
+	) (*kargoapi.PromotionStatus, error)
 	getArgoCDAppFn func(
 		ctx context.Context,
 		namespace string,
@@ -67,43 +76,84 @@ func (*argoCDMechanism) GetName() string {
 func (a *argoCDMechanism) Promote(
 	ctx context.Context,
 	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
 	newFreight kargoapi.SimpleFreight,
-) (kargoapi.SimpleFreight, error) {
+) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
 	updates := stage.Spec.PromotionMechanisms.ArgoCDAppUpdates
 
 	if len(updates) == 0 {
-		return newFreight, nil
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded),
+			newFreight, nil
 	}
 
+	promo = promo.DeepCopy()
+
 	logger := logging.LoggerFromContext(ctx)
 	logger.Debug("executing Argo CD-based promotion mechanisms")
 
+	phase := kargoapi.PromotionPhaseSucceeded
 	for _, update := range updates {
-		if err := a.doSingleUpdateFn(
+		target := fmt.Sprintf(
+			"%s/%s",
+			update.AppNamespaceOrDefault(),
+			update.AppName,
+		)
+		status, err := a.doSingleUpdateFn(
 			ctx,
 			stage.ObjectMeta,
+			promo,
 			update,
 			newFreight,
-		); err != nil {
-			return newFreight, err
+		)
+		if err != nil {
+			if status == nil {
+				status = promo.Status.DeepCopy()
+			}
+			recordStep(
+				status,
+				a.GetName(),
+				target,
+				kargoapi.PromotionPhaseErrored,
+				"",
+				err,
+			)
+			return status, newFreight, err
+		}
+		recordStep(status, a.GetName(), target, status.Phase, "", nil)
+		promo.Status = *status
+		// Syncs of different Applications are independent of one another, so
+		// a sync that has not completed yet does not prevent us from moving on
+		// to the next Application.
+		if status.Phase != kargoapi.PromotionPhaseSucceeded {
+			phase = status.Phase
 		}
 	}
 
 	logger.Debug("done executing Argo CD-based promotion mechanisms")
 
-	return newFreight, nil
+	return promo.Status.WithPhase(phase), newFreight, nil
 }
 
+// doSingleUpdate updates a single Argo CD Application and initiates a sync
+// operation on it. The first time it is invoked for a given Promotion, it
+// updates the Application. On subsequent invocations, it only checks on the
+// state of the sync operation it initiated previously. If the update calls for
+// waiting on the sync operation, the returned PromotionStatus has a phase of
+// Running until the operation has completed.
 func (a *argoCDMechanism) doSingleUpdate(
 	ctx context.Context,
 	stageMeta metav1.ObjectMeta,
+	promo *kargoapi.Promotion,
 	update kargoapi.ArgoCDAppUpdate,
 	newFreight kargoapi.SimpleFreight,
-) error {
+) (*kargoapi.PromotionStatus, error) {
+	logger := logging.LoggerFromContext(ctx).WithField("app", update.AppName)
+	status := promo.Status.DeepCopy()
+
 	app, err :=
 		a.getArgoCDAppFn(ctx, update.AppNamespaceOrDefault(), update.AppName)
 	if err != nil {
-		return errors.Wrapf(
+		return nil, errors.Wrapf(
 			err,
 			"error finding Argo CD Application %q in namespace %q",
 			update.AppName,
@@ -111,7 +161,7 @@ func (a *argoCDMechanism) doSingleUpdate(
 		)
 	}
 	if app == nil {
-		return errors.Errorf(
+		return nil, errors.Errorf(
 			"unable to find Argo CD Application %q in namespace %q",
 			update.AppName,
 			update.AppNamespaceOrDefault(),
@@ -119,9 +169,79 @@ func (a *argoCDMechanism) doSingleUpdate(
 	}
 	// Make sure this is allowed!
 	if err = authorizeArgoCDAppUpdate(stageMeta, app.ObjectMeta); err != nil {
-		return err
+		return nil, err
+	}
+
+	syncIndex := -1
+	for i, sync := range status.ArgoCDAppSyncs {
+		if sync.Namespace == app.Namespace && sync.Name == app.Name {
+			syncIndex = i
+			break
+		}
 	}
-	patch := client.MergeFrom(app.DeepCopy())
+	if syncIndex < 0 {
+		if err = a.updateAndSync(ctx, app, promo, update, newFreight); err != nil {
+			return nil, err
+		}
+		if previewFromContext(ctx) != nil {
+			return status.WithPhase(kargoapi.PromotionPhaseSucceeded), nil
+		}
+		status.ArgoCDAppSyncs = append(
+			status.ArgoCDAppSyncs,
+			kargoapi.ArgoCDAppSyncInfo{
+				Namespace: app.Namespace,
+				Name:      app.Name,
+			},
+		)
+		syncIndex = len(status.ArgoCDAppSyncs) - 1
+	}
+
+	if !update.WaitForSync {
+		return status.WithPhase(kargoapi.PromotionPhaseSucceeded), nil
+	}
+
+	syncInfo := &status.ArgoCDAppSyncs[syncIndex]
+	opState :=
+		getSyncOperationState(app, promo.Name, promo.Status.CurrentAttempt())
+	if opState == nil {
+		logger.Debug("waiting for sync operation to start")
+		return status.WithPhase(kargoapi.PromotionPhaseRunning), nil
+	}
+	syncInfo.Phase = string(opState.Phase)
+	syncInfo.Message = opState.Message
+	switch {
+	case opState.Phase.Successful():
+		logger.Debug("sync operation succeeded")
+		return status.WithPhase(kargoapi.PromotionPhaseSucceeded), nil
+	case opState.Phase.Failed():
+		return status, errors.Errorf(
+			"sync operation on Argo CD Application %q in namespace %q ended "+
+				"with phase %s: %s",
+			app.Name,
+			app.Namespace,
+			opState.Phase,
+			opState.Message,
+		)
+	default:
+		logger.Debug("waiting for sync operation to complete")
+		return status.WithPhase(kargoapi.PromotionPhaseRunning), nil
+	}
+}
+
+// updateAndSync applies the specified update to the provided Argo CD
+// Application and patches it with an operation that requests a sync. If the
+// provided context carries a Preview, the changes that would have been made to
+// the Application's spec are recorded in it instead.
+func (a *argoCDMechanism) updateAndSync(
+	ctx context.Context,
+	app *argocd.Application,
+	promo *kargoapi.Promotion,
+	update kargoapi.ArgoCDAppUpdate,
+	newFreight kargoapi.SimpleFreight,
+) error {
+	var err error
+	originalApp := app.DeepCopy()
+	patch := client.MergeFrom(originalApp)
 	for _, srcUpdate := range update.SourceUpdates {
 		if app.Spec.Source != nil {
 			var source argocd.ApplicationSource
@@ -155,6 +275,31 @@ func (a *argoCDMechanism) doSingleUpdate(
 			app.Spec.Sources[i] = source
 		}
 	}
+	if preview := previewFromContext(ctx); preview != nil {
+		var diff string
+		if diff, err = yamlDiff(
+			fmt.Sprintf("%s/%s (current)", app.Namespace, app.Name),
+			fmt.Sprintf("%s/%s (promoted)", app.Namespace, app.Name),
+			originalApp.Spec,
+			app.Spec,
+		); err != nil {
+			return errors.Wrapf(
+				err,
+				"error diffing spec of Argo CD Application %q in namespace %q",
+				update.AppName,
+				update.AppNamespaceOrDefault(),
+			)
+		}
+		preview.ArgoCDAppDiffs = append(
+			preview.ArgoCDAppDiffs,
+			ArgoCDAppDiff{
+				Namespace: app.Namespace,
+				Name:      app.Name,
+				Diff:      diff,
+			},
+		)
+		return nil
+	}
 	app.ObjectMeta.Annotations[argocd.AnnotationKeyRefresh] =
 		string(argocd.RefreshTypeHard)
 	app.Operation = &argocd.Operation{
@@ -167,6 +312,14 @@ func (a *argoCDMechanism) doSingleUpdate(
 				Name:  "Reason",
 				Value: "Promotion triggered a sync of this Application resource.",
 			},
+			{
+				Name:  promotionInfoKey,
+				Value: promo.Name,
+			},
+			{
+				Name:  promotionAttemptInfoKey,
+				Value: strconv.Itoa(promo.Status.CurrentAttempt()),
+			},
 		},
 		Sync: &argocd.SyncOperation{
 			Revisions: []string{},
@@ -200,6 +353,41 @@ func (a *argoCDMechanism) doSingleUpdate(
 	return nil
 }
 
+// getSyncOperationState returns the state of the sync operation that was
+// initiated on the provided Argo CD Application by the specified attempt at
+// executing the specified Promotion. If that operation has not started yet, or
+// if the Application's most recent operation was initiated by something else
+// (including an earlier attempt at executing the same Promotion), nil is
+// returned.
+func getSyncOperationState(
+	app *argocd.Application,
+	promoName string,
+	attempt int,
+) *argocd.OperationState {
+	// Argo CD clears the Application's operation field once it has begun
+	// executing the operation.
+	if app.Operation != nil || app.Status.OperationState == nil {
+		return nil
+	}
+	var promoMatches bool
+	opAttempt := "1"
+	for _, info := range app.Status.OperationState.Operation.Info {
+		if info == nil {
+			continue
+		}
+		switch info.Name {
+		case promotionInfoKey:
+			promoMatches = info.Value == promoName
+		case promotionAttemptInfoKey:
+			opAttempt = info.Value
+		}
+	}
+	if !promoMatches || opAttempt != strconv.Itoa(attempt) {
+		return nil
+	}
+	return app.Status.OperationState
+}
+
 func getApplicationFn(
 	argoClient client.Client,
 ) func(
@@ -223,55 +411,7 @@ func authorizeArgoCDAppUpdate(
 	stageMeta metav1.ObjectMeta,
 	appMeta metav1.ObjectMeta,
 ) error {
-	permErr := errors.Errorf(
-		"Argo CD Application %q in namespace %q does not permit mutation by "+
-			"Kargo Stage %s in namespace %s",
-		appMeta.Name,
-		appMeta.Namespace,
-		stageMeta.Name,
-		stageMeta.Namespace,
-	)
-	if appMeta.Annotations == nil {
-		return permErr
-	}
-	allowedStage, ok := appMeta.Annotations[authorizedStageAnnotationKey]
-	if !ok {
-		return permErr
-	}
-	tokens := strings.SplitN(allowedStage, ":", 2)
-	if len(tokens) != 2 {
-		return errors.Errorf(
-			"unable to parse value of annotation %q (%q) on Argo CD Application "+
-				"%q in namespace %q",
-			authorizedStageAnnotationKey,
-			allowedStage,
-			appMeta.Name,
-			appMeta.Namespace,
-		)
-	}
-	allowedNamespaceGlob, err := glob.Compile(tokens[0])
-	if err != nil {
-		return errors.Errorf(
-			"Argo CD Application %q in namespace %q has invalid glob expression: %q",
-			appMeta.Name,
-			appMeta.Namespace,
-			tokens[0],
-		)
-	}
-	allowedNameGlob, err := glob.Compile(tokens[1])
-	if err != nil {
-		return errors.Errorf(
-			"Argo CD Application %q in namespace %q has invalid glob expression: %q",
-			appMeta.Name,
-			appMeta.Namespace,
-			tokens[1],
-		)
-	}
-	if !allowedNamespaceGlob.Match(stageMeta.Namespace) ||
-		!allowedNameGlob.Match(stageMeta.Name) {
-		return permErr
-	}
-	return nil
+	return authorizeUpdate("Argo CD Application", stageMeta, appMeta)
 }
 
 // applyArgoCDSourceUpdate updates a single Argo CD ApplicationSource.
@@ -310,6 +450,7 @@ func applyArgoCDSourceUpdate(
 		source.Kustomize.Images = buildKustomizeImagesForArgoCDAppSource(
 			newFreight.Images,
 			update.Kustomize.Images,
+			update.Kustomize.UseDigest,
 		)
 	}
 
@@ -346,24 +487,28 @@ func applyArgoCDSourceUpdate(
 func buildKustomizeImagesForArgoCDAppSource(
 	images []kargoapi.Image,
 	imageUpdates []string,
+	useDigest bool,
 ) argocd.KustomizeImages {
-	tagsByImage := map[string]string{}
+	imagesByRepo := make(map[string]kargoapi.Image, len(images))
 	for _, image := range images {
-		tagsByImage[image.RepoURL] = image.Tag
+		imagesByRepo[image.RepoURL] = image
 	}
 	kustomizeImages := make(argocd.KustomizeImages, 0, len(imageUpdates))
 	for _, imageUpdate := range imageUpdates {
-		tag, found := tagsByImage[imageUpdate]
+		image, found := imagesByRepo[imageUpdate]
 		if !found {
 			// There's no change to make in this case.
 			continue
 		}
-		kustomizeImages = append(
-			kustomizeImages,
-			argocd.KustomizeImage(
-				fmt.Sprintf("%s=%s:%s", imageUpdate, imageUpdate, tag),
-			),
-		)
+		newImage := fmt.Sprintf("%s=%s:%s", imageUpdate, imageUpdate, image.Tag)
+		if useDigest {
+			if image.Digest == "" {
+				// There's no change to make in this case.
+				continue
+			}
+			newImage = fmt.Sprintf("%s=%s@%s", imageUpdate, imageUpdate, image.Digest)
+		}
+		kustomizeImages = append(kustomizeImages, argocd.KustomizeImage(newImage))
 	}
 	return kustomizeImages
 }
@@ -372,26 +517,19 @@ func buildHelmParamChangesForArgoCDAppSource(
 	images []kargoapi.Image,
 	imageUpdates []kargoapi.ArgoCDHelmImageUpdate,
 ) map[string]string {
-	tagsByImage := map[string]string{}
+	imagesByRepo := make(map[string]kargoapi.Image, len(images))
 	for _, image := range images {
-		tagsByImage[image.RepoURL] = image.Tag
+		imagesByRepo[image.RepoURL] = image
 	}
 	changes := map[string]string{}
 	for _, imageUpdate := range imageUpdates {
-		if imageUpdate.Value != kargoapi.ImageUpdateValueTypeImage &&
-			imageUpdate.Value != kargoapi.ImageUpdateValueTypeTag {
-			// This really shouldn't happen, so we'll ignore it.
-			continue
-		}
-		tag, found := tagsByImage[imageUpdate.Image]
+		image, found := imagesByRepo[imageUpdate.Image]
 		if !found {
 			// There's no change to make in this case.
 			continue
 		}
-		if imageUpdate.Value == kargoapi.ImageUpdateValueTypeImage {
-			changes[imageUpdate.Key] = fmt.Sprintf("%s:%s", imageUpdate.Image, tag)
-		} else {
-			changes[imageUpdate.Key] = tag
+		if value := image.GetValue(imageUpdate.Value); value != "" {
+			changes[imageUpdate.Key] = value
 		}
 	}
 	return changes
@@ -37,6 +37,7 @@ func TestArgoCDPromote(t *testing.T) {
 		stage      *kargoapi.Stage
 		newFreight kargoapi.SimpleFreight
 		assertions func(
+			status *kargoapi.PromotionStatus,
 			newFreightIn kargoapi.SimpleFreight,
 			newFreightOut kargoapi.SimpleFreight,
 			err error,
@@ -51,6 +52,7 @@ func TestArgoCDPromote(t *testing.T) {
 				},
 			},
 			assertions: func(
+				_ *kargoapi.PromotionStatus,
 				newFreightIn kargoapi.SimpleFreight,
 				newFreightOut kargoapi.SimpleFreight,
 				err error,
@@ -65,10 +67,11 @@ func TestArgoCDPromote(t *testing.T) {
 				doSingleUpdateFn: func(
 					context.Context,
 					metav1.ObjectMeta,
+					*kargoapi.Promotion,
 					kargoapi.ArgoCDAppUpdate,
 					kargoapi.SimpleFreight,
-				) error {
-					return errors.New("something went wrong")
+				) (*kargoapi.PromotionStatus, error) {
+					return nil, errors.New("something went wrong")
 				},
 			},
 			stage: &kargoapi.Stage{
@@ -81,6 +84,7 @@ func TestArgoCDPromote(t *testing.T) {
 				},
 			},
 			assertions: func(
+				_ *kargoapi.PromotionStatus,
 				newFreightIn kargoapi.SimpleFreight,
 				newFreightOut kargoapi.SimpleFreight,
 				err error,
@@ -94,16 +98,56 @@ func TestArgoCDPromote(t *testing.T) {
 				require.Equal(t, newFreightIn, newFreightOut)
 			},
 		},
+		{
+			name: "sync not complete",
+			promoMech: &argoCDMechanism{
+				doSingleUpdateFn: func(
+					_ context.Context,
+					_ metav1.ObjectMeta,
+					promo *kargoapi.Promotion,
+					update kargoapi.ArgoCDAppUpdate,
+					_ kargoapi.SimpleFreight,
+				) (*kargoapi.PromotionStatus, error) {
+					if update.WaitForSync {
+						return promo.Status.WithPhase(kargoapi.PromotionPhaseRunning), nil
+					}
+					return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{
+								WaitForSync: true,
+							},
+							{},
+						},
+					},
+				},
+			},
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				newFreightIn kargoapi.SimpleFreight,
+				newFreightOut kargoapi.SimpleFreight,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Equal(t, newFreightIn, newFreightOut)
+			},
+		},
 		{
 			name: "success",
 			promoMech: &argoCDMechanism{
 				doSingleUpdateFn: func(
-					context.Context,
-					metav1.ObjectMeta,
-					kargoapi.ArgoCDAppUpdate,
-					kargoapi.SimpleFreight,
-				) error {
-					return nil
+					_ context.Context,
+					_ metav1.ObjectMeta,
+					promo *kargoapi.Promotion,
+					_ kargoapi.ArgoCDAppUpdate,
+					_ kargoapi.SimpleFreight,
+				) (*kargoapi.PromotionStatus, error) {
+					return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), nil
 				},
 			},
 			stage: &kargoapi.Stage{
@@ -116,23 +160,26 @@ func TestArgoCDPromote(t *testing.T) {
 				},
 			},
 			assertions: func(
+				status *kargoapi.PromotionStatus,
 				newFreightIn kargoapi.SimpleFreight,
 				newFreightOut kargoapi.SimpleFreight,
 				err error,
 			) {
 				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
 				require.Equal(t, newFreightIn, newFreightOut)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			newFreightOut, err := testCase.promoMech.Promote(
+			status, newFreightOut, err := testCase.promoMech.Promote(
 				context.Background(),
 				testCase.stage,
+				&kargoapi.Promotion{},
 				testCase.newFreight,
 			)
-			testCase.assertions(testCase.newFreight, newFreightOut, err)
+			testCase.assertions(status, testCase.newFreight, newFreightOut, err)
 		})
 	}
 }
@@ -142,8 +189,9 @@ func TestArgoCDDoSingleUpdate(t *testing.T) {
 		name       string
 		promoMech  *argoCDMechanism
 		stageMeta  metav1.ObjectMeta
+		promo      *kargoapi.Promotion
 		update     kargoapi.ArgoCDAppUpdate
-		assertions func(err error)
+		assertions func(status *kargoapi.PromotionStatus, err error)
 	}{
 		{
 			name: "error getting Argo CD App",
@@ -156,7 +204,7 @@ func TestArgoCDDoSingleUpdate(t *testing.T) {
 					return nil, errors.New("something went wrong")
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(_ *kargoapi.PromotionStatus, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "error finding Argo CD Application")
 				require.Contains(t, err.Error(), "something went wrong")
@@ -173,7 +221,7 @@ func TestArgoCDDoSingleUpdate(t *testing.T) {
 					return nil, nil
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(_ *kargoapi.PromotionStatus, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "unable to find Argo CD Application")
 			},
@@ -199,7 +247,7 @@ func TestArgoCDDoSingleUpdate(t *testing.T) {
 				Name:      "fake-name",
 				Namespace: "fake-namespace",
 			},
-			assertions: func(err error) {
+			assertions: func(_ *kargoapi.PromotionStatus, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "does not permit mutation by")
 			},
@@ -242,7 +290,7 @@ func TestArgoCDDoSingleUpdate(t *testing.T) {
 					{},
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(_ *kargoapi.PromotionStatus, err error) {
 				require.Error(t, err)
 				require.Contains(
 					t,
@@ -292,7 +340,7 @@ func TestArgoCDDoSingleUpdate(t *testing.T) {
 					{},
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(_ *kargoapi.PromotionStatus, err error) {
 				require.Error(t, err)
 				require.Contains(
 					t,
@@ -333,7 +381,7 @@ func TestArgoCDDoSingleUpdate(t *testing.T) {
 				Name:      "fake-name",
 				Namespace: "fake-namespace",
 			},
-			assertions: func(err error) {
+			assertions: func(_ *kargoapi.PromotionStatus, err error) {
 				require.Error(t, err)
 				require.Contains(
 					t,
@@ -345,6 +393,122 @@ func TestArgoCDDoSingleUpdate(t *testing.T) {
 		},
 		{
 			name: "success",
+			promoMech: &argoCDMechanism{
+				getArgoCDAppFn: func(
+					context.Context,
+					string,
+					string,
+				) (*argocd.Application, error) {
+					return &argocd.Application{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-name",
+							Namespace: "fake-namespace",
+							Annotations: map[string]string{
+								authorizedStageAnnotationKey: "fake-namespace:fake-name",
+							},
+						},
+					}, nil
+				},
+				argoCDAppPatchFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ client.Patch,
+					_ ...client.PatchOption,
+				) error {
+					app, ok := obj.(*argocd.Application)
+					require.True(t, ok)
+					require.NotNil(t, app.Operation)
+					require.Contains(
+						t,
+						app.Operation.Info,
+						&argocd.Info{
+							Name:  promotionInfoKey,
+							Value: "fake-promotion",
+						},
+					)
+					require.Contains(
+						t,
+						app.Operation.Info,
+						&argocd.Info{
+							Name:  promotionAttemptInfoKey,
+							Value: "1",
+						},
+					)
+					return nil
+				},
+			},
+			stageMeta: metav1.ObjectMeta{
+				Name:      "fake-name",
+				Namespace: "fake-namespace",
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Equal(
+					t,
+					[]kargoapi.ArgoCDAppSyncInfo{
+						{
+							Namespace: "fake-namespace",
+							Name:      "fake-name",
+						},
+					},
+					status.ArgoCDAppSyncs,
+				)
+			},
+		},
+		{
+			name: "sync already initiated; not waiting",
+			promoMech: &argoCDMechanism{
+				getArgoCDAppFn: func(
+					context.Context,
+					string,
+					string,
+				) (*argocd.Application, error) {
+					return &argocd.Application{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-name",
+							Namespace: "fake-namespace",
+							Annotations: map[string]string{
+								authorizedStageAnnotationKey: "fake-namespace:fake-name",
+							},
+						},
+					}, nil
+				},
+				argoCDAppPatchFn: func(
+					context.Context,
+					client.Object,
+					client.Patch,
+					...client.PatchOption,
+				) error {
+					require.Fail(t, "Application should not be patched again")
+					return nil
+				},
+			},
+			stageMeta: metav1.ObjectMeta{
+				Name:      "fake-name",
+				Namespace: "fake-namespace",
+			},
+			promo: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-promotion",
+				},
+				Status: kargoapi.PromotionStatus{
+					ArgoCDAppSyncs: []kargoapi.ArgoCDAppSyncInfo{
+						{
+							Namespace: "fake-namespace",
+							Name:      "fake-name",
+						},
+					},
+				},
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Len(t, status.ArgoCDAppSyncs, 1)
+			},
+		},
+		{
+			name: "waiting for sync operation to start",
 			promoMech: &argoCDMechanism{
 				getArgoCDAppFn: func(
 					context.Context,
@@ -374,17 +538,276 @@ func TestArgoCDDoSingleUpdate(t *testing.T) {
 				Name:      "fake-name",
 				Namespace: "fake-namespace",
 			},
-			assertions: func(err error) {
+			update: kargoapi.ArgoCDAppUpdate{
+				WaitForSync: true,
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
 				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Len(t, status.ArgoCDAppSyncs, 1)
+				require.Empty(t, status.ArgoCDAppSyncs[0].Phase)
+			},
+		},
+		{
+			name: "sync operation still running",
+			promoMech: &argoCDMechanism{
+				getArgoCDAppFn: func(
+					context.Context,
+					string,
+					string,
+				) (*argocd.Application, error) {
+					return &argocd.Application{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-name",
+							Namespace: "fake-namespace",
+							Annotations: map[string]string{
+								authorizedStageAnnotationKey: "fake-namespace:fake-name",
+							},
+						},
+						Status: argocd.ApplicationStatus{
+							OperationState: &argocd.OperationState{
+								Operation: argocd.Operation{
+									Info: []*argocd.Info{
+										{
+											Name:  promotionInfoKey,
+											Value: "fake-promotion",
+										},
+									},
+								},
+								Phase:   argocd.OperationRunning,
+								Message: "fake-message",
+							},
+						},
+					}, nil
+				},
+			},
+			stageMeta: metav1.ObjectMeta{
+				Name:      "fake-name",
+				Namespace: "fake-namespace",
+			},
+			promo: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-promotion",
+				},
+				Status: kargoapi.PromotionStatus{
+					ArgoCDAppSyncs: []kargoapi.ArgoCDAppSyncInfo{
+						{
+							Namespace: "fake-namespace",
+							Name:      "fake-name",
+						},
+					},
+				},
+			},
+			update: kargoapi.ArgoCDAppUpdate{
+				WaitForSync: true,
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Equal(
+					t,
+					kargoapi.ArgoCDAppSyncInfo{
+						Namespace: "fake-namespace",
+						Name:      "fake-name",
+						Phase:     string(argocd.OperationRunning),
+						Message:   "fake-message",
+					},
+					status.ArgoCDAppSyncs[0],
+				)
+			},
+		},
+		{
+			name: "sync operation succeeded",
+			promoMech: &argoCDMechanism{
+				getArgoCDAppFn: func(
+					context.Context,
+					string,
+					string,
+				) (*argocd.Application, error) {
+					return &argocd.Application{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-name",
+							Namespace: "fake-namespace",
+							Annotations: map[string]string{
+								authorizedStageAnnotationKey: "fake-namespace:fake-name",
+							},
+						},
+						Status: argocd.ApplicationStatus{
+							OperationState: &argocd.OperationState{
+								Operation: argocd.Operation{
+									Info: []*argocd.Info{
+										{
+											Name:  promotionInfoKey,
+											Value: "fake-promotion",
+										},
+									},
+								},
+								Phase: argocd.OperationSucceeded,
+							},
+						},
+					}, nil
+				},
+			},
+			stageMeta: metav1.ObjectMeta{
+				Name:      "fake-name",
+				Namespace: "fake-namespace",
+			},
+			promo: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-promotion",
+				},
+				Status: kargoapi.PromotionStatus{
+					ArgoCDAppSyncs: []kargoapi.ArgoCDAppSyncInfo{
+						{
+							Namespace: "fake-namespace",
+							Name:      "fake-name",
+						},
+					},
+				},
+			},
+			update: kargoapi.ArgoCDAppUpdate{
+				WaitForSync: true,
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Equal(
+					t,
+					string(argocd.OperationSucceeded),
+					status.ArgoCDAppSyncs[0].Phase,
+				)
+			},
+		},
+		{
+			name: "most recent operation initiated by something else",
+			promoMech: &argoCDMechanism{
+				getArgoCDAppFn: func(
+					context.Context,
+					string,
+					string,
+				) (*argocd.Application, error) {
+					return &argocd.Application{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-name",
+							Namespace: "fake-namespace",
+							Annotations: map[string]string{
+								authorizedStageAnnotationKey: "fake-namespace:fake-name",
+							},
+						},
+						Status: argocd.ApplicationStatus{
+							OperationState: &argocd.OperationState{
+								Phase: argocd.OperationSucceeded,
+							},
+						},
+					}, nil
+				},
+			},
+			stageMeta: metav1.ObjectMeta{
+				Name:      "fake-name",
+				Namespace: "fake-namespace",
+			},
+			promo: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-promotion",
+				},
+				Status: kargoapi.PromotionStatus{
+					ArgoCDAppSyncs: []kargoapi.ArgoCDAppSyncInfo{
+						{
+							Namespace: "fake-namespace",
+							Name:      "fake-name",
+						},
+					},
+				},
+			},
+			update: kargoapi.ArgoCDAppUpdate{
+				WaitForSync: true,
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+			},
+		},
+		{
+			name: "sync operation failed",
+			promoMech: &argoCDMechanism{
+				getArgoCDAppFn: func(
+					context.Context,
+					string,
+					string,
+				) (*argocd.Application, error) {
+					return &argocd.Application{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-name",
+							Namespace: "fake-namespace",
+							Annotations: map[string]string{
+								authorizedStageAnnotationKey: "fake-namespace:fake-name",
+							},
+						},
+						Status: argocd.ApplicationStatus{
+							OperationState: &argocd.OperationState{
+								Operation: argocd.Operation{
+									Info: []*argocd.Info{
+										{
+											Name:  promotionInfoKey,
+											Value: "fake-promotion",
+										},
+									},
+								},
+								Phase:   argocd.OperationError,
+								Message: "one or more objects failed to apply",
+							},
+						},
+					}, nil
+				},
+			},
+			stageMeta: metav1.ObjectMeta{
+				Name:      "fake-name",
+				Namespace: "fake-namespace",
+			},
+			promo: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-promotion",
+				},
+				Status: kargoapi.PromotionStatus{
+					ArgoCDAppSyncs: []kargoapi.ArgoCDAppSyncInfo{
+						{
+							Namespace: "fake-namespace",
+							Name:      "fake-name",
+						},
+					},
+				},
+			},
+			update: kargoapi.ArgoCDAppUpdate{
+				WaitForSync: true,
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "ended with phase Error")
+				require.Contains(t, err.Error(), "one or more objects failed to apply")
+				require.NotNil(t, status)
+				require.Equal(
+					t,
+					string(argocd.OperationError),
+					status.ArgoCDAppSyncs[0].Phase,
+				)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
+			promo := testCase.promo
+			if promo == nil {
+				promo = &kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "fake-promotion",
+					},
+				}
+			}
 			testCase.assertions(
 				testCase.promoMech.doSingleUpdate(
 					context.Background(),
 					testCase.stageMeta,
+					promo,
 					testCase.update,
 					kargoapi.SimpleFreight{},
 				),
@@ -393,6 +816,76 @@ func TestArgoCDDoSingleUpdate(t *testing.T) {
 	}
 }
 
+func TestGetSyncOperationState(t *testing.T) {
+	appWithInfo := func(info ...*argocd.Info) *argocd.Application {
+		return &argocd.Application{
+			Status: argocd.ApplicationStatus{
+				OperationState: &argocd.OperationState{
+					Operation: argocd.Operation{Info: info},
+					Phase:     argocd.OperationFailed,
+				},
+			},
+		}
+	}
+	testCases := []struct {
+		name     string
+		app      *argocd.Application
+		attempt  int
+		expected bool
+	}{
+		{
+			name: "operation has not started",
+			app: &argocd.Application{
+				Operation: &argocd.Operation{},
+			},
+			attempt: 1,
+		},
+		{
+			name: "operation initiated by another Promotion",
+			app: appWithInfo(
+				&argocd.Info{Name: promotionInfoKey, Value: "other-promotion"},
+			),
+			attempt: 1,
+		},
+		{
+			name: "operation without attempt initiated by first attempt",
+			app: appWithInfo(
+				&argocd.Info{Name: promotionInfoKey, Value: "fake-promotion"},
+			),
+			attempt:  1,
+			expected: true,
+		},
+		{
+			name: "operation initiated by earlier attempt",
+			app: appWithInfo(
+				&argocd.Info{Name: promotionInfoKey, Value: "fake-promotion"},
+				&argocd.Info{Name: promotionAttemptInfoKey, Value: "1"},
+			),
+			attempt: 2,
+		},
+		{
+			name: "operation initiated by current attempt",
+			app: appWithInfo(
+				&argocd.Info{Name: promotionInfoKey, Value: "fake-promotion"},
+				&argocd.Info{Name: promotionAttemptInfoKey, Value: "2"},
+			),
+			attempt:  2,
+			expected: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			opState :=
+				getSyncOperationState(testCase.app, "fake-promotion", testCase.attempt)
+			if testCase.expected {
+				require.NotNil(t, opState)
+				return
+			}
+			require.Nil(t, opState)
+		})
+	}
+}
+
 func TestAuthorizeArgoCDAppUpdate(t *testing.T) {
 	permErr := "does not permit mutation"
 	parseErr := "unable to parse"
@@ -727,6 +1220,7 @@ func TestBuildKustomizeImagesForArgoCDAppSource(t *testing.T) {
 		{
 			RepoURL: "fake-url",
 			Tag:     "fake-tag",
+			Digest:  "fake-digest",
 		},
 		{
 			RepoURL: "another-fake-url",
@@ -738,7 +1232,7 @@ func TestBuildKustomizeImagesForArgoCDAppSource(t *testing.T) {
 		"another-fake-url",
 		"image-that-is-not-in-list",
 	}
-	result := buildKustomizeImagesForArgoCDAppSource(images, imageUpdates)
+	result := buildKustomizeImagesForArgoCDAppSource(images, imageUpdates, false)
 	require.Equal(
 		t,
 		argocd.KustomizeImages{
@@ -747,6 +1241,14 @@ func TestBuildKustomizeImagesForArgoCDAppSource(t *testing.T) {
 		},
 		result,
 	)
+	result = buildKustomizeImagesForArgoCDAppSource(images, imageUpdates, true)
+	require.Equal(
+		t,
+		argocd.KustomizeImages{
+			"fake-url=fake-url@fake-digest",
+		},
+		result,
+	)
 }
 
 func TestBuildHelmParamChangesForArgoCDAppSource(t *testing.T) {
@@ -754,6 +1256,7 @@ func TestBuildHelmParamChangesForArgoCDAppSource(t *testing.T) {
 		{
 			RepoURL: "fake-url",
 			Tag:     "fake-tag",
+			Digest:  "fake-digest",
 		},
 		{
 			RepoURL: "another-fake-url",
@@ -771,6 +1274,21 @@ func TestBuildHelmParamChangesForArgoCDAppSource(t *testing.T) {
 			Key:   "another-fake-key",
 			Value: "Tag",
 		},
+		{
+			Image: "fake-url",
+			Key:   "digest-key",
+			Value: "Digest",
+		},
+		{
+			Image: "fake-url",
+			Key:   "image-and-digest-key",
+			Value: "ImageAndDigest",
+		},
+		{
+			Image: "another-fake-url",
+			Key:   "missing-digest-key",
+			Value: "Digest",
+		},
 		{
 			Image: "image-that-is-not-in-list",
 			Key:   "fake-key",
@@ -781,9 +1299,83 @@ func TestBuildHelmParamChangesForArgoCDAppSource(t *testing.T) {
 	require.Equal(
 		t,
 		map[string]string{
-			"fake-key":         "fake-url:fake-tag",
-			"another-fake-key": "another-fake-tag",
+			"fake-key":             "fake-url:fake-tag",
+			"another-fake-key":     "another-fake-tag",
+			"digest-key":           "fake-digest",
+			"image-and-digest-key": "fake-url@fake-digest",
 		},
 		result,
 	)
 }
+
+func TestArgoCDDoSingleUpdatePreview(t *testing.T) {
+	promoMech := &argoCDMechanism{
+		getArgoCDAppFn: func(
+			context.Context,
+			string,
+			string,
+		) (*argocd.Application, error) {
+			return &argocd.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-name",
+					Namespace: "fake-namespace",
+					Annotations: map[string]string{
+						authorizedStageAnnotationKey: "fake-namespace:fake-name",
+					},
+				},
+				Spec: argocd.ApplicationSpec{
+					Source: &argocd.ApplicationSource{
+						RepoURL:        "fake-url",
+						TargetRevision: "fake-revision",
+					},
+				},
+			}, nil
+		},
+		applyArgoCDSourceUpdateFn: applyArgoCDSourceUpdate,
+		argoCDAppPatchFn: func(
+			context.Context,
+			client.Object,
+			client.Patch,
+			...client.PatchOption,
+		) error {
+			require.Fail(t, "Application should not be patched when previewing")
+			return nil
+		},
+	}
+	preview := &Preview{}
+	status, err := promoMech.doSingleUpdate(
+		ContextWithPreview(context.Background(), preview),
+		metav1.ObjectMeta{
+			Name:      "fake-name",
+			Namespace: "fake-namespace",
+		},
+		&kargoapi.Promotion{},
+		kargoapi.ArgoCDAppUpdate{
+			AppName:      "fake-name",
+			AppNamespace: "fake-namespace",
+			SourceUpdates: []kargoapi.ArgoCDSourceUpdate{
+				{
+					RepoURL:              "fake-url",
+					UpdateTargetRevision: true,
+				},
+			},
+		},
+		kargoapi.SimpleFreight{
+			Commits: []kargoapi.GitCommit{
+				{
+					RepoURL: "fake-url",
+					ID:      "fake-commit-id",
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+	require.Empty(t, status.ArgoCDAppSyncs)
+	require.Len(t, preview.ArgoCDAppDiffs, 1)
+	appDiff := preview.ArgoCDAppDiffs[0]
+	require.Equal(t, "fake-namespace", appDiff.Namespace)
+	require.Equal(t, "fake-name", appDiff.Name)
+	require.Contains(t, appDiff.Diff, "-  targetRevision: fake-revision")
+	require.Contains(t, appDiff.Diff, "+  targetRevision: fake-commit-id")
+}
@@ -0,0 +1,76 @@
+package promotion
+
+import (
+	"strings"
+
+	"github.com/gobwas/glob"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const authorizedStageAnnotationKey = "kargo.akuity.io/authorized-stage"
+
+// authorizeUpdate returns an error if the resource represented by objMeta
+// does not explicitly permit mutation by the Kargo Stage represented by
+// stageMeta. The resource must be annotated with a value of the form
+// <namespace glob>:<name glob> that matches the Stage. The provided kind is a
+// human-readable description of the resource's kind that is used in error
+// messages.
+func authorizeUpdate(
+	kind string,
+	stageMeta metav1.ObjectMeta,
+	objMeta metav1.ObjectMeta,
+) error {
+	permErr := errors.Errorf(
+		"%s %q in namespace %q does not permit mutation by "+
+			"Kargo Stage %s in namespace %s",
+		kind,
+		objMeta.Name,
+		objMeta.Namespace,
+		stageMeta.Name,
+		stageMeta.Namespace,
+	)
+	if objMeta.Annotations == nil {
+		return permErr
+	}
+	allowedStage, ok := objMeta.Annotations[authorizedStageAnnotationKey]
+	if !ok {
+		return permErr
+	}
+	tokens := strings.SplitN(allowedStage, ":", 2)
+	if len(tokens) != 2 {
+		return errors.Errorf(
+			"unable to parse value of annotation %q (%q) on %s %q in namespace %q",
+			authorizedStageAnnotationKey,
+			allowedStage,
+			kind,
+			objMeta.Name,
+			objMeta.Namespace,
+		)
+	}
+	allowedNamespaceGlob, err := glob.Compile(tokens[0])
+	if err != nil {
+		return errors.Errorf(
+			"%s %q in namespace %q has invalid glob expression: %q",
+			kind,
+			objMeta.Name,
+			objMeta.Namespace,
+			tokens[0],
+		)
+	}
+	allowedNameGlob, err := glob.Compile(tokens[1])
+	if err != nil {
+		return errors.Errorf(
+			"%s %q in namespace %q has invalid glob expression: %q",
+			kind,
+			objMeta.Name,
+			objMeta.Namespace,
+			tokens[1],
+		)
+	}
+	if !allowedNamespaceGlob.Match(stageMeta.Namespace) ||
+		!allowedNameGlob.Match(stageMeta.Name) {
+		return permErr
+	}
+	return nil
+}
@@ -41,30 +41,62 @@ func (c *compositeMechanism) GetName() string {
 func (c *compositeMechanism) Promote(
 	ctx context.Context,
 	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
 	newFreight kargoapi.SimpleFreight,
-) (kargoapi.SimpleFreight, error) {
+) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
 	if stage.Spec.PromotionMechanisms == nil {
-		return newFreight, nil
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded),
+			newFreight, nil
 	}
 
 	newFreight = *newFreight.DeepCopy()
+	// Each child Mechanism sees the status as updated by its predecessors.
+	promo = promo.DeepCopy()
 
 	logger := logging.LoggerFromContext(ctx)
 	logger.Debugf("executing %s", c.name)
 
 	for _, childMechanism := range c.childMechanisms {
-		var err error
-		newFreight, err = childMechanism.Promote(ctx, stage, newFreight)
+		status, nextFreight, err :=
+			childMechanism.Promote(ctx, stage, promo, newFreight)
 		if err != nil {
-			return newFreight, errors.Wrapf(
+			if class := getErrorClass(childMechanism); class != "" {
+				err = &MechanismError{Class: class, Err: err}
+			}
+			if status == nil {
+				// Don't lose track of what preceding Mechanisms already did.
+				status = promo.Status.DeepCopy()
+				recordStep(
+					status,
+					childMechanism.GetName(),
+					"",
+					kargoapi.PromotionPhaseErrored,
+					"",
+					err,
+				)
+			}
+			return status, nextFreight, errors.Wrapf(
 				err,
 				"error executing %s",
 				childMechanism.GetName(),
 			)
 		}
+		newFreight = nextFreight
+		promo.Status = *status
+		if status.Phase != kargoapi.PromotionPhaseSucceeded {
+			// Subsequent Mechanisms may depend on the outcome of this one, so we
+			// cannot proceed until it has succeeded.
+			logger.Debugf(
+				"%s has not completed; phase is %s",
+				childMechanism.GetName(),
+				status.Phase,
+			)
+			return status, newFreight, nil
+		}
 	}
 
 	logger.Debug("done executing promotion mechanisms")
 
-	return newFreight, nil
+	return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded),
+		newFreight, nil
 }
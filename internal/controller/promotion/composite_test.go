@@ -43,6 +43,7 @@ func TestCompositePromote(t *testing.T) {
 		promoMech  *compositeMechanism
 		newFreight kargoapi.SimpleFreight
 		assertions func(
+			status *kargoapi.PromotionStatus,
 			newFreightIn kargoapi.SimpleFreight,
 			newFreightOut kargoapi.SimpleFreight,
 			err error,
@@ -57,15 +58,17 @@ func TestCompositePromote(t *testing.T) {
 						PromoteFn: func(
 							context.Context,
 							*kargoapi.Stage,
+							*kargoapi.Promotion,
 							kargoapi.SimpleFreight,
-						) (kargoapi.SimpleFreight, error) {
-							return kargoapi.SimpleFreight{},
+						) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
+							return nil, kargoapi.SimpleFreight{},
 								errors.New("something went wrong")
 						},
 					},
 				},
 			},
 			assertions: func(
+				_ *kargoapi.PromotionStatus,
 				newFreightIn kargoapi.SimpleFreight,
 				newFreightOut kargoapi.SimpleFreight,
 				err error,
@@ -79,6 +82,103 @@ func TestCompositePromote(t *testing.T) {
 				require.Contains(t, err.Error(), "something went wrong")
 			},
 		},
+		{
+			name: "error executing child promotion mechanism after other steps",
+			promoMech: &compositeMechanism{
+				childMechanisms: []Mechanism{
+					&FakeMechanism{
+						Name: "fake promotion mechanism",
+						PromoteFn: func(
+							_ context.Context,
+							_ *kargoapi.Stage,
+							promo *kargoapi.Promotion,
+							newFreight kargoapi.SimpleFreight,
+						) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
+							status := promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded)
+							recordStep(
+								status,
+								"fake promotion mechanism",
+								"fake-target",
+								status.Phase,
+								"fake-commit",
+								nil,
+							)
+							return status, newFreight, nil
+						},
+					},
+					&FakeMechanism{
+						Name: "another fake promotion mechanism",
+						PromoteFn: func(
+							context.Context,
+							*kargoapi.Stage,
+							*kargoapi.Promotion,
+							kargoapi.SimpleFreight,
+						) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
+							return nil, kargoapi.SimpleFreight{},
+								errors.New("something went wrong")
+						},
+					},
+				},
+			},
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				_ kargoapi.SimpleFreight,
+				_ kargoapi.SimpleFreight,
+				err error,
+			) {
+				require.Error(t, err)
+				require.NotNil(t, status)
+				require.Len(t, status.Steps, 2)
+				require.Equal(t, "fake-commit", status.Steps[0].Commit)
+				require.Equal(
+					t,
+					"another fake promotion mechanism",
+					status.Steps[1].Mechanism,
+				)
+				require.Equal(t, "something went wrong", status.Steps[1].Error)
+				require.NotNil(t, status.Steps[1].FinishedAt)
+			},
+		},
+		{
+			name: "child promotion mechanism has not completed",
+			promoMech: &compositeMechanism{
+				childMechanisms: []Mechanism{
+					&FakeMechanism{
+						Name: "fake promotion mechanism",
+						PromoteFn: func(
+							_ context.Context,
+							_ *kargoapi.Stage,
+							promo *kargoapi.Promotion,
+							newFreight kargoapi.SimpleFreight,
+						) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
+							return promo.Status.WithPhase(kargoapi.PromotionPhaseRunning),
+								newFreight, nil
+						},
+					},
+					&FakeMechanism{
+						Name: "another fake promotion mechanism",
+						PromoteFn: func(
+							context.Context,
+							*kargoapi.Stage,
+							*kargoapi.Promotion,
+							kargoapi.SimpleFreight,
+						) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
+							panic("should not be executed")
+						},
+					},
+				},
+			},
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				newFreightIn kargoapi.SimpleFreight,
+				newFreightOut kargoapi.SimpleFreight,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Equal(t, newFreightIn, newFreightOut)
+			},
+		},
 		{
 			name: "success",
 			promoMech: &compositeMechanism{
@@ -88,24 +188,45 @@ func TestCompositePromote(t *testing.T) {
 						PromoteFn: func(
 							_ context.Context,
 							_ *kargoapi.Stage,
+							promo *kargoapi.Promotion,
 							newFreight kargoapi.SimpleFreight,
-						) (kargoapi.SimpleFreight, error) {
+						) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
 							// This is not a realistic change that a child promotion mechanism
 							// would make, but for testing purposes, this is good enough to
 							// help us assert that the function under test does return all
 							// modifications made by its child promotion mechanisms.
 							newFreight.ID = "fake-mutated-id"
-							return newFreight, nil
+							status := promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded)
+							status.PullRequests = []kargoapi.PullRequestInfo{{Number: 42}}
+							return status, newFreight, nil
+						},
+					},
+					&FakeMechanism{
+						Name: "another fake promotion mechanism",
+						PromoteFn: func(
+							_ context.Context,
+							_ *kargoapi.Stage,
+							promo *kargoapi.Promotion,
+							newFreight kargoapi.SimpleFreight,
+						) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
+							// Verify that status changes made by the previous child promotion
+							// mechanism are visible to this one
+							require.Len(t, promo.Status.PullRequests, 1)
+							return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded),
+								newFreight, nil
 						},
 					},
 				},
 			},
 			assertions: func(
+				status *kargoapi.PromotionStatus,
 				newFreightIn kargoapi.SimpleFreight,
 				newFreightOut kargoapi.SimpleFreight,
 				err error,
 			) {
 				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Len(t, status.PullRequests, 1)
 				// Verify that changes made by child promotion mechanism are returned
 				require.Equal(t, "fake-mutated-id", newFreightOut.ID)
 				// Everything else should be unchanged
@@ -116,16 +237,17 @@ func TestCompositePromote(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			newFreightOut, err := testCase.promoMech.Promote(
+			status, newFreightOut, err := testCase.promoMech.Promote(
 				context.Background(),
 				&kargoapi.Stage{
 					Spec: &kargoapi.StageSpec{
 						PromotionMechanisms: &kargoapi.PromotionMechanisms{},
 					},
 				},
+				&kargoapi.Promotion{},
 				testCase.newFreight,
 			)
-			testCase.assertions(testCase.newFreight, newFreightOut, err)
+			testCase.assertions(status, testCase.newFreight, newFreightOut, err)
 		})
 	}
 }
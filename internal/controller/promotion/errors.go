@@ -0,0 +1,59 @@
+package promotion
+
+import (
+	"github.com/pkg/errors"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// MechanismError is an error type that is produced when an individual
+// promotion mechanism fails. It identifies the class of mechanism responsible
+// for the failure so that a decision can be made about whether the failure is
+// retryable.
+type MechanismError struct {
+	// Class identifies the promotion mechanism that failed.
+	Class kargoapi.PromotionErrorClass
+	// Err is the error returned by the promotion mechanism.
+	Err error
+}
+
+func (e *MechanismError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *MechanismError) Unwrap() error {
+	return e.Err
+}
+
+// GetErrorClass returns the class of the promotion mechanism responsible for
+// the provided error. An empty class is returned if the error cannot be
+// attributed to any one promotion mechanism.
+func GetErrorClass(err error) kargoapi.PromotionErrorClass {
+	var mechErr *MechanismError
+	if errors.As(err, &mechErr) {
+		return mechErr.Class
+	}
+	return ""
+}
+
+// getErrorClass returns the class of error produced by the provided promotion
+// mechanism. An empty class is returned for composite mechanisms, since the
+// errors they return are already classified by their children.
+func getErrorClass(mechanism Mechanism) kargoapi.PromotionErrorClass {
+	switch mechanism.(type) {
+	case *gitMechanism, *kargoRenderMechanism:
+		return kargoapi.PromotionErrorClassGit
+	case *argoCDMechanism:
+		return kargoapi.PromotionErrorClassArgoCD
+	case *fluxMechanism:
+		return kargoapi.PromotionErrorClassFlux
+	case *kubernetesPatchMechanism:
+		return kargoapi.PromotionErrorClassKubernetes
+	case *jobMechanism:
+		return kargoapi.PromotionErrorClassJob
+	case *httpWebhookMechanism:
+		return kargoapi.PromotionErrorClassHTTPWebhook
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,105 @@
+package promotion
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestGetErrorClass(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected kargoapi.PromotionErrorClass
+	}{
+		{
+			name:     "unclassified error",
+			err:      errors.New("something went wrong"),
+			expected: "",
+		},
+		{
+			name: "classified error",
+			err: &MechanismError{
+				Class: kargoapi.PromotionErrorClassGit,
+				Err:   errors.New("something went wrong"),
+			},
+			expected: kargoapi.PromotionErrorClassGit,
+		},
+		{
+			name: "wrapped classified error",
+			err: errors.Wrap(
+				errors.Wrap(
+					&MechanismError{
+						Class: kargoapi.PromotionErrorClassArgoCD,
+						Err:   errors.New("something went wrong"),
+					},
+					"error executing Argo CD promotion mechanism",
+				),
+				"error executing promotion mechanisms",
+			),
+			expected: kargoapi.PromotionErrorClassArgoCD,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, GetErrorClass(testCase.err))
+		})
+	}
+}
+
+func TestGetMechanismErrorClass(t *testing.T) {
+	testCases := []struct {
+		name      string
+		mechanism Mechanism
+		expected  kargoapi.PromotionErrorClass
+	}{
+		{
+			name:      "composite mechanism",
+			mechanism: &compositeMechanism{},
+			expected:  "",
+		},
+		{
+			name:      "Git mechanism",
+			mechanism: &gitMechanism{},
+			expected:  kargoapi.PromotionErrorClassGit,
+		},
+		{
+			name:      "Kargo Render mechanism",
+			mechanism: &kargoRenderMechanism{},
+			expected:  kargoapi.PromotionErrorClassGit,
+		},
+		{
+			name:      "Argo CD mechanism",
+			mechanism: &argoCDMechanism{},
+			expected:  kargoapi.PromotionErrorClassArgoCD,
+		},
+		{
+			name:      "Flux mechanism",
+			mechanism: &fluxMechanism{},
+			expected:  kargoapi.PromotionErrorClassFlux,
+		},
+		{
+			name:      "Kubernetes patch mechanism",
+			mechanism: &kubernetesPatchMechanism{},
+			expected:  kargoapi.PromotionErrorClassKubernetes,
+		},
+		{
+			name:      "Job mechanism",
+			mechanism: &jobMechanism{},
+			expected:  kargoapi.PromotionErrorClassJob,
+		},
+		{
+			name:      "HTTP webhook mechanism",
+			mechanism: &httpWebhookMechanism{},
+			expected:  kargoapi.PromotionErrorClassHTTPWebhook,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, getErrorClass(testCase.mechanism))
+		})
+	}
+}
@@ -0,0 +1,393 @@
+package promotion
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/flux"
+	"github.com/akuity/kargo/internal/git"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// fluxMechanism is an implementation of the Mechanism interface that updates
+// Flux resources.
+type fluxMechanism struct {
+	// These behaviors are overridable for testing purposes:
+	doSingleUpdateFn func(
+		ctx context.Context,
+		stageMeta metav1.ObjectMeta,
+		update kargoapi.FluxUpdate,
+		newFreight kargoapi.SimpleFreight,
+	) error
+	getFluxResourceFn func(
+		ctx context.Context,
+		kind kargoapi.FluxResourceKind,
+		namespace string,
+		name string,
+	) (*unstructured.Unstructured, error)
+	applyFluxUpdateFn func(
+		*unstructured.Unstructured,
+		kargoapi.SimpleFreight,
+		kargoapi.FluxUpdate,
+	) error
+	fluxPatchFn func(
+		ctx context.Context,
+		obj client.Object,
+		patch client.Patch,
+		opts ...client.PatchOption,
+	) error
+}
+
+// newFluxMechanism returns an implementation of the Mechanism interface that
+// updates Flux resources.
+func newFluxMechanism(fluxClient client.Client) Mechanism {
+	f := &fluxMechanism{}
+	f.doSingleUpdateFn = f.doSingleUpdate
+	f.getFluxResourceFn = getFluxResourceFn(fluxClient)
+	f.applyFluxUpdateFn = applyFluxUpdate
+	f.fluxPatchFn = fluxClient.Patch
+	return f
+}
+
+// GetName implements the Mechanism interface.
+func (*fluxMechanism) GetName() string {
+	return "Flux promotion mechanism"
+}
+
+// Promote implements the Mechanism interface.
+func (f *fluxMechanism) Promote(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	newFreight kargoapi.SimpleFreight,
+) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
+	updates := stage.Spec.PromotionMechanisms.FluxUpdates
+
+	if len(updates) == 0 {
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded),
+			newFreight, nil
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	logger.Debug("executing Flux-based promotion mechanisms")
+
+	status := promo.Status.DeepCopy()
+	for _, update := range updates {
+		target := fmt.Sprintf(
+			"%s %s/%s",
+			update.Kind,
+			update.NamespaceOrDefault(),
+			update.Name,
+		)
+		if err := f.doSingleUpdateFn(
+			ctx,
+			stage.ObjectMeta,
+			update,
+			newFreight,
+		); err != nil {
+			recordStep(
+				status,
+				f.GetName(),
+				target,
+				kargoapi.PromotionPhaseErrored,
+				"",
+				err,
+			)
+			return status, newFreight, err
+		}
+		recordStep(
+			status,
+			f.GetName(),
+			target,
+			kargoapi.PromotionPhaseSucceeded,
+			"",
+			nil,
+		)
+	}
+
+	logger.Debug("done executing Flux-based promotion mechanisms")
+
+	return status.WithPhase(kargoapi.PromotionPhaseSucceeded), newFreight, nil
+}
+
+// doSingleUpdate applies the specified update to a single Flux resource and
+// requests that Flux reconcile it immediately.
+func (f *fluxMechanism) doSingleUpdate(
+	ctx context.Context,
+	stageMeta metav1.ObjectMeta,
+	update kargoapi.FluxUpdate,
+	newFreight kargoapi.SimpleFreight,
+) error {
+	obj, err := f.getFluxResourceFn(
+		ctx,
+		update.Kind,
+		update.NamespaceOrDefault(),
+		update.Name,
+	)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"error finding Flux %s %q in namespace %q",
+			update.Kind,
+			update.Name,
+			update.NamespaceOrDefault(),
+		)
+	}
+	if obj == nil {
+		return errors.Errorf(
+			"unable to find Flux %s %q in namespace %q",
+			update.Kind,
+			update.Name,
+			update.NamespaceOrDefault(),
+		)
+	}
+	// Make sure this is allowed!
+	if err = authorizeUpdate(
+		fmt.Sprintf("Flux %s", update.Kind),
+		stageMeta,
+		metav1.ObjectMeta{
+			Name:        obj.GetName(),
+			Namespace:   obj.GetNamespace(),
+			Annotations: obj.GetAnnotations(),
+		},
+	); err != nil {
+		return err
+	}
+	if preview := previewFromContext(ctx); preview != nil {
+		preview.Warnings = append(
+			preview.Warnings,
+			fmt.Sprintf(
+				"changes to Flux %s %q in namespace %q cannot be previewed",
+				update.Kind,
+				obj.GetName(),
+				obj.GetNamespace(),
+			),
+		)
+		return nil
+	}
+	patch := client.MergeFrom(obj.DeepCopy())
+	if err = f.applyFluxUpdateFn(obj, newFreight, update); err != nil {
+		return errors.Wrapf(
+			err,
+			"error updating Flux %s %q in namespace %q",
+			update.Kind,
+			obj.GetName(),
+			obj.GetNamespace(),
+		)
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[flux.AnnotationKeyReconcileRequestedAt] =
+		time.Now().UTC().Format(time.RFC3339Nano)
+	obj.SetAnnotations(annotations)
+	if err = f.fluxPatchFn(ctx, obj, patch, &client.PatchOptions{}); err != nil {
+		return errors.Wrapf(
+			err,
+			"error patching Flux %s %q in namespace %q",
+			update.Kind,
+			obj.GetName(),
+			obj.GetNamespace(),
+		)
+	}
+	logging.LoggerFromContext(ctx).WithFields(log.Fields{
+		"kind": update.Kind,
+		"name": obj.GetName(),
+	}).Debug("patched Flux resource")
+	return nil
+}
+
+func getFluxResourceFn(
+	fluxClient client.Client,
+) func(
+	ctx context.Context,
+	kind kargoapi.FluxResourceKind,
+	namespace string,
+	name string,
+) (*unstructured.Unstructured, error) {
+	return func(
+		ctx context.Context,
+		kind kargoapi.FluxResourceKind,
+		namespace string,
+		name string,
+	) (*unstructured.Unstructured, error) {
+		return flux.GetResource(ctx, fluxClient, kind, namespace, name)
+	}
+}
+
+// applyFluxUpdate updates a single Flux resource in place.
+func applyFluxUpdate(
+	obj *unstructured.Unstructured,
+	newFreight kargoapi.SimpleFreight,
+	update kargoapi.FluxUpdate,
+) error {
+	switch update.Kind {
+	case kargoapi.FluxResourceKindGitRepository:
+		return applyFluxGitRepositoryUpdate(obj, newFreight)
+	case kargoapi.FluxResourceKindOCIRepository:
+		return applyFluxOCIRepositoryUpdate(obj, newFreight)
+	case kargoapi.FluxResourceKindHelmRelease:
+		if update.HelmRelease == nil {
+			return nil
+		}
+		return applyFluxHelmReleaseUpdate(obj, newFreight, *update.HelmRelease)
+	case kargoapi.FluxResourceKindKustomization:
+		if update.Kustomization == nil {
+			return nil
+		}
+		return applyFluxKustomizationUpdate(
+			obj,
+			newFreight,
+			*update.Kustomization,
+		)
+	default:
+		return errors.Errorf("unsupported Flux resource kind %q", update.Kind)
+	}
+}
+
+// applyFluxGitRepositoryUpdate points a GitRepository's reference at the
+// commit from the Freight that belongs to the GitRepository's repository.
+func applyFluxGitRepositoryUpdate(
+	obj *unstructured.Unstructured,
+	newFreight kargoapi.SimpleFreight,
+) error {
+	repoURL, _, _ := unstructured.NestedString(obj.Object, "spec", "url")
+	for _, commit := range newFreight.Commits {
+		if git.NormalizeGitURL(commit.RepoURL) == git.NormalizeGitURL(repoURL) {
+			// When both are specified, Flux checks out the commit and verifies it
+			// belongs to the branch, so any branch is deliberately left alone.
+			return unstructured.SetNestedField(
+				obj.Object,
+				commit.ID,
+				"spec", "ref", "commit",
+			)
+		}
+	}
+	return nil
+}
+
+// applyFluxOCIRepositoryUpdate points an OCIRepository's reference at the tag
+// of the image from the Freight that belongs to the OCIRepository's
+// repository.
+func applyFluxOCIRepositoryUpdate(
+	obj *unstructured.Unstructured,
+	newFreight kargoapi.SimpleFreight,
+) error {
+	repoURL, _, _ := unstructured.NestedString(obj.Object, "spec", "url")
+	repoURL = strings.TrimPrefix(repoURL, "oci://")
+	for _, image := range newFreight.Images {
+		if image.RepoURL == repoURL {
+			// Digests and semver ranges take precedence over tags, so they must be
+			// removed for the tag to take effect.
+			unstructured.RemoveNestedField(obj.Object, "spec", "ref", "digest")
+			unstructured.RemoveNestedField(obj.Object, "spec", "ref", "semver")
+			return unstructured.SetNestedField(
+				obj.Object,
+				image.Tag,
+				"spec", "ref", "tag",
+			)
+		}
+	}
+	return nil
+}
+
+// applyFluxHelmReleaseUpdate updates a HelmRelease's chart version and values.
+func applyFluxHelmReleaseUpdate(
+	obj *unstructured.Unstructured,
+	newFreight kargoapi.SimpleFreight,
+	update kargoapi.FluxHelmReleaseUpdate,
+) error {
+	if update.ChartRegistryURL != "" {
+		chartName, _, _ := unstructured.NestedString(
+			obj.Object,
+			"spec", "chart", "spec", "chart",
+		)
+		for _, chart := range newFreight.Charts {
+			if chart.RegistryURL == update.ChartRegistryURL &&
+				chart.Name == chartName {
+				if err := unstructured.SetNestedField(
+					obj.Object,
+					chart.Version,
+					"spec", "chart", "spec", "version",
+				); err != nil {
+					return errors.Wrap(err, "error updating chart version")
+				}
+				break
+			}
+		}
+	}
+	imagesByRepo := make(map[string]kargoapi.Image, len(newFreight.Images))
+	for _, image := range newFreight.Images {
+		imagesByRepo[image.RepoURL] = image
+	}
+	for _, imageUpdate := range update.Images {
+		image, found := imagesByRepo[imageUpdate.Image]
+		if !found {
+			// There's no change to make in this case.
+			continue
+		}
+		value := image.GetValue(imageUpdate.Value)
+		if value == "" {
+			// There's no change to make in this case.
+			continue
+		}
+		if err := unstructured.SetNestedField(
+			obj.Object,
+			value,
+			append([]string{"spec", "values"}, strings.Split(imageUpdate.Key, ".")...)...,
+		); err != nil {
+			return errors.Wrapf(err, "error updating value of key %q", imageUpdate.Key)
+		}
+	}
+	return nil
+}
+
+// applyFluxKustomizationUpdate overrides the tags of images in a
+// Kustomization's images field.
+func applyFluxKustomizationUpdate(
+	obj *unstructured.Unstructured,
+	newFreight kargoapi.SimpleFreight,
+	update kargoapi.FluxKustomizationUpdate,
+) error {
+	tagsByImage := map[string]string{}
+	for _, image := range newFreight.Images {
+		tagsByImage[image.RepoURL] = image.Tag
+	}
+	images, _, err := unstructured.NestedSlice(obj.Object, "spec", "images")
+	if err != nil {
+		return errors.Wrap(err, "error reading images")
+	}
+imageUpdateLoop:
+	for _, imageName := range update.Images {
+		tag, found := tagsByImage[imageName]
+		if !found {
+			// There's no change to make in this case.
+			continue
+		}
+		for _, i := range images {
+			if image, ok := i.(map[string]any); ok && image["name"] == imageName {
+				image["newTag"] = tag
+				delete(image, "digest")
+				continue imageUpdateLoop
+			}
+		}
+		images = append(
+			images,
+			map[string]any{
+				"name":   imageName,
+				"newTag": tag,
+			},
+		)
+	}
+	return unstructured.SetNestedSlice(obj.Object, images, "spec", "images")
+}
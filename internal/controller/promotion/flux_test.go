@@ -0,0 +1,558 @@
+package promotion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/flux"
+)
+
+func TestNewFluxMechanism(t *testing.T) {
+	pm := newFluxMechanism(
+		fake.NewClientBuilder().Build(),
+	)
+	fpm, ok := pm.(*fluxMechanism)
+	require.True(t, ok)
+	require.NotNil(t, fpm.doSingleUpdateFn)
+	require.NotNil(t, fpm.getFluxResourceFn)
+	require.NotNil(t, fpm.applyFluxUpdateFn)
+	require.NotNil(t, fpm.fluxPatchFn)
+}
+
+func TestFluxGetName(t *testing.T) {
+	require.NotEmpty(t, (&fluxMechanism{}).GetName())
+}
+
+func TestFluxPromote(t *testing.T) {
+	testCases := []struct {
+		name       string
+		promoMech  *fluxMechanism
+		stage      *kargoapi.Stage
+		assertions func(*kargoapi.PromotionStatus, error)
+	}{
+		{
+			name:      "no updates",
+			promoMech: &fluxMechanism{},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name: "error applying update",
+			promoMech: &fluxMechanism{
+				doSingleUpdateFn: func(
+					context.Context,
+					metav1.ObjectMeta,
+					kargoapi.FluxUpdate,
+					kargoapi.SimpleFreight,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						FluxUpdates: []kargoapi.FluxUpdate{{}},
+					},
+				},
+			},
+			assertions: func(_ *kargoapi.PromotionStatus, err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "success",
+			promoMech: &fluxMechanism{
+				doSingleUpdateFn: func(
+					context.Context,
+					metav1.ObjectMeta,
+					kargoapi.FluxUpdate,
+					kargoapi.SimpleFreight,
+				) error {
+					return nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						FluxUpdates: []kargoapi.FluxUpdate{{}},
+					},
+				},
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status, _, err := testCase.promoMech.Promote(
+				context.Background(),
+				testCase.stage,
+				&kargoapi.Promotion{},
+				kargoapi.SimpleFreight{},
+			)
+			testCase.assertions(status, err)
+		})
+	}
+}
+
+func TestFluxDoSingleUpdate(t *testing.T) {
+	testStageMeta := metav1.ObjectMeta{
+		Name:      "fake-stage",
+		Namespace: "fake-namespace",
+	}
+	testUpdate := kargoapi.FluxUpdate{
+		Kind:      kargoapi.FluxResourceKindKustomization,
+		Name:      "fake-name",
+		Namespace: "fake-namespace",
+	}
+	newAuthorizedObj := func() *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetName("fake-name")
+		obj.SetNamespace("fake-namespace")
+		obj.SetAnnotations(map[string]string{
+			authorizedStageAnnotationKey: "fake-namespace:fake-stage",
+		})
+		return obj
+	}
+	testCases := []struct {
+		name       string
+		promoMech  *fluxMechanism
+		ctx        context.Context
+		assertions func(error)
+	}{
+		{
+			name: "error getting resource",
+			promoMech: &fluxMechanism{
+				getFluxResourceFn: func(
+					context.Context,
+					kargoapi.FluxResourceKind,
+					string,
+					string,
+				) (*unstructured.Unstructured, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error finding Flux Kustomization")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "resource not found",
+			promoMech: &fluxMechanism{
+				getFluxResourceFn: func(
+					context.Context,
+					kargoapi.FluxResourceKind,
+					string,
+					string,
+				) (*unstructured.Unstructured, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "unable to find Flux Kustomization")
+			},
+		},
+		{
+			name: "update not authorized",
+			promoMech: &fluxMechanism{
+				getFluxResourceFn: func(
+					context.Context,
+					kargoapi.FluxResourceKind,
+					string,
+					string,
+				) (*unstructured.Unstructured, error) {
+					obj := &unstructured.Unstructured{}
+					obj.SetName("fake-name")
+					obj.SetNamespace("fake-namespace")
+					return obj, nil
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "does not permit mutation")
+			},
+		},
+		{
+			name: "preview",
+			promoMech: &fluxMechanism{
+				getFluxResourceFn: func(
+					context.Context,
+					kargoapi.FluxResourceKind,
+					string,
+					string,
+				) (*unstructured.Unstructured, error) {
+					return newAuthorizedObj(), nil
+				},
+				fluxPatchFn: func(
+					context.Context,
+					client.Object,
+					client.Patch,
+					...client.PatchOption,
+				) error {
+					require.Fail(t, "resource should not have been patched")
+					return nil
+				},
+			},
+			ctx: ContextWithPreview(context.Background(), &Preview{}),
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "error applying update",
+			promoMech: &fluxMechanism{
+				getFluxResourceFn: func(
+					context.Context,
+					kargoapi.FluxResourceKind,
+					string,
+					string,
+				) (*unstructured.Unstructured, error) {
+					return newAuthorizedObj(), nil
+				},
+				applyFluxUpdateFn: func(
+					*unstructured.Unstructured,
+					kargoapi.SimpleFreight,
+					kargoapi.FluxUpdate,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error updating Flux Kustomization")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "error patching resource",
+			promoMech: &fluxMechanism{
+				getFluxResourceFn: func(
+					context.Context,
+					kargoapi.FluxResourceKind,
+					string,
+					string,
+				) (*unstructured.Unstructured, error) {
+					return newAuthorizedObj(), nil
+				},
+				applyFluxUpdateFn: func(
+					*unstructured.Unstructured,
+					kargoapi.SimpleFreight,
+					kargoapi.FluxUpdate,
+				) error {
+					return nil
+				},
+				fluxPatchFn: func(
+					context.Context,
+					client.Object,
+					client.Patch,
+					...client.PatchOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error patching Flux Kustomization")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			promoMech: &fluxMechanism{
+				getFluxResourceFn: func(
+					context.Context,
+					kargoapi.FluxResourceKind,
+					string,
+					string,
+				) (*unstructured.Unstructured, error) {
+					return newAuthorizedObj(), nil
+				},
+				applyFluxUpdateFn: func(
+					*unstructured.Unstructured,
+					kargoapi.SimpleFreight,
+					kargoapi.FluxUpdate,
+				) error {
+					return nil
+				},
+				fluxPatchFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ client.Patch,
+					_ ...client.PatchOption,
+				) error {
+					require.NotEmpty(
+						t,
+						obj.GetAnnotations()[flux.AnnotationKeyReconcileRequestedAt],
+					)
+					return nil
+				},
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := testCase.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			testCase.assertions(
+				testCase.promoMech.doSingleUpdate(
+					ctx,
+					testStageMeta,
+					testUpdate,
+					kargoapi.SimpleFreight{},
+				),
+			)
+		})
+	}
+}
+
+func TestApplyFluxUpdate(t *testing.T) {
+	testFreight := kargoapi.SimpleFreight{
+		Commits: []kargoapi.GitCommit{
+			{
+				RepoURL: "https://github.com/example/repo.git",
+				ID:      "fake-commit",
+			},
+		},
+		Images: []kargoapi.Image{
+			{
+				RepoURL: "ghcr.io/example/image",
+				Tag:     "v1.2.3",
+				Digest:  "sha256:def",
+			},
+		},
+		Charts: []kargoapi.Chart{
+			{
+				RegistryURL: "https://charts.example.com",
+				Name:        "fake-chart",
+				Version:     "4.5.6",
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		obj        map[string]any
+		update     kargoapi.FluxUpdate
+		assertions func(map[string]any, error)
+	}{
+		{
+			name: "unsupported kind",
+			obj:  map[string]any{},
+			update: kargoapi.FluxUpdate{
+				Kind: "Bogus",
+			},
+			assertions: func(_ map[string]any, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "unsupported Flux resource kind")
+			},
+		},
+		{
+			name: "GitRepository",
+			obj: map[string]any{
+				"spec": map[string]any{
+					"url": "https://github.com/example/repo",
+					"ref": map[string]any{
+						"branch": "main",
+					},
+				},
+			},
+			update: kargoapi.FluxUpdate{
+				Kind: kargoapi.FluxResourceKindGitRepository,
+			},
+			assertions: func(obj map[string]any, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					map[string]any{
+						"branch": "main",
+						"commit": "fake-commit",
+					},
+					obj["spec"].(map[string]any)["ref"], // nolint: forcetypeassert
+				)
+			},
+		},
+		{
+			name: "OCIRepository",
+			obj: map[string]any{
+				"spec": map[string]any{
+					"url": "oci://ghcr.io/example/image",
+					"ref": map[string]any{
+						"semver": ">=1.0.0",
+					},
+				},
+			},
+			update: kargoapi.FluxUpdate{
+				Kind: kargoapi.FluxResourceKindOCIRepository,
+			},
+			assertions: func(obj map[string]any, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					map[string]any{
+						"tag": "v1.2.3",
+					},
+					obj["spec"].(map[string]any)["ref"], // nolint: forcetypeassert
+				)
+			},
+		},
+		{
+			name: "HelmRelease",
+			obj: map[string]any{
+				"spec": map[string]any{
+					"chart": map[string]any{
+						"spec": map[string]any{
+							"chart":   "fake-chart",
+							"version": "1.0.0",
+						},
+					},
+				},
+			},
+			update: kargoapi.FluxUpdate{
+				Kind: kargoapi.FluxResourceKindHelmRelease,
+				HelmRelease: &kargoapi.FluxHelmReleaseUpdate{
+					ChartRegistryURL: "https://charts.example.com",
+					Images: []kargoapi.FluxHelmImageUpdate{
+						{
+							Image: "ghcr.io/example/image",
+							Key:   "image.tag",
+							Value: kargoapi.ImageUpdateValueTypeTag,
+						},
+						{
+							Image: "ghcr.io/example/image",
+							Key:   "sidecar.image",
+							Value: kargoapi.ImageUpdateValueTypeImage,
+						},
+						{
+							Image: "ghcr.io/example/image",
+							Key:   "pinned.image",
+							Value: kargoapi.ImageUpdateValueTypeImageAndDigest,
+						},
+						{
+							Image: "ghcr.io/example/other-image",
+							Key:   "other.image",
+							Value: kargoapi.ImageUpdateValueTypeImage,
+						},
+					},
+				},
+			},
+			assertions: func(obj map[string]any, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					map[string]any{
+						"chart": map[string]any{
+							"spec": map[string]any{
+								"chart":   "fake-chart",
+								"version": "4.5.6",
+							},
+						},
+						"values": map[string]any{
+							"image": map[string]any{
+								"tag": "v1.2.3",
+							},
+							"sidecar": map[string]any{
+								"image": "ghcr.io/example/image:v1.2.3",
+							},
+							"pinned": map[string]any{
+								"image": "ghcr.io/example/image@sha256:def",
+							},
+						},
+					},
+					obj["spec"],
+				)
+			},
+		},
+		{
+			name: "Kustomization",
+			obj: map[string]any{
+				"spec": map[string]any{
+					"images": []any{
+						map[string]any{
+							"name":   "ghcr.io/example/image",
+							"digest": "sha256:abc",
+						},
+					},
+				},
+			},
+			update: kargoapi.FluxUpdate{
+				Kind: kargoapi.FluxResourceKindKustomization,
+				Kustomization: &kargoapi.FluxKustomizationUpdate{
+					Images: []string{
+						"ghcr.io/example/image",
+						"ghcr.io/example/other-image",
+					},
+				},
+			},
+			assertions: func(obj map[string]any, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]any{
+						map[string]any{
+							"name":   "ghcr.io/example/image",
+							"newTag": "v1.2.3",
+						},
+					},
+					obj["spec"].(map[string]any)["images"], // nolint: forcetypeassert
+				)
+			},
+		},
+		{
+			name: "Kustomization with image not yet overridden",
+			obj: map[string]any{
+				"spec": map[string]any{},
+			},
+			update: kargoapi.FluxUpdate{
+				Kind: kargoapi.FluxResourceKindKustomization,
+				Kustomization: &kargoapi.FluxKustomizationUpdate{
+					Images: []string{"ghcr.io/example/image"},
+				},
+			},
+			assertions: func(obj map[string]any, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]any{
+						map[string]any{
+							"name":   "ghcr.io/example/image",
+							"newTag": "v1.2.3",
+						},
+					},
+					obj["spec"].(map[string]any)["images"], // nolint: forcetypeassert
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			obj := &unstructured.Unstructured{Object: testCase.obj}
+			err := applyFluxUpdate(obj, testFreight, testCase.update)
+			testCase.assertions(obj.Object, err)
+		})
+	}
+}
@@ -5,12 +5,15 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"text/template"
 
 	"github.com/pkg/errors"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/git"
 	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/gitprovider"
 	"github.com/akuity/kargo/internal/logging"
 )
 
@@ -23,10 +26,10 @@ type gitMechanism struct {
 	selectUpdatesFn  func([]kargoapi.GitRepoUpdate) []kargoapi.GitRepoUpdate
 	doSingleUpdateFn func(
 		ctx context.Context,
-		namespace string,
+		promo *kargoapi.Promotion,
 		update kargoapi.GitRepoUpdate,
 		newFreight kargoapi.SimpleFreight,
-	) (kargoapi.SimpleFreight, error)
+	) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error)
 	getReadRefFn func(
 		update kargoapi.GitRepoUpdate,
 		commits []kargoapi.GitCommit,
@@ -36,13 +39,28 @@ type gitMechanism struct {
 		namespace string,
 		repoURL string,
 	) (*git.RepoCredentials, error)
-	gitCommitFn func(
+	getGitUserFn func(ctx context.Context, namespace string) (*git.User, error)
+	gitCommitFn  func(
+		promo *kargoapi.Promotion,
 		update kargoapi.GitRepoUpdate,
 		newFreight kargoapi.SimpleFreight,
 		readRef string,
 		writeBranch string,
 		creds *git.RepoCredentials,
+		user *git.User,
 	) (string, error)
+	gitDiffFn func(
+		promo *kargoapi.Promotion,
+		update kargoapi.GitRepoUpdate,
+		newFreight kargoapi.SimpleFreight,
+		readRef string,
+		creds *git.RepoCredentials,
+		user *git.User,
+	) (string, string, error)
+	getGitProviderServiceFn func(
+		update kargoapi.GitRepoUpdate,
+		creds *git.RepoCredentials,
+	) (gitprovider.Service, error)
 	applyConfigManagementFn func(
 		update kargoapi.GitRepoUpdate,
 		newFreight kargoapi.SimpleFreight,
@@ -73,7 +91,10 @@ func newGitMechanism(
 	g.doSingleUpdateFn = g.doSingleUpdate
 	g.getReadRefFn = getReadRef
 	g.getCredentialsFn = getRepoCredentialsFn(credentialsDB)
+	g.getGitUserFn = getGitUserFn(credentialsDB)
 	g.gitCommitFn = g.gitCommit
+	g.gitDiffFn = g.gitDiff
+	g.getGitProviderServiceFn = getGitProviderService
 	g.applyConfigManagementFn = applyConfigManagementFn
 	return g
 }
@@ -87,73 +108,370 @@ func (g *gitMechanism) GetName() string {
 func (g *gitMechanism) Promote(
 	ctx context.Context,
 	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
 	newFreight kargoapi.SimpleFreight,
-) (kargoapi.SimpleFreight, error) {
+) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
 	updates := g.selectUpdatesFn(stage.Spec.PromotionMechanisms.GitRepoUpdates)
 
 	if len(updates) == 0 {
-		return newFreight, nil
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded),
+			newFreight, nil
 	}
 
 	newFreight = *newFreight.DeepCopy()
+	promo = promo.DeepCopy()
 
 	logger := logging.LoggerFromContext(ctx)
 	logger.Debugf("executing %s", g.name)
 
+	phase := kargoapi.PromotionPhaseSucceeded
 	for _, update := range updates {
-		var err error
-		if newFreight, err = g.doSingleUpdateFn(
+		// doSingleUpdateFn records the outcome of any update that does not fail.
+		// Failures are recorded here, since no status may have been returned.
+		status, nextFreight, err := g.doSingleUpdateFn(
 			ctx,
-			stage.Namespace,
+			promo,
 			update,
 			newFreight,
-		); err != nil {
-			return newFreight, err
+		)
+		if err != nil {
+			if status == nil {
+				status = promo.Status.DeepCopy()
+			}
+			recordStep(
+				status,
+				g.name,
+				update.RepoURL,
+				kargoapi.PromotionPhaseErrored,
+				"",
+				err,
+			)
+			return status, nextFreight, err
+		}
+		newFreight = nextFreight
+		promo.Status = *status
+		// Updates to different repositories are independent of one another, so
+		// an update that has not completed yet does not prevent us from moving on
+		// to the next one.
+		if status.Phase != kargoapi.PromotionPhaseSucceeded {
+			phase = status.Phase
 		}
 	}
 
 	logger.Debugf("done executing %s", g.name)
 
-	return newFreight, nil
+	return promo.Status.WithPhase(phase), newFreight, nil
 }
 
 // doSingleUpdate updates configuration in a single Git repository.
 func (g *gitMechanism) doSingleUpdate(
 	ctx context.Context,
-	namespace string,
+	promo *kargoapi.Promotion,
 	update kargoapi.GitRepoUpdate,
 	newFreight kargoapi.SimpleFreight,
-) (kargoapi.SimpleFreight, error) {
+) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
 	readRef, commitIndex, err := g.getReadRefFn(update, newFreight.Commits)
 	if err != nil {
-		return newFreight, err
+		return nil, newFreight, err
 	}
 
 	creds, err := g.getCredentialsFn(
 		ctx,
-		namespace,
+		promo.Namespace,
 		update.RepoURL,
 	)
 	if err != nil {
-		return newFreight, err
+		return nil, newFreight, err
+	}
+
+	user, err := g.getGitUserFn(ctx, promo.Namespace)
+	if err != nil {
+		return nil, newFreight, err
+	}
+	signingKeyID, err := user.SigningKeyID()
+	if err != nil {
+		return nil, newFreight, errors.Wrap(
+			err,
+			"error determining ID of git commit signing key",
+		)
+	}
+
+	if preview := previewFromContext(ctx); preview != nil {
+		// Nothing is pushed and no pull request is opened when previewing. The
+		// diff is always against the WriteBranch, since that is the branch
+		// changes would ultimately land in.
+		commitMsg, diff, err := g.gitDiffFn(
+			promo,
+			update,
+			newFreight,
+			readRef,
+			creds,
+			user,
+		)
+		if err != nil {
+			return nil, newFreight, err
+		}
+		preview.GitRepoDiffs = append(
+			preview.GitRepoDiffs,
+			GitRepoDiff{
+				RepoURL:       update.RepoURL,
+				Branch:        update.WriteBranch,
+				CommitMessage: commitMsg,
+				Diff:          diff,
+			},
+		)
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded),
+			newFreight, nil
+	}
+
+	if update.PullRequest != nil {
+		return g.doPullRequestUpdate(
+			ctx,
+			promo,
+			update,
+			newFreight,
+			readRef,
+			commitIndex,
+			creds,
+			user,
+			signingKeyID,
+		)
 	}
 
 	commitID, err := g.gitCommitFn(
+		promo,
 		update,
 		newFreight,
 		readRef,
 		update.WriteBranch,
 		creds,
+		user,
 	)
 	if err != nil {
-		return newFreight, err
+		return nil, newFreight, err
 	}
 
 	if commitIndex > -1 {
 		newFreight.Commits[commitIndex].HealthCheckCommit = commitID
 	}
 
-	return newFreight, nil
+	status := promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded)
+	if signingKeyID != "" {
+		status.SigningKeyID = signingKeyID
+	}
+	recordStep(status, g.name, update.RepoURL, status.Phase, commitID, nil)
+	return status, newFreight, nil
+}
+
+// doPullRequestUpdate updates configuration in a single Git repository by way
+// of a pull request. The first time it is invoked for a given Promotion, it
+// pushes changes to a new branch and opens a pull request to merge that branch
+// into the update's WriteBranch. On subsequent invocations, it checks on the
+// state of the pull request it opened previously. The returned
+// PromotionStatus has a phase of Running for as long as the pull request
+// remains open.
+func (g *gitMechanism) doPullRequestUpdate(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+	update kargoapi.GitRepoUpdate,
+	newFreight kargoapi.SimpleFreight,
+	readRef string,
+	commitIndex int,
+	creds *git.RepoCredentials,
+	user *git.User,
+	signingKeyID string,
+) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
+	logger := logging.LoggerFromContext(ctx).WithField("repo", update.RepoURL)
+	status := promo.Status.DeepCopy()
+
+	gpSvc, err := g.getGitProviderServiceFn(update, creds)
+	if err != nil {
+		return nil, newFreight, errors.Wrapf(
+			err,
+			"error getting Git provider service for repo %q",
+			update.RepoURL,
+		)
+	}
+
+	prIndex := -1
+	for i, pr := range status.PullRequests {
+		if pr.RepoURL == update.RepoURL && pr.TargetBranch == update.WriteBranch {
+			prIndex = i
+			break
+		}
+	}
+
+	if prIndex < 0 {
+		prBranch := getPullRequestBranch(
+			promo.Name,
+			promo.Status.CurrentAttempt(),
+			update.WriteBranch,
+		)
+		var commitID string
+		if commitID, err = g.gitCommitFn(
+			promo,
+			update,
+			newFreight,
+			readRef,
+			prBranch,
+			creds,
+			user,
+		); err != nil {
+			return nil, newFreight, err
+		}
+		if signingKeyID != "" {
+			status.SigningKeyID = signingKeyID
+		}
+		if commitID == "" {
+			logger.Debug("no changes to propose; not opening a pull request")
+			status.Phase = kargoapi.PromotionPhaseSucceeded
+			recordStep(status, g.name, update.RepoURL, status.Phase, "", nil).Message =
+				fmt.Sprintf(
+					"no changes to propose to branch %q; no pull request was opened",
+					update.WriteBranch,
+				)
+			return status, newFreight, nil
+		}
+		var pr *gitprovider.PullRequest
+		if pr, err = gpSvc.CreatePullRequest(
+			ctx,
+			gitprovider.CreatePullRequestOpts{
+				Head: prBranch,
+				Base: update.WriteBranch,
+				Title: fmt.Sprintf(
+					"Promote Freight %s to Stage %s",
+					promo.Spec.Freight,
+					promo.Spec.Stage,
+				),
+				Description: fmt.Sprintf(
+					"This pull request was opened by Kargo on behalf of Promotion "+
+						"%q in namespace %q.",
+					promo.Name,
+					promo.Namespace,
+				),
+			},
+		); err != nil {
+			return nil, newFreight, errors.Wrapf(
+				err,
+				"error opening pull request to merge branch %q into branch %q "+
+					"of repo %q",
+				prBranch,
+				update.WriteBranch,
+				update.RepoURL,
+			)
+		}
+		logger.WithField("pullRequest", pr.URL).Debug("opened pull request")
+		status.PullRequests = append(
+			status.PullRequests,
+			kargoapi.PullRequestInfo{
+				RepoURL:      update.RepoURL,
+				SourceBranch: prBranch,
+				TargetBranch: update.WriteBranch,
+			},
+		)
+		prIndex = len(status.PullRequests) - 1
+		applyPullRequest(&status.PullRequests[prIndex], pr)
+	} else {
+		prInfo := &status.PullRequests[prIndex]
+		pr, err := gpSvc.GetPullRequest(ctx, prInfo.Number)
+		if err != nil {
+			return nil, newFreight, errors.Wrapf(
+				err,
+				"error getting pull request %q",
+				prInfo.URL,
+			)
+		}
+		applyPullRequest(prInfo, pr)
+	}
+
+	prInfo := status.PullRequests[prIndex]
+	switch prInfo.State {
+	case kargoapi.PullRequestStateMerged:
+		logger.WithField("pullRequest", prInfo.URL).Debug("pull request was merged")
+		if commitIndex > -1 {
+			newFreight.Commits[commitIndex].HealthCheckCommit = prInfo.MergeCommit
+		}
+		status.Phase = kargoapi.PromotionPhaseSucceeded
+		recordStep(
+			status,
+			g.name,
+			update.RepoURL,
+			status.Phase,
+			prInfo.MergeCommit,
+			nil,
+		)
+	case kargoapi.PullRequestStateClosed:
+		return status, newFreight, errors.Errorf(
+			"pull request %q was closed without being merged",
+			prInfo.URL,
+		)
+	default:
+		logger.WithField("pullRequest", prInfo.URL).
+			Debug("waiting for pull request to be merged or closed")
+		status.Phase = kargoapi.PromotionPhaseRunning
+		recordStep(status, g.name, update.RepoURL, status.Phase, "", nil)
+	}
+	return status, newFreight, nil
+}
+
+// getPullRequestBranch returns the name of the branch to which changes should
+// be pushed when the specified attempt at executing the specified Promotion
+// proposes changes to the specified branch by way of a pull request. Branches
+// pushed by retries are suffixed with the number of the attempt so that a
+// retry never reuses the branch of a pull request that was closed. Since
+// Promotion names cannot contain underscores, such branches cannot collide
+// with those of other Promotions.
+func getPullRequestBranch(
+	promoName string,
+	attempt int,
+	targetBranch string,
+) string {
+	if attempt > 1 {
+		return fmt.Sprintf(
+			"kargo/promotion/%s_attempt-%d/%s",
+			promoName,
+			attempt,
+			targetBranch,
+		)
+	}
+	return fmt.Sprintf("kargo/promotion/%s/%s", promoName, targetBranch)
+}
+
+// applyPullRequest updates the provided PullRequestInfo to reflect the most
+// recently observed state of a pull request.
+func applyPullRequest(
+	prInfo *kargoapi.PullRequestInfo,
+	pr *gitprovider.PullRequest,
+) {
+	prInfo.Number = pr.Number
+	prInfo.URL = pr.URL
+	prInfo.MergeCommit = pr.MergeCommit
+	switch pr.State {
+	case gitprovider.PullRequestStateMerged:
+		prInfo.State = kargoapi.PullRequestStateMerged
+	case gitprovider.PullRequestStateClosed:
+		prInfo.State = kargoapi.PullRequestStateClosed
+	default:
+		prInfo.State = kargoapi.PullRequestStateOpen
+	}
+}
+
+// getGitProviderService returns a gitprovider.Service for the repository
+// referenced by the provided update. The password from the provided
+// credentials (which may be nil) is used as a token for authenticating to the
+// provider's API.
+func getGitProviderService(
+	update kargoapi.GitRepoUpdate,
+	creds *git.RepoCredentials,
+) (gitprovider.Service, error) {
+	opts := gitprovider.Options{
+		Name:   gitprovider.Name(update.PullRequest.Provider),
+		APIURL: update.PullRequest.APIURL,
+	}
+	if creds != nil {
+		opts.Token = creds.Password
+	}
+	return gitprovider.NewService(update.RepoURL, opts)
 }
 
 // getReadRef steps through the provided slice of commits to determine if any of
@@ -227,33 +545,194 @@ func getRepoCredentialsFn(
 	}
 }
 
-// gitCommit clones the specified git repository using the provided credentials
-// (which may be nil), checks out the specified readRef (if non-empty), applies
-// the provided update function to the cloned repository, and then commits and
-// pushes any changes to the specified writeBranch. The function returns the
-// commit ID of the last commit made to the repository, or an error if any of
-// the above fails.
+// getGitUserFn returns a function that closes over the provided credentials
+// database and, when invoked, uses that database to obtain the identity (and,
+// optionally, the signing key) with which commits should be made in the
+// specified namespace. If no such identity is found, then nil is returned and
+// commits will be made using a default identity and will not be signed.
+func getGitUserFn(
+	credentialsDB credentials.Database,
+) func(ctx context.Context, namespace string) (*git.User, error) {
+	return func(ctx context.Context, namespace string) (*git.User, error) {
+		gitUser, ok, err := credentialsDB.GetGitUser(ctx, namespace)
+		if err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error obtaining git user for namespace %q",
+				namespace,
+			)
+		}
+		logger := logging.LoggerFromContext(ctx)
+		if !ok {
+			logger.Debug("found no git user; using default identity")
+			return nil, nil
+		}
+		logger.Debug("obtained git user")
+		return &git.User{
+			Name:           gitUser.Name,
+			Email:          gitUser.Email,
+			SigningKeyType: git.SigningKeyType(gitUser.SigningKeyType),
+			SigningKey:     gitUser.SigningKey,
+		}, nil
+	}
+}
+
+// gitCommit prepares changes to the specified git repository (see
+// prepareCommit) and then commits and pushes any changes to the specified
+// writeBranch. The function returns the commit ID of the last commit made to
+// the repository, or an error if any of the above fails. If the update calls
+// for a pull request, writeBranch is the branch from which the pull request
+// will be opened. If that branch does not already exist, it is created from the
+// update's WriteBranch and, if there turn out to be no changes to push to it,
+// an empty commit ID is returned.
 func (g *gitMechanism) gitCommit(
+	promo *kargoapi.Promotion,
 	update kargoapi.GitRepoUpdate,
 	newFreight kargoapi.SimpleFreight,
 	readRef string,
 	writeBranch string,
 	creds *git.RepoCredentials,
+	user *git.User,
 ) (string, error) {
+	repo, commitMsg, branchExists, err := g.prepareCommit(
+		promo,
+		update,
+		newFreight,
+		readRef,
+		writeBranch,
+		creds,
+		user,
+	)
+	if err != nil {
+		return "", err
+	}
+	defer repo.Close()
+
+	hasDiffs, err := repo.HasDiffs()
+	if err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error checking for diffs in git repo %q",
+			update.RepoURL,
+		)
+	}
+
+	if hasDiffs {
+		if err = repo.AddAllAndCommit(commitMsg); err != nil {
+			return "", errors.Wrapf(
+				err,
+				"error committing updates to git repo %q",
+				update.RepoURL,
+			)
+		}
+		if err = repo.Push(); err != nil {
+			return "", errors.Wrapf(
+				err,
+				"error pushing updates to git repo %q",
+				update.RepoURL,
+			)
+		}
+	} else if update.PullRequest != nil && !branchExists {
+		// Nothing was pushed, so there is nothing to open a pull request for.
+		return "", nil
+	}
+
+	commitID, err := repo.LastCommitID()
+	if err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error getting last commit ID from git repo %q",
+			update.RepoURL,
+		)
+	}
+
+	return commitID, nil
+}
+
+// gitDiff prepares changes to the specified git repository (see prepareCommit)
+// exactly as gitCommit would for the update's WriteBranch, but instead of
+// committing and pushing them, returns the commit message that would have been
+// used and a unified diff of the changes.
+func (g *gitMechanism) gitDiff(
+	promo *kargoapi.Promotion,
+	update kargoapi.GitRepoUpdate,
+	newFreight kargoapi.SimpleFreight,
+	readRef string,
+	creds *git.RepoCredentials,
+	user *git.User,
+) (string, string, error) {
+	repo, commitMsg, _, err := g.prepareCommit(
+		promo,
+		update,
+		newFreight,
+		readRef,
+		update.WriteBranch,
+		creds,
+		user,
+	)
+	if err != nil {
+		return "", "", err
+	}
+	defer repo.Close()
+	diff, err := repo.AddAllAndDiff()
+	if err != nil {
+		return "", "", errors.Wrapf(
+			err,
+			"error diffing updates to git repo %q",
+			update.RepoURL,
+		)
+	}
+	return commitMsg, diff, nil
+}
+
+// prepareCommit clones the specified git repository using the provided
+// credentials (which may be nil) and authors commits as the provided user
+// (which may also be nil, in which case a default identity is used and commits
+// are not signed), checks out the specified readRef (if non-empty), applies the
+// provided update function to the cloned repository, and then checks out the
+// specified writeBranch with the resulting changes left uncommitted in the
+// working tree. It returns the cloned repository, which the caller is
+// responsible for closing, along with a commit message built from the update's
+// CommitMessageTemplate (or a default template) and annotated with trailers
+// that identify the provided Promotion. It also returns a bool indicating
+// whether writeBranch already existed in the remote repository.
+func (g *gitMechanism) prepareCommit(
+	promo *kargoapi.Promotion,
+	update kargoapi.GitRepoUpdate,
+	newFreight kargoapi.SimpleFreight,
+	readRef string,
+	writeBranch string,
+	creds *git.RepoCredentials,
+	user *git.User,
+) (repo git.Repo, commitMsg string, branchExists bool, err error) {
 	if creds == nil {
 		creds = &git.RepoCredentials{}
 	}
-	repo, err := git.Clone(update.RepoURL, *creds)
+	clonedRepo, err := git.Clone(
+		update.RepoURL,
+		*creds,
+		&git.CloneOptions{
+			User: user,
+		},
+	)
 	if err != nil {
-		return "", errors.Wrapf(err, "error cloning git repo %q", update.RepoURL)
+		return nil, "", false,
+			errors.Wrapf(err, "error cloning git repo %q", update.RepoURL)
 	}
-	defer repo.Close()
+	defer func() {
+		// The caller only becomes responsible for closing the repository if we
+		// return it successfully.
+		if err != nil {
+			clonedRepo.Close()
+		}
+	}()
+	repo = clonedRepo
 
 	// If readRef is non-empty, check out the specified commit or branch,
 	// otherwise just move using the repository's default branch as the source.
 	if readRef != "" {
 		if err = repo.Checkout(readRef); err != nil {
-			return "", errors.Wrapf(
+			return nil, "", false, errors.Wrapf(
 				err,
 				"error checking out %q from git repo",
 				readRef,
@@ -269,17 +748,28 @@ func (g *gitMechanism) gitCommit(
 			repo.HomeDir(),
 			repo.WorkingDir(),
 		); err != nil {
-			return "", err
+			return nil, "", false, err
 		}
 	}
-	commitMsg := buildCommitMessage(changes)
+	msgData := newPromotionTemplateData(promo, newFreight)
+	msgData.Changes = changes
+	if commitMsg, err = buildCommitMessage(
+		update.CommitMessageTemplate,
+		msgData,
+	); err != nil {
+		return nil, "", false, errors.Wrapf(
+			err,
+			"error building commit message for git repo %q",
+			update.RepoURL,
+		)
+	}
 
 	// Sometimes we don't write to the same branch we read from...
 	if readRef != writeBranch {
 		var tempDir string
 		tempDir, err = os.MkdirTemp("", "")
 		if err != nil {
-			return "", errors.Wrap(
+			return nil, "", false, errors.Wrap(
 				err,
 				"error creating temp directory for pending changes",
 			)
@@ -287,27 +777,46 @@ func (g *gitMechanism) gitCommit(
 		defer os.RemoveAll(tempDir)
 
 		if err = moveRepoContents(repo.WorkingDir(), tempDir); err != nil {
-			return "", errors.Wrap(
+			return nil, "", false, errors.Wrap(
 				err,
 				"error moving repository working tree to temporary location",
 			)
 		}
 
 		if err = repo.ResetHard(); err != nil {
-			return "", errors.Wrap(err, "error resetting repository working tree")
+			return nil, "", false,
+				errors.Wrap(err, "error resetting repository working tree")
 		}
 
-		var branchExists bool
 		if branchExists, err = repo.RemoteBranchExists(writeBranch); err != nil {
-			return "", errors.Wrapf(
+			return nil, "", false, errors.Wrapf(
 				err,
 				"error checking for existence of branch %q in remote repo %q",
 				writeBranch,
 				update.RepoURL,
 			)
+		} else if !branchExists && update.PullRequest != nil {
+			// A branch from which a pull request will be opened starts out as a
+			// copy of the branch the pull request targets.
+			if err = repo.Checkout(update.WriteBranch); err != nil {
+				return nil, "", false, errors.Wrapf(
+					err,
+					"error checking out branch %q from git repo %q",
+					update.WriteBranch,
+					update.RepoURL,
+				)
+			}
+			if err = repo.CreateChildBranch(writeBranch); err != nil {
+				return nil, "", false, errors.Wrapf(
+					err,
+					"error creating branch %q in repo %q",
+					writeBranch,
+					update.RepoURL,
+				)
+			}
 		} else if !branchExists {
 			if err = repo.CreateOrphanedBranch(writeBranch); err != nil {
-				return "", errors.Wrapf(
+				return nil, "", false, errors.Wrapf(
 					err,
 					"error creating branch %q in repo %q",
 					writeBranch,
@@ -316,7 +825,7 @@ func (g *gitMechanism) gitCommit(
 			}
 		} else {
 			if err = repo.Checkout(writeBranch); err != nil {
-				return "", errors.Wrapf(
+				return nil, "", false, errors.Wrapf(
 					err,
 					"error checking out branch %q from git repo %q",
 					writeBranch,
@@ -326,54 +835,19 @@ func (g *gitMechanism) gitCommit(
 		}
 
 		if err = deleteRepoContents(repo.WorkingDir()); err != nil {
-			return "",
+			return nil, "", false,
 				errors.Wrap(err, "error clearing contents from repository working tree")
 		}
 
 		if err = moveRepoContents(tempDir, repo.WorkingDir()); err != nil {
-			return "", errors.Wrap(
+			return nil, "", false, errors.Wrap(
 				err,
 				"error restoring repository working tree from temporary location",
 			)
 		}
 	}
 
-	hasDiffs, err := repo.HasDiffs()
-	if err != nil {
-		return "", errors.Wrapf(
-			err,
-			"error checking for diffs in git repo %q",
-			update.RepoURL,
-		)
-	}
-
-	if hasDiffs {
-		if err = repo.AddAllAndCommit(commitMsg); err != nil {
-			return "", errors.Wrapf(
-				err,
-				"error committing updates to git repo %q",
-				update.RepoURL,
-			)
-		}
-		if err = repo.Push(); err != nil {
-			return "", errors.Wrapf(
-				err,
-				"error pushing updates to git repo %q",
-				update.RepoURL,
-			)
-		}
-	}
-
-	commitID, err := repo.LastCommitID()
-	if err != nil {
-		return "", errors.Wrapf(
-			err,
-			"error getting last commit ID from git repo %q",
-			update.RepoURL,
-		)
-	}
-
-	return commitID, nil
+	return repo, commitMsg, branchExists, nil
 }
 
 // moveRepoContents transplants the entire contents of the source directory
@@ -414,21 +888,67 @@ func deleteRepoContents(dir string) error {
 	return nil
 }
 
-// buildCommitMessage constructs a commit message from the provided change
-// summary. If the change summary is empty, then a generic message is returned.
-// If the change summary contains only one entry, then that entry is returned as
-// the commit message. Otherwise, the change summary is formatted as a bulleted
-// list and returned as the commit message.
-func buildCommitMessage(changeSummary []string) string {
-	if len(changeSummary) == 0 { // This shouldn't really happen
-		return "Kargo applied some changes"
+const (
+	// freightTrailerKey is the key of the Git trailer that identifies the
+	// Freight that a commit was made to promote.
+	freightTrailerKey = "Kargo-Freight"
+	// stageTrailerKey is the key of the Git trailer that identifies the Stage
+	// that a commit was made to promote Freight into.
+	stageTrailerKey = "Kargo-Stage"
+	// promotionTrailerKey is the key of the Git trailer that identifies the
+	// Promotion that made a commit.
+	promotionTrailerKey = "Kargo-Promotion"
+)
+
+// defaultCommitMessageTemplate is the template used for constructing commit
+// messages when a GitRepoUpdate does not specify one. If the change summary is
+// empty, a generic message is produced. If the change summary contains only one
+// entry, then that entry is used as the commit message. Otherwise, the change
+// summary is formatted as a bulleted list.
+const defaultCommitMessageTemplate = `
+{{- if eq (len .Changes) 0 -}}
+Kargo applied some changes
+{{- else if eq (len .Changes) 1 -}}
+{{ index .Changes 0 }}
+{{- else -}}
+Kargo applied multiple changes
+
+Including:
+{{ range .Changes }}
+  * {{ . }}
+{{- end }}
+{{- end }}`
+
+// buildCommitMessage executes the provided template (or the default template,
+// if the provided one is empty) against the provided data and appends
+// trailers identifying the Freight, Stage, and Promotion to the result.
+func buildCommitMessage(
+	tmpl string,
+	data promotionTemplateData,
+) (string, error) {
+	if tmpl == "" {
+		tmpl = defaultCommitMessageTemplate
+	}
+	t, err := template.New("commit-message").Funcs(TemplateFuncs()).Parse(tmpl)
+	if err != nil {
+		return "", errors.Wrap(err, "error parsing commit message template")
 	}
-	if len(changeSummary) == 1 {
-		return changeSummary[0]
+	buf := &strings.Builder{}
+	if err = t.Execute(buf, data); err != nil {
+		return "", errors.Wrap(err, "error executing commit message template")
 	}
-	msg := "Kargo applied multiple changes\n\nIncluding:\n"
-	for _, change := range changeSummary {
-		msg = fmt.Sprintf("%s\n  * %s", msg, change)
+	msg := strings.TrimSpace(buf.String())
+	if msg == "" {
+		return "", errors.New("commit message template produced an empty message")
 	}
-	return msg
+	return fmt.Sprintf(
+		"%s\n\n%s: %s\n%s: %s\n%s: %s",
+		msg,
+		freightTrailerKey,
+		data.Freight,
+		stageTrailerKey,
+		data.Stage,
+		promotionTrailerKey,
+		data.Promotion,
+	), nil
 }
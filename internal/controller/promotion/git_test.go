@@ -10,10 +10,12 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/git"
 	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/gitprovider"
 )
 
 func TestNewGitMechanism(t *testing.T) {
@@ -39,7 +41,10 @@ func TestNewGitMechanism(t *testing.T) {
 	require.NotNil(t, gpm.doSingleUpdateFn)
 	require.NotNil(t, gpm.getReadRefFn)
 	require.NotNil(t, gpm.getCredentialsFn)
+	require.NotNil(t, gpm.getGitUserFn)
 	require.NotNil(t, gpm.gitCommitFn)
+	require.NotNil(t, gpm.gitDiffFn)
+	require.NotNil(t, gpm.getGitProviderServiceFn)
 	require.NotNil(t, gpm.applyConfigManagementFn)
 }
 
@@ -54,6 +59,7 @@ func TestGitPromote(t *testing.T) {
 		name       string
 		promoMech  *gitMechanism
 		assertions func(
+			status *kargoapi.PromotionStatus,
 			newFreightIn kargoapi.SimpleFreight,
 			newFreightOut kargoapi.SimpleFreight,
 			err error,
@@ -67,11 +73,13 @@ func TestGitPromote(t *testing.T) {
 				},
 			},
 			assertions: func(
+				status *kargoapi.PromotionStatus,
 				newFreightIn kargoapi.SimpleFreight,
 				newFreightOut kargoapi.SimpleFreight,
 				err error,
 			) {
 				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
 				require.Equal(t, newFreightIn, newFreightOut)
 			},
 		},
@@ -83,14 +91,15 @@ func TestGitPromote(t *testing.T) {
 				},
 				doSingleUpdateFn: func(
 					_ context.Context,
-					_ string,
+					_ *kargoapi.Promotion,
 					_ kargoapi.GitRepoUpdate,
 					newFreight kargoapi.SimpleFreight,
-				) (kargoapi.SimpleFreight, error) {
-					return newFreight, errors.New("something went wrong")
+				) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
+					return nil, newFreight, errors.New("something went wrong")
 				},
 			},
 			assertions: func(
+				status *kargoapi.PromotionStatus,
 				newFreightIn kargoapi.SimpleFreight,
 				newFreightOut kargoapi.SimpleFreight,
 				err error,
@@ -98,46 +107,100 @@ func TestGitPromote(t *testing.T) {
 				require.Error(t, err)
 				require.Equal(t, "something went wrong", err.Error())
 				require.Equal(t, newFreightIn, newFreightOut)
+				require.Len(t, status.Steps, 1)
+				require.Equal(t, "something went wrong", status.Steps[0].Error)
+				require.NotNil(t, status.Steps[0].FinishedAt)
 			},
 		},
 		{
-			name: "success",
+			name: "single update has not completed",
 			promoMech: &gitMechanism{
 				selectUpdatesFn: func([]kargoapi.GitRepoUpdate) []kargoapi.GitRepoUpdate {
-					return []kargoapi.GitRepoUpdate{{}}
+					return []kargoapi.GitRepoUpdate{{}, {}}
 				},
 				doSingleUpdateFn: func(
 					_ context.Context,
-					_ string,
+					promo *kargoapi.Promotion,
 					_ kargoapi.GitRepoUpdate,
 					newFreight kargoapi.SimpleFreight,
-				) (kargoapi.SimpleFreight, error) {
-					return newFreight, nil
+				) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
+					// The first update is still in progress, the second is done
+					if len(promo.Status.PullRequests) == 0 {
+						status := promo.Status.WithPhase(kargoapi.PromotionPhaseRunning)
+						status.PullRequests = []kargoapi.PullRequestInfo{{Number: 42}}
+						return status, newFreight, nil
+					}
+					return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded),
+						newFreight, nil
 				},
 			},
 			assertions: func(
+				status *kargoapi.PromotionStatus,
 				newFreightIn kargoapi.SimpleFreight,
 				newFreightOut kargoapi.SimpleFreight,
 				err error,
 			) {
 				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Len(t, status.PullRequests, 1)
 				require.Equal(t, newFreightIn, newFreightOut)
 			},
 		},
+		{
+			name: "success",
+			promoMech: &gitMechanism{
+				selectUpdatesFn: func([]kargoapi.GitRepoUpdate) []kargoapi.GitRepoUpdate {
+					return []kargoapi.GitRepoUpdate{{RepoURL: "fake-url"}}
+				},
+				doSingleUpdateFn: func(
+					_ context.Context,
+					promo *kargoapi.Promotion,
+					update kargoapi.GitRepoUpdate,
+					newFreight kargoapi.SimpleFreight,
+				) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
+					status := promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded)
+					recordStep(
+						status,
+						"fake-mechanism",
+						update.RepoURL,
+						status.Phase,
+						"fake-commit",
+						nil,
+					)
+					return status, newFreight, nil
+				},
+			},
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				newFreightIn kargoapi.SimpleFreight,
+				newFreightOut kargoapi.SimpleFreight,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Equal(t, newFreightIn, newFreightOut)
+				// The step recorded by the single update is the only one
+				require.Len(t, status.Steps, 1)
+				require.Equal(t, "fake-url", status.Steps[0].Target)
+				require.Equal(t, "fake-commit", status.Steps[0].Commit)
+				require.NotNil(t, status.Steps[0].FinishedAt)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			newFreightIn := kargoapi.SimpleFreight{}
-			newFreightOut, err := testCase.promoMech.Promote(
+			status, newFreightOut, err := testCase.promoMech.Promote(
 				context.Background(),
 				&kargoapi.Stage{
 					Spec: &kargoapi.StageSpec{
 						PromotionMechanisms: &kargoapi.PromotionMechanisms{},
 					},
 				},
+				&kargoapi.Promotion{},
 				newFreightIn,
 			)
-			testCase.assertions(newFreightIn, newFreightOut, err)
+			testCase.assertions(status, newFreightIn, newFreightOut, err)
 		})
 	}
 }
@@ -147,7 +210,9 @@ func TestGitDoSingleUpdate(t *testing.T) {
 	testCases := []struct {
 		name       string
 		promoMech  *gitMechanism
+		update     kargoapi.GitRepoUpdate
 		assertions func(
+			status *kargoapi.PromotionStatus,
 			newFreightIn kargoapi.SimpleFreight,
 			newFreightOut kargoapi.SimpleFreight,
 			err error,
@@ -164,6 +229,7 @@ func TestGitDoSingleUpdate(t *testing.T) {
 				},
 			},
 			assertions: func(
+				_ *kargoapi.PromotionStatus,
 				newFreightIn kargoapi.SimpleFreight,
 				newFreightOut kargoapi.SimpleFreight,
 				err error,
@@ -191,6 +257,38 @@ func TestGitDoSingleUpdate(t *testing.T) {
 				},
 			},
 			assertions: func(
+				_ *kargoapi.PromotionStatus,
+				newFreightIn kargoapi.SimpleFreight,
+				newFreightOut kargoapi.SimpleFreight,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+				require.Equal(t, newFreightIn, newFreightOut)
+			},
+		},
+		{
+			name: "error getting git user",
+			promoMech: &gitMechanism{
+				getReadRefFn: func(
+					kargoapi.GitRepoUpdate,
+					[]kargoapi.GitCommit,
+				) (string, int, error) {
+					return testRef, 0, nil
+				},
+				getCredentialsFn: func(
+					context.Context,
+					string,
+					string,
+				) (*git.RepoCredentials, error) {
+					return nil, nil
+				},
+				getGitUserFn: func(context.Context, string) (*git.User, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				_ *kargoapi.PromotionStatus,
 				newFreightIn kargoapi.SimpleFreight,
 				newFreightOut kargoapi.SimpleFreight,
 				err error,
@@ -200,6 +298,44 @@ func TestGitDoSingleUpdate(t *testing.T) {
 				require.Equal(t, newFreightIn, newFreightOut)
 			},
 		},
+		{
+			name: "error determining signing key ID",
+			promoMech: &gitMechanism{
+				getReadRefFn: func(
+					kargoapi.GitRepoUpdate,
+					[]kargoapi.GitCommit,
+				) (string, int, error) {
+					return testRef, 0, nil
+				},
+				getCredentialsFn: func(
+					context.Context,
+					string,
+					string,
+				) (*git.RepoCredentials, error) {
+					return nil, nil
+				},
+				getGitUserFn: func(context.Context, string) (*git.User, error) {
+					return &git.User{
+						SigningKeyType: git.SigningKeyTypeSSH,
+						SigningKey:     "not-a-key",
+					}, nil
+				},
+			},
+			assertions: func(
+				_ *kargoapi.PromotionStatus,
+				newFreightIn kargoapi.SimpleFreight,
+				newFreightOut kargoapi.SimpleFreight,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					"error determining ID of git commit signing key",
+				)
+				require.Equal(t, newFreightIn, newFreightOut)
+			},
+		},
 		{
 			name: "error committing change to repo",
 			promoMech: &gitMechanism{
@@ -216,17 +352,23 @@ func TestGitDoSingleUpdate(t *testing.T) {
 				) (*git.RepoCredentials, error) {
 					return nil, nil
 				},
+				getGitUserFn: func(context.Context, string) (*git.User, error) {
+					return nil, nil
+				},
 				gitCommitFn: func(
+					promo *kargoapi.Promotion,
 					update kargoapi.GitRepoUpdate,
 					newFreight kargoapi.SimpleFreight,
 					readRef string,
 					writeBranch string,
 					creds *git.RepoCredentials,
+					user *git.User,
 				) (string, error) {
 					return "", errors.New("something went wrong")
 				},
 			},
 			assertions: func(
+				_ *kargoapi.PromotionStatus,
 				newFreightIn kargoapi.SimpleFreight,
 				newFreightOut kargoapi.SimpleFreight,
 				err error,
@@ -252,22 +394,29 @@ func TestGitDoSingleUpdate(t *testing.T) {
 				) (*git.RepoCredentials, error) {
 					return nil, nil
 				},
+				getGitUserFn: func(context.Context, string) (*git.User, error) {
+					return nil, nil
+				},
 				gitCommitFn: func(
+					promo *kargoapi.Promotion,
 					update kargoapi.GitRepoUpdate,
 					newFreight kargoapi.SimpleFreight,
 					readRef string,
 					writeBranch string,
 					creds *git.RepoCredentials,
+					user *git.User,
 				) (string, error) {
 					return "fake-commit-id", nil
 				},
 			},
 			assertions: func(
+				status *kargoapi.PromotionStatus,
 				newFreightIn kargoapi.SimpleFreight,
 				newFreightOut kargoapi.SimpleFreight,
 				err error,
 			) {
 				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
 				require.Equal(
 					t,
 					"fake-commit-id",
@@ -276,6 +425,93 @@ func TestGitDoSingleUpdate(t *testing.T) {
 				// The newFreight is otherwise unaltered
 				newFreightIn.Commits[0].HealthCheckCommit = ""
 				require.Equal(t, newFreightIn, newFreightOut)
+				require.Len(t, status.Steps, 1)
+				require.Equal(t, "fake-commit-id", status.Steps[0].Commit)
+			},
+		},
+		{
+			name: "pull request opened",
+			promoMech: &gitMechanism{
+				getReadRefFn: func(
+					kargoapi.GitRepoUpdate,
+					[]kargoapi.GitCommit,
+				) (string, int, error) {
+					return testRef, 0, nil
+				},
+				getCredentialsFn: func(
+					context.Context,
+					string,
+					string,
+				) (*git.RepoCredentials, error) {
+					return nil, nil
+				},
+				getGitUserFn: func(context.Context, string) (*git.User, error) {
+					return nil, nil
+				},
+				gitCommitFn: func(
+					_ *kargoapi.Promotion,
+					_ kargoapi.GitRepoUpdate,
+					_ kargoapi.SimpleFreight,
+					_ string,
+					writeBranch string,
+					_ *git.RepoCredentials,
+					_ *git.User,
+				) (string, error) {
+					require.Equal(
+						t,
+						"kargo/promotion/fake-promo/fake-branch",
+						writeBranch,
+					)
+					return "fake-commit-id", nil
+				},
+				getGitProviderServiceFn: func(
+					kargoapi.GitRepoUpdate,
+					*git.RepoCredentials,
+				) (gitprovider.Service, error) {
+					return &fakeGitProviderService{
+						createPullRequestFn: func(
+							_ context.Context,
+							opts gitprovider.CreatePullRequestOpts,
+						) (*gitprovider.PullRequest, error) {
+							require.Equal(t, "fake-branch", opts.Base)
+							return &gitprovider.PullRequest{
+								Number: 42,
+								URL:    "fake-url",
+								State:  gitprovider.PullRequestStateOpen,
+							}, nil
+						},
+					}, nil
+				},
+			},
+			update: kargoapi.GitRepoUpdate{
+				RepoURL:     "fake-repo-url",
+				WriteBranch: "fake-branch",
+				PullRequest: &kargoapi.PullRequestPromotionMechanism{},
+			},
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				newFreightIn kargoapi.SimpleFreight,
+				newFreightOut kargoapi.SimpleFreight,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Equal(
+					t,
+					[]kargoapi.PullRequestInfo{
+						{
+							RepoURL:      "fake-repo-url",
+							SourceBranch: "kargo/promotion/fake-promo/fake-branch",
+							TargetBranch: "fake-branch",
+							Number:       42,
+							URL:          "fake-url",
+							State:        kargoapi.PullRequestStateOpen,
+						},
+					},
+					status.PullRequests,
+				)
+				// The health check commit is not known until the PR is merged
+				require.Equal(t, newFreightIn, newFreightOut)
 			},
 		},
 	}
@@ -284,17 +520,499 @@ func TestGitDoSingleUpdate(t *testing.T) {
 			newFreightIn := kargoapi.SimpleFreight{
 				Commits: []kargoapi.GitCommit{{}},
 			}
-			newFreightOut, err := testCase.promoMech.doSingleUpdate(
+			status, newFreightOut, err := testCase.promoMech.doSingleUpdate(
 				context.Background(),
-				"fake-namespace",
-				kargoapi.GitRepoUpdate{},
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-promo",
+						Namespace: "fake-namespace",
+					},
+					Spec: &kargoapi.PromotionSpec{},
+				},
+				testCase.update,
 				newFreightIn,
 			)
-			testCase.assertions(newFreightIn, newFreightOut, err)
+			testCase.assertions(status, newFreightIn, newFreightOut, err)
+		})
+	}
+}
+
+func TestGitDoSingleUpdatePreview(t *testing.T) {
+	testCases := []struct {
+		name       string
+		promoMech  *gitMechanism
+		assertions func(*kargoapi.PromotionStatus, *Preview, error)
+	}{
+		{
+			name: "error diffing changes",
+			promoMech: &gitMechanism{
+				gitDiffFn: func(
+					*kargoapi.Promotion,
+					kargoapi.GitRepoUpdate,
+					kargoapi.SimpleFreight,
+					string,
+					*git.RepoCredentials,
+					*git.User,
+				) (string, string, error) {
+					return "", "", errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ *kargoapi.PromotionStatus, _ *Preview, err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "success",
+			promoMech: &gitMechanism{
+				gitDiffFn: func(
+					*kargoapi.Promotion,
+					kargoapi.GitRepoUpdate,
+					kargoapi.SimpleFreight,
+					string,
+					*git.RepoCredentials,
+					*git.User,
+				) (string, string, error) {
+					return "fake-message", "fake-diff", nil
+				},
+			},
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				preview *Preview,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Equal(
+					t,
+					[]GitRepoDiff{
+						{
+							RepoURL:       "fake-repo-url",
+							Branch:        "fake-branch",
+							CommitMessage: "fake-message",
+							Diff:          "fake-diff",
+						},
+					},
+					preview.GitRepoDiffs,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.promoMech.getReadRefFn = func(
+				kargoapi.GitRepoUpdate,
+				[]kargoapi.GitCommit,
+			) (string, int, error) {
+				return "fake-ref", 0, nil
+			}
+			testCase.promoMech.getCredentialsFn = func(
+				context.Context,
+				string,
+				string,
+			) (*git.RepoCredentials, error) {
+				return nil, nil
+			}
+			testCase.promoMech.getGitUserFn = func(
+				context.Context,
+				string,
+			) (*git.User, error) {
+				return nil, nil
+			}
+			testCase.promoMech.gitCommitFn = func(
+				*kargoapi.Promotion,
+				kargoapi.GitRepoUpdate,
+				kargoapi.SimpleFreight,
+				string,
+				string,
+				*git.RepoCredentials,
+				*git.User,
+			) (string, error) {
+				require.Fail(t, "changes should not be committed when previewing")
+				return "", nil
+			}
+			preview := &Preview{}
+			status, _, err := testCase.promoMech.doSingleUpdate(
+				ContextWithPreview(context.Background(), preview),
+				&kargoapi.Promotion{},
+				kargoapi.GitRepoUpdate{
+					RepoURL:     "fake-repo-url",
+					WriteBranch: "fake-branch",
+					// Pull requests are never opened when previewing
+					PullRequest: &kargoapi.PullRequestPromotionMechanism{},
+				},
+				kargoapi.SimpleFreight{
+					Commits: []kargoapi.GitCommit{{}},
+				},
+			)
+			testCase.assertions(status, preview, err)
 		})
 	}
 }
 
+func TestGitDoPullRequestUpdate(t *testing.T) {
+	testUpdate := kargoapi.GitRepoUpdate{
+		RepoURL:     "fake-repo-url",
+		WriteBranch: "fake-branch",
+		PullRequest: &kargoapi.PullRequestPromotionMechanism{},
+	}
+	testOpenPR := kargoapi.PullRequestInfo{
+		RepoURL:      "fake-repo-url",
+		SourceBranch: "kargo/promotion/fake-promo/fake-branch",
+		TargetBranch: "fake-branch",
+		Number:       42,
+		URL:          "fake-url",
+		State:        kargoapi.PullRequestStateOpen,
+	}
+	testCases := []struct {
+		name       string
+		promoMech  *gitMechanism
+		promo      *kargoapi.Promotion
+		assertions func(
+			status *kargoapi.PromotionStatus,
+			newFreight kargoapi.SimpleFreight,
+			err error,
+		)
+	}{
+		{
+			name: "error getting Git provider service",
+			promoMech: &gitMechanism{
+				getGitProviderServiceFn: func(
+					kargoapi.GitRepoUpdate,
+					*git.RepoCredentials,
+				) (gitprovider.Service, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			promo: &kargoapi.Promotion{},
+			assertions: func(
+				_ *kargoapi.PromotionStatus,
+				_ kargoapi.SimpleFreight,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error getting Git provider service")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "no changes to propose",
+			promoMech: &gitMechanism{
+				gitCommitFn: func(
+					*kargoapi.Promotion,
+					kargoapi.GitRepoUpdate,
+					kargoapi.SimpleFreight,
+					string,
+					string,
+					*git.RepoCredentials,
+					*git.User,
+				) (string, error) {
+					return "", nil
+				},
+				getGitProviderServiceFn: func(
+					kargoapi.GitRepoUpdate,
+					*git.RepoCredentials,
+				) (gitprovider.Service, error) {
+					return &fakeGitProviderService{}, nil
+				},
+			},
+			promo: &kargoapi.Promotion{},
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				_ kargoapi.SimpleFreight,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Empty(t, status.PullRequests)
+				// The reason no pull request was opened should be visible
+				require.Len(t, status.Steps, 1)
+				require.Equal(t, "fake-repo-url", status.Steps[0].Target)
+				require.NotNil(t, status.Steps[0].FinishedAt)
+				require.Empty(t, status.Steps[0].Commit)
+				require.Equal(
+					t,
+					`no changes to propose to branch "fake-branch"; `+
+						"no pull request was opened",
+					status.Steps[0].Message,
+				)
+			},
+		},
+		{
+			name: "error opening pull request",
+			promoMech: &gitMechanism{
+				gitCommitFn: func(
+					*kargoapi.Promotion,
+					kargoapi.GitRepoUpdate,
+					kargoapi.SimpleFreight,
+					string,
+					string,
+					*git.RepoCredentials,
+					*git.User,
+				) (string, error) {
+					return "fake-commit-id", nil
+				},
+				getGitProviderServiceFn: func(
+					kargoapi.GitRepoUpdate,
+					*git.RepoCredentials,
+				) (gitprovider.Service, error) {
+					return &fakeGitProviderService{
+						createPullRequestFn: func(
+							context.Context,
+							gitprovider.CreatePullRequestOpts,
+						) (*gitprovider.PullRequest, error) {
+							return nil, errors.New("something went wrong")
+						},
+					}, nil
+				},
+			},
+			promo: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-promo"},
+				Spec:       &kargoapi.PromotionSpec{},
+			},
+			assertions: func(
+				_ *kargoapi.PromotionStatus,
+				_ kargoapi.SimpleFreight,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error opening pull request")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "error getting pull request",
+			promoMech: &gitMechanism{
+				getGitProviderServiceFn: func(
+					kargoapi.GitRepoUpdate,
+					*git.RepoCredentials,
+				) (gitprovider.Service, error) {
+					return &fakeGitProviderService{
+						getPullRequestFn: func(
+							context.Context,
+							int64,
+						) (*gitprovider.PullRequest, error) {
+							return nil, errors.New("something went wrong")
+						},
+					}, nil
+				},
+			},
+			promo: &kargoapi.Promotion{
+				Status: kargoapi.PromotionStatus{
+					PullRequests: []kargoapi.PullRequestInfo{testOpenPR},
+				},
+			},
+			assertions: func(
+				_ *kargoapi.PromotionStatus,
+				_ kargoapi.SimpleFreight,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error getting pull request")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "pull request still open",
+			promoMech: &gitMechanism{
+				getGitProviderServiceFn: func(
+					kargoapi.GitRepoUpdate,
+					*git.RepoCredentials,
+				) (gitprovider.Service, error) {
+					return &fakeGitProviderService{
+						getPullRequestFn: func(
+							_ context.Context,
+							number int64,
+						) (*gitprovider.PullRequest, error) {
+							require.Equal(t, int64(42), number)
+							return &gitprovider.PullRequest{
+								Number: 42,
+								URL:    "fake-url",
+								State:  gitprovider.PullRequestStateOpen,
+							}, nil
+						},
+					}, nil
+				},
+			},
+			promo: &kargoapi.Promotion{
+				Status: kargoapi.PromotionStatus{
+					PullRequests: []kargoapi.PullRequestInfo{testOpenPR},
+				},
+			},
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				newFreight kargoapi.SimpleFreight,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Equal(
+					t,
+					[]kargoapi.PullRequestInfo{testOpenPR},
+					status.PullRequests,
+				)
+				require.Empty(t, newFreight.Commits[0].HealthCheckCommit)
+				require.Len(t, status.Steps, 1)
+				require.Nil(t, status.Steps[0].FinishedAt)
+			},
+		},
+		{
+			name: "pull request merged",
+			promoMech: &gitMechanism{
+				getGitProviderServiceFn: func(
+					kargoapi.GitRepoUpdate,
+					*git.RepoCredentials,
+				) (gitprovider.Service, error) {
+					return &fakeGitProviderService{
+						getPullRequestFn: func(
+							context.Context,
+							int64,
+						) (*gitprovider.PullRequest, error) {
+							return &gitprovider.PullRequest{
+								Number:      42,
+								URL:         "fake-url",
+								State:       gitprovider.PullRequestStateMerged,
+								MergeCommit: "fake-merge-commit",
+							}, nil
+						},
+					}, nil
+				},
+			},
+			promo: &kargoapi.Promotion{
+				Status: kargoapi.PromotionStatus{
+					PullRequests: []kargoapi.PullRequestInfo{testOpenPR},
+				},
+			},
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				newFreight kargoapi.SimpleFreight,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Len(t, status.PullRequests, 1)
+				require.Equal(
+					t,
+					kargoapi.PullRequestStateMerged,
+					status.PullRequests[0].State,
+				)
+				require.Equal(
+					t,
+					"fake-merge-commit",
+					status.PullRequests[0].MergeCommit,
+				)
+				require.Equal(
+					t,
+					"fake-merge-commit",
+					newFreight.Commits[0].HealthCheckCommit,
+				)
+				require.Len(t, status.Steps, 1)
+				require.Equal(t, "fake-merge-commit", status.Steps[0].Commit)
+				require.NotNil(t, status.Steps[0].FinishedAt)
+			},
+		},
+		{
+			name: "pull request closed",
+			promoMech: &gitMechanism{
+				getGitProviderServiceFn: func(
+					kargoapi.GitRepoUpdate,
+					*git.RepoCredentials,
+				) (gitprovider.Service, error) {
+					return &fakeGitProviderService{
+						getPullRequestFn: func(
+							context.Context,
+							int64,
+						) (*gitprovider.PullRequest, error) {
+							return &gitprovider.PullRequest{
+								Number: 42,
+								URL:    "fake-url",
+								State:  gitprovider.PullRequestStateClosed,
+							}, nil
+						},
+					}, nil
+				},
+			},
+			promo: &kargoapi.Promotion{
+				Status: kargoapi.PromotionStatus{
+					PullRequests: []kargoapi.PullRequestInfo{testOpenPR},
+				},
+			},
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				_ kargoapi.SimpleFreight,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "closed without being merged")
+				// The closed pull request is still recorded
+				require.Equal(
+					t,
+					kargoapi.PullRequestStateClosed,
+					status.PullRequests[0].State,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status, newFreight, err := testCase.promoMech.doPullRequestUpdate(
+				context.Background(),
+				testCase.promo,
+				testUpdate,
+				kargoapi.SimpleFreight{
+					Commits: []kargoapi.GitCommit{{}},
+				},
+				"fake-ref",
+				0,
+				nil,
+				nil,
+				"",
+			)
+			testCase.assertions(status, newFreight, err)
+		})
+	}
+}
+
+// fakeGitProviderService is a fake implementation of the gitprovider.Service
+// interface used for testing.
+type fakeGitProviderService struct {
+	createPullRequestFn func(
+		context.Context,
+		gitprovider.CreatePullRequestOpts,
+	) (*gitprovider.PullRequest, error)
+	getPullRequestFn func(
+		context.Context,
+		int64,
+	) (*gitprovider.PullRequest, error)
+}
+
+func (f *fakeGitProviderService) CreatePullRequest(
+	ctx context.Context,
+	opts gitprovider.CreatePullRequestOpts,
+) (*gitprovider.PullRequest, error) {
+	return f.createPullRequestFn(ctx, opts)
+}
+
+func (f *fakeGitProviderService) GetPullRequest(
+	ctx context.Context,
+	number int64,
+) (*gitprovider.PullRequest, error) {
+	return f.getPullRequestFn(ctx, number)
+}
+
+func TestGetPullRequestBranch(t *testing.T) {
+	require.Equal(
+		t,
+		"kargo/promotion/fake-promo/fake-branch",
+		getPullRequestBranch("fake-promo", 1, "fake-branch"),
+	)
+	require.Equal(
+		t,
+		"kargo/promotion/fake-promo_attempt-2/fake-branch",
+		getPullRequestBranch("fake-promo", 2, "fake-branch"),
+	)
+}
+
 func TestGetReadRef(t *testing.T) {
 	const testBranch = "fake-branch"
 	testCases := []struct {
@@ -447,6 +1165,86 @@ func TestGetRepoCredentials(t *testing.T) {
 	}
 }
 
+func TestGetGitUser(t *testing.T) {
+	testCases := []struct {
+		name          string
+		credentialsDB credentials.Database
+		assertions    func(*git.User, error)
+	}{
+		{
+			name: "error getting git user from database",
+			credentialsDB: &credentials.FakeDB{
+				GetGitUserFn: func(
+					context.Context,
+					string,
+				) (credentials.GitUser, bool, error) {
+					return credentials.GitUser{},
+						false, errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ *git.User, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error obtaining git user")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "no git user found in database",
+			credentialsDB: &credentials.FakeDB{
+				GetGitUserFn: func(
+					context.Context,
+					string,
+				) (credentials.GitUser, bool, error) {
+					return credentials.GitUser{}, false, nil
+				},
+			},
+			assertions: func(user *git.User, err error) {
+				require.NoError(t, err)
+				require.Nil(t, user)
+			},
+		},
+		{
+			name: "git user found in database",
+			credentialsDB: &credentials.FakeDB{
+				GetGitUserFn: func(
+					context.Context,
+					string,
+				) (credentials.GitUser, bool, error) {
+					return credentials.GitUser{
+						Name:           "fake-name",
+						Email:          "fake-email",
+						SigningKeyType: "ssh",
+						SigningKey:     "fake-key",
+					}, true, nil
+				},
+			},
+			assertions: func(user *git.User, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&git.User{
+						Name:           "fake-name",
+						Email:          "fake-email",
+						SigningKeyType: git.SigningKeyTypeSSH,
+						SigningKey:     "fake-key",
+					},
+					user,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				getGitUserFn(testCase.credentialsDB)(
+					context.Background(),
+					"fake-namespace",
+				),
+			)
+		})
+	}
+}
+
 func TestMoveRepoContents(t *testing.T) {
 	const subdirCount = 50
 	const fileCount = 50
@@ -504,18 +1302,30 @@ func TestDeleteRepoContents(t *testing.T) {
 }
 
 func TestBuildCommitMessage(t *testing.T) {
+	trailers := []string{
+		"",
+		"Kargo-Freight: fake-freight",
+		"Kargo-Stage: fake-stage",
+		"Kargo-Promotion: fake-promo",
+	}
 	testCases := []struct {
 		name          string
+		template      string
 		changeSummary []string
-		assertions    func(msg string)
+		assertions    func(msg string, err error)
 	}{
 		{
 			// This shouldn't really happen, but we're careful to handle it anyway,
 			// so we might as well test it.
 			name:          "nil change summary",
 			changeSummary: nil,
-			assertions: func(msg string) {
-				require.Equal(t, "Kargo applied some changes", msg)
+			assertions: func(msg string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					append([]string{"Kargo applied some changes"}, trailers...),
+					strings.Split(msg, "\n"),
+				)
 			},
 		},
 		{
@@ -523,8 +1333,13 @@ func TestBuildCommitMessage(t *testing.T) {
 			// so we might as well test it.
 			name:          "empty change summary",
 			changeSummary: []string{},
-			assertions: func(msg string) {
-				require.Equal(t, "Kargo applied some changes", msg)
+			assertions: func(msg string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					append([]string{"Kargo applied some changes"}, trailers...),
+					strings.Split(msg, "\n"),
+				)
 			},
 		},
 		{
@@ -532,8 +1347,13 @@ func TestBuildCommitMessage(t *testing.T) {
 			changeSummary: []string{
 				"fake-change",
 			},
-			assertions: func(msg string) {
-				require.Equal(t, "fake-change", msg)
+			assertions: func(msg string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					append([]string{"fake-change"}, trailers...),
+					strings.Split(msg, "\n"),
+				)
 			},
 		},
 		{
@@ -542,17 +1362,70 @@ func TestBuildCommitMessage(t *testing.T) {
 				"fake-change",
 				"another-fake-change",
 			},
-			assertions: func(msg string) {
+			assertions: func(msg string, err error) {
+				require.NoError(t, err)
 				require.Equal(
 					t,
-					[]string{
-						"Kargo applied multiple changes",
-						"",
-						"Including:",
-						"",
-						"  * fake-change",
-						"  * another-fake-change",
-					},
+					append(
+						[]string{
+							"Kargo applied multiple changes",
+							"",
+							"Including:",
+							"",
+							"  * fake-change",
+							"  * another-fake-change",
+						},
+						trailers...,
+					),
+					strings.Split(msg, "\n"),
+				)
+			},
+		},
+		{
+			name:     "invalid template",
+			template: "{{ .Stage ",
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing commit message template")
+			},
+		},
+		{
+			name:     "error executing template",
+			template: "{{ .Bogus }}",
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					"error executing commit message template",
+				)
+			},
+		},
+		{
+			name:     "template produces empty message",
+			template: "  ",
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "produced an empty message")
+			},
+		},
+		{
+			name: "custom template",
+			template: "Promote {{ .Freight }} to {{ .Stage }}\n\n" +
+				"{{ range .Commits }}{{ .ID }} by {{ .Author }}: {{ .Message }}{{ end }}",
+			changeSummary: []string{"fake-change"},
+			assertions: func(msg string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					append(
+						[]string{
+							"Promote fake-freight to fake-stage",
+							"",
+							"fake-commit-id by fake-author: fake-message",
+						},
+						trailers...,
+					),
 					strings.Split(msg, "\n"),
 				)
 			},
@@ -560,7 +1433,24 @@ func TestBuildCommitMessage(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			testCase.assertions(buildCommitMessage(testCase.changeSummary))
+			testCase.assertions(
+				buildCommitMessage(
+					testCase.template,
+					promotionTemplateData{
+						Stage:     "fake-stage",
+						Promotion: "fake-promo",
+						Freight:   "fake-freight",
+						Commits: []kargoapi.GitCommit{
+							{
+								ID:      "fake-commit-id",
+								Author:  "fake-author",
+								Message: "fake-message",
+							},
+						},
+						Changes: testCase.changeSummary,
+					},
+				),
+			)
 		})
 	}
 }
@@ -122,40 +122,36 @@ func buildValuesFilesChanges(
 	images []kargoapi.Image,
 	imageUpdates []kargoapi.HelmImageUpdate,
 ) (map[string]map[string]string, []string) {
-	tagsByImage := map[string]string{}
+	imagesByRepo := make(map[string]kargoapi.Image, len(images))
 	for _, image := range images {
-		tagsByImage[image.RepoURL] = image.Tag
+		imagesByRepo[image.RepoURL] = image
 	}
 
 	changesByFile := make(map[string]map[string]string, len(imageUpdates))
 	changeSummary := make([]string, 0, len(imageUpdates))
 	for _, imageUpdate := range imageUpdates {
-		if imageUpdate.Value != kargoapi.ImageUpdateValueTypeImage &&
-			imageUpdate.Value != kargoapi.ImageUpdateValueTypeTag {
-			// This really shouldn't happen, so we'll ignore it.
-			continue
-		}
-		tag, found := tagsByImage[imageUpdate.Image]
+		image, found := imagesByRepo[imageUpdate.Image]
 		if !found {
 			// There's no change to make in this case.
 			continue
 		}
+		value := image.GetValue(imageUpdate.Value)
+		if value == "" {
+			// Either the value type is unrecognized, which really shouldn't
+			// happen, or the Freight doesn't record a digest for this image.
+			// Either way, there's no change to make in this case.
+			continue
+		}
 		if _, found = changesByFile[imageUpdate.ValuesFilePath]; !found {
 			changesByFile[imageUpdate.ValuesFilePath] = map[string]string{}
 		}
-		if imageUpdate.Value == kargoapi.ImageUpdateValueTypeImage {
-			changesByFile[imageUpdate.ValuesFilePath][imageUpdate.Key] =
-				fmt.Sprintf("%s:%s", imageUpdate.Image, tag)
-		} else {
-			changesByFile[imageUpdate.ValuesFilePath][imageUpdate.Key] = tag
-		}
+		changesByFile[imageUpdate.ValuesFilePath][imageUpdate.Key] = value
 		changeSummary = append(
 			changeSummary,
 			fmt.Sprintf(
-				"updated %s to use image %s:%s",
+				"updated %s to use image %s",
 				imageUpdate.ValuesFilePath,
-				imageUpdate.Image,
-				tag,
+				imageReference(image, imageUpdate.Value),
 			),
 		)
 	}
@@ -163,6 +159,20 @@ func buildValuesFilesChanges(
 	return changesByFile, changeSummary
 }
 
+// imageReference returns a human-readable reference to the provided image,
+// which identifies the image by digest when the provided value type is digest
+// based and by tag otherwise.
+func imageReference(
+	image kargoapi.Image,
+	valueType kargoapi.ImageUpdateValueType,
+) string {
+	if valueType == kargoapi.ImageUpdateValueTypeDigest ||
+		valueType == kargoapi.ImageUpdateValueTypeImageAndDigest {
+		return fmt.Sprintf("%s@%s", image.RepoURL, image.Digest)
+	}
+	return fmt.Sprintf("%s:%s", image.RepoURL, image.Tag)
+}
+
 // buildChartDependencyChanges takes a list of charts and a list of instructions
 // about changes that should be made to various Chart.yaml files and distills
 // them into a map of maps that indexes new values for each Chart.yaml file by
@@ -0,0 +1,219 @@
+package promotion
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/helm"
+	libYAML "github.com/akuity/kargo/internal/yaml"
+)
+
+// renderedManifestsFile is the name of the file to which manifests rendered
+// using `helm template` are written.
+const renderedManifestsFile = "all.yaml"
+
+// newHelmTemplateMechanism returns a gitMechanism that only only selects and
+// performs updates that involve rendering Helm charts to plain manifests.
+func newHelmTemplateMechanism(
+	credentialsDB credentials.Database,
+) Mechanism {
+	return newGitMechanism(
+		"Helm template promotion mechanism",
+		credentialsDB,
+		selectHelmTemplateUpdates,
+		(&helmTemplater{
+			buildChartDependencyChangesFn: buildChartDependencyChanges,
+			setStringsInYAMLFileFn:        libYAML.SetStringsInFile,
+			renderChartFn:                 helm.Template,
+			writeManifestsFn:              writeRenderedManifests,
+		}).apply,
+	)
+}
+
+// selectHelmTemplateUpdates returns a subset of the given updates that involve
+// rendering Helm charts to plain manifests.
+func selectHelmTemplateUpdates(
+	updates []kargoapi.GitRepoUpdate,
+) []kargoapi.GitRepoUpdate {
+	selectedUpdates := make([]kargoapi.GitRepoUpdate, 0, len(updates))
+	for _, update := range updates {
+		if update.HelmTemplate != nil {
+			selectedUpdates = append(selectedUpdates, update)
+		}
+	}
+	return selectedUpdates
+}
+
+// helmTemplater is a helper struct whose sole purpose is to close over several
+// other functions that are used in the implementation of the apply() function.
+type helmTemplater struct {
+	buildChartDependencyChangesFn func(
+		string,
+		[]kargoapi.Chart,
+		[]kargoapi.HelmChartDependencyUpdate,
+	) (map[string]map[string]string, []string, error)
+	setStringsInYAMLFileFn func(file string, changes map[string]string) error
+	renderChartFn          func(
+		homeDir string,
+		chartPath string,
+		opts helm.TemplateOptions,
+	) ([]byte, error)
+	writeManifestsFn func(workingDir, outputPath string, manifests []byte) error
+}
+
+// apply uses `helm template` to render the chart specified by the provided
+// update and replaces the contents of the specified working directory with the
+// rendered manifests.
+func (h *helmTemplater) apply(
+	update kargoapi.GitRepoUpdate,
+	newFreight kargoapi.SimpleFreight,
+	homeDir string,
+	workingDir string,
+) ([]string, error) {
+	promoMech := update.HelmTemplate
+
+	// Chart dependency updates
+	chartUpdates := make([]kargoapi.HelmChartDependencyUpdate, len(newFreight.Charts))
+	for i, chart := range newFreight.Charts {
+		chartUpdates[i] = kargoapi.HelmChartDependencyUpdate{
+			RegistryURL: chart.RegistryURL,
+			Name:        chart.Name,
+			ChartPath:   promoMech.ChartPath,
+		}
+	}
+	changesByChart, changeSummary, err := h.buildChartDependencyChangesFn(
+		workingDir,
+		newFreight.Charts,
+		chartUpdates,
+	)
+	if err != nil {
+		return nil, errors.Wrap(
+			err,
+			"error preparing changes to affected Chart.yaml files",
+		)
+	}
+	for chart, changes := range changesByChart {
+		chartYAMLPath := filepath.Join(workingDir, chart, "Chart.yaml")
+		if err = h.setStringsInYAMLFileFn(chartYAMLPath, changes); err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error updating dependencies for chart %q",
+				chart,
+			)
+		}
+	}
+
+	// Image updates
+	setValues, imageChangeSummary :=
+		buildHelmTemplateSetValues(newFreight.Images, promoMech.Images)
+	changeSummary = append(changeSummary, imageChangeSummary...)
+
+	valuesFiles := make([]string, len(promoMech.ValuesFiles))
+	for i, valuesFile := range promoMech.ValuesFiles {
+		valuesFiles[i] = filepath.Join(workingDir, valuesFile)
+	}
+	manifests, err := h.renderChartFn(
+		homeDir,
+		filepath.Join(workingDir, promoMech.ChartPath),
+		helm.TemplateOptions{
+			ReleaseName: promoMech.ReleaseName,
+			Namespace:   promoMech.Namespace,
+			ValuesFiles: valuesFiles,
+			SetValues:   setValues,
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error rendering chart %q",
+			promoMech.ChartPath,
+		)
+	}
+
+	if err = h.writeManifestsFn(
+		workingDir,
+		promoMech.OutputPath,
+		manifests,
+	); err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error writing manifests rendered from chart %q",
+			promoMech.ChartPath,
+		)
+	}
+
+	return append(
+		changeSummary,
+		fmt.Sprintf(
+			"rendered chart %s to %s",
+			promoMech.ChartPath,
+			filepath.Join(promoMech.OutputPath, renderedManifestsFile),
+		),
+	), nil
+}
+
+// buildHelmTemplateSetValues takes a list of images and a list of instructions
+// about how they should be incorporated into the values used when rendering a
+// chart and distills them into a map of values indexed by key.
+func buildHelmTemplateSetValues(
+	images []kargoapi.Image,
+	imageUpdates []kargoapi.HelmTemplateImageUpdate,
+) (map[string]string, []string) {
+	imagesByRepo := make(map[string]kargoapi.Image, len(images))
+	for _, image := range images {
+		imagesByRepo[image.RepoURL] = image
+	}
+
+	setValues := make(map[string]string, len(imageUpdates))
+	changeSummary := make([]string, 0, len(imageUpdates))
+	for _, imageUpdate := range imageUpdates {
+		image, found := imagesByRepo[imageUpdate.Image]
+		if !found {
+			// There's no change to make in this case.
+			continue
+		}
+		value := image.GetValue(imageUpdate.Value)
+		if value == "" {
+			// There's no change to make in this case.
+			continue
+		}
+		setValues[imageUpdate.Key] = value
+		changeSummary = append(
+			changeSummary,
+			fmt.Sprintf(
+				"rendered using image %s",
+				imageReference(image, imageUpdate.Value),
+			),
+		)
+	}
+
+	return setValues, changeSummary
+}
+
+// writeRenderedManifests replaces the entire contents of the specified working
+// directory (EXCEPT for the .git subdirectory) with the provided manifests,
+// which are written to a single file in the specified output path.
+func writeRenderedManifests(
+	workingDir string,
+	outputPath string,
+	manifests []byte,
+) error {
+	if err := deleteRepoContents(workingDir); err != nil {
+		return errors.Wrap(err, "error clearing contents from working directory")
+	}
+	outputDir := filepath.Join(workingDir, outputPath)
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return errors.Wrapf(err, "error creating directory %q", outputDir)
+	}
+	outputFile := filepath.Join(outputDir, renderedManifestsFile)
+	return errors.Wrapf(
+		os.WriteFile(outputFile, manifests, 0600),
+		"error writing rendered manifests to %q",
+		outputFile,
+	)
+}
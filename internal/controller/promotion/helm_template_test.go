@@ -0,0 +1,355 @@
+package promotion
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/helm"
+)
+
+func TestNewHelmTemplateMechanism(t *testing.T) {
+	pm := newHelmTemplateMechanism(&credentials.FakeDB{})
+	hpm, ok := pm.(*gitMechanism)
+	require.True(t, ok)
+	require.NotNil(t, hpm.selectUpdatesFn)
+	require.NotNil(t, hpm.applyConfigManagementFn)
+}
+
+func TestSelectHelmTemplateUpdates(t *testing.T) {
+	testCases := []struct {
+		name       string
+		updates    []kargoapi.GitRepoUpdate
+		assertions func(selectedUpdates []kargoapi.GitRepoUpdate)
+	}{
+		{
+			name: "no updates",
+			assertions: func(selectedUpdates []kargoapi.GitRepoUpdate) {
+				require.Empty(t, selectedUpdates)
+			},
+		},
+		{
+			name: "no helm template updates",
+			updates: []kargoapi.GitRepoUpdate{
+				{
+					RepoURL: "fake-url",
+					Helm:    &kargoapi.HelmPromotionMechanism{},
+				},
+			},
+			assertions: func(selectedUpdates []kargoapi.GitRepoUpdate) {
+				require.Empty(t, selectedUpdates)
+			},
+		},
+		{
+			name: "some helm template updates",
+			updates: []kargoapi.GitRepoUpdate{
+				{
+					RepoURL:   "fake-url",
+					Kustomize: &kargoapi.KustomizePromotionMechanism{},
+				},
+				{
+					RepoURL:      "fake-url",
+					HelmTemplate: &kargoapi.HelmTemplatePromotionMechanism{},
+				},
+				{
+					RepoURL: "fake-url",
+				},
+			},
+			assertions: func(selectedUpdates []kargoapi.GitRepoUpdate) {
+				require.Len(t, selectedUpdates, 1)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(selectHelmTemplateUpdates(testCase.updates))
+		})
+	}
+}
+
+func TestHelmTemplaterApply(t *testing.T) {
+	const testChartPath = "fake-chart-dir"
+	testCases := []struct {
+		name          string
+		helmTemplater *helmTemplater
+		assertions    func(changes []string, err error)
+	}{
+		{
+			name: "error building chart dependency changes",
+			helmTemplater: &helmTemplater{
+				buildChartDependencyChangesFn: func(
+					string,
+					[]kargoapi.Chart,
+					[]kargoapi.HelmChartDependencyUpdate,
+				) (map[string]map[string]string, []string, error) {
+					return nil, nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ []string, err error) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					"error preparing changes to affected Chart.yaml files",
+				)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "error updating Chart.yaml",
+			helmTemplater: &helmTemplater{
+				buildChartDependencyChangesFn: func(
+					string,
+					[]kargoapi.Chart,
+					[]kargoapi.HelmChartDependencyUpdate,
+				) (map[string]map[string]string, []string, error) {
+					return map[string]map[string]string{
+						testChartPath: {
+							"fake-key": "fake-value",
+						},
+					}, nil, nil
+				},
+				setStringsInYAMLFileFn: func(string, map[string]string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ []string, err error) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					"error updating dependencies for chart",
+				)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "error rendering chart",
+			helmTemplater: &helmTemplater{
+				buildChartDependencyChangesFn: func(
+					string,
+					[]kargoapi.Chart,
+					[]kargoapi.HelmChartDependencyUpdate,
+				) (map[string]map[string]string, []string, error) {
+					return nil, nil, nil
+				},
+				renderChartFn: func(
+					string,
+					string,
+					helm.TemplateOptions,
+				) ([]byte, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ []string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error rendering chart")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "error writing manifests",
+			helmTemplater: &helmTemplater{
+				buildChartDependencyChangesFn: func(
+					string,
+					[]kargoapi.Chart,
+					[]kargoapi.HelmChartDependencyUpdate,
+				) (map[string]map[string]string, []string, error) {
+					return nil, nil, nil
+				},
+				renderChartFn: func(
+					string,
+					string,
+					helm.TemplateOptions,
+				) ([]byte, error) {
+					return []byte("fake-manifests"), nil
+				},
+				writeManifestsFn: func(string, string, []byte) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ []string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error writing manifests")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			helmTemplater: &helmTemplater{
+				buildChartDependencyChangesFn: func(
+					string,
+					[]kargoapi.Chart,
+					[]kargoapi.HelmChartDependencyUpdate,
+				) (map[string]map[string]string, []string, error) {
+					return map[string]map[string]string{
+						testChartPath: {
+							"fake-key": "fake-value",
+						},
+					}, []string{"fake-chart-update"}, nil
+				},
+				setStringsInYAMLFileFn: func(string, map[string]string) error {
+					return nil
+				},
+				renderChartFn: func(
+					_ string,
+					chartPath string,
+					opts helm.TemplateOptions,
+				) ([]byte, error) {
+					require.Equal(t, filepath.Join("fake-dir", testChartPath), chartPath)
+					require.Equal(
+						t,
+						helm.TemplateOptions{
+							ReleaseName: "fake-release",
+							ValuesFiles: []string{
+								filepath.Join("fake-dir", "fake-values.yaml"),
+							},
+							SetValues: map[string]string{
+								"image.tag": "fake-tag",
+							},
+						},
+						opts,
+					)
+					return []byte("fake-manifests"), nil
+				},
+				writeManifestsFn: func(string, string, []byte) error {
+					return nil
+				},
+			},
+			assertions: func(changes []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"fake-chart-update",
+						"rendered using image fake-url:fake-tag",
+						"rendered chart fake-chart-dir to fake-output/all.yaml",
+					},
+					changes,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.helmTemplater.apply(
+					kargoapi.GitRepoUpdate{
+						HelmTemplate: &kargoapi.HelmTemplatePromotionMechanism{
+							ChartPath:   testChartPath,
+							ReleaseName: "fake-release",
+							ValuesFiles: []string{"fake-values.yaml"},
+							Images: []kargoapi.HelmTemplateImageUpdate{
+								{
+									Image: "fake-url",
+									Key:   "image.tag",
+									Value: kargoapi.ImageUpdateValueTypeTag,
+								},
+							},
+							OutputPath: "fake-output",
+						},
+					},
+					kargoapi.SimpleFreight{
+						Images: []kargoapi.Image{
+							{
+								RepoURL: "fake-url",
+								Tag:     "fake-tag",
+							},
+						},
+					},
+					"",
+					"fake-dir",
+				),
+			)
+		})
+	}
+}
+
+func TestBuildHelmTemplateSetValues(t *testing.T) {
+	images := []kargoapi.Image{
+		{
+			RepoURL: "fake-url",
+			Tag:     "fake-tag",
+			Digest:  "fake-digest",
+		},
+		{
+			RepoURL: "another-fake-url",
+			Tag:     "another-fake-tag",
+		},
+	}
+	imageUpdates := []kargoapi.HelmTemplateImageUpdate{
+		{
+			Image: "fake-url",
+			Key:   "fake-key",
+			Value: kargoapi.ImageUpdateValueTypeImage,
+		},
+		{
+			Image: "another-fake-url",
+			Key:   "another-fake-key",
+			Value: kargoapi.ImageUpdateValueTypeTag,
+		},
+		{
+			Image: "fake-url",
+			Key:   "digest-key",
+			Value: kargoapi.ImageUpdateValueTypeImageAndDigest,
+		},
+		{
+			Image: "another-fake-url",
+			Key:   "missing-digest-key",
+			Value: kargoapi.ImageUpdateValueTypeDigest,
+		},
+		{
+			Image: "image-that-is-not-in-list",
+			Key:   "yet-another-fake-key",
+			Value: kargoapi.ImageUpdateValueTypeTag,
+		},
+	}
+	result, changeSummary := buildHelmTemplateSetValues(images, imageUpdates)
+	require.Equal(
+		t,
+		map[string]string{
+			"fake-key":         "fake-url:fake-tag",
+			"another-fake-key": "another-fake-tag",
+			"digest-key":       "fake-url@fake-digest",
+		},
+		result,
+	)
+	require.Equal(
+		t,
+		[]string{
+			"rendered using image fake-url:fake-tag",
+			"rendered using image another-fake-url:another-fake-tag",
+			"rendered using image fake-url@fake-digest",
+		},
+		changeSummary,
+	)
+}
+
+func TestWriteRenderedManifests(t *testing.T) {
+	const subdirCount = 5
+	const fileCount = 5
+	// Create dummy repo dir
+	dir, err := createDummyRepoDir(subdirCount, fileCount)
+	defer os.RemoveAll(dir)
+	require.NoError(t, err)
+	// Write
+	err = writeRenderedManifests(dir, "fake-output", []byte("fake-manifests"))
+	require.NoError(t, err)
+	// .git should not have been deleted
+	_, err = os.Stat(filepath.Join(dir, ".git"))
+	require.NoError(t, err)
+	// Everything else should have been replaced by the output path
+	dirEntries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, dirEntries, 2)
+	manifests, err := os.ReadFile(
+		filepath.Join(dir, "fake-output", renderedManifestsFile),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "fake-manifests", string(manifests))
+}
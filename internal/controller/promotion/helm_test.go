@@ -266,6 +266,7 @@ func TestBuildValuesFilesChanges(t *testing.T) {
 		{
 			RepoURL: "fake-url",
 			Tag:     "fake-tag",
+			Digest:  "fake-digest",
 		},
 		{
 			RepoURL: "another-fake-url",
@@ -291,6 +292,24 @@ func TestBuildValuesFilesChanges(t *testing.T) {
 			Key:            "fake-key",
 			Value:          "Tag",
 		},
+		{
+			ValuesFilePath: "digest-values.yaml",
+			Image:          "fake-url",
+			Key:            "fake-key",
+			Value:          "Digest",
+		},
+		{
+			ValuesFilePath: "digest-values.yaml",
+			Image:          "fake-url",
+			Key:            "another-fake-key",
+			Value:          "ImageAndDigest",
+		},
+		{
+			ValuesFilePath: "digest-values.yaml",
+			Image:          "another-fake-url",
+			Key:            "missing-digest-key",
+			Value:          "Digest",
+		},
 		{
 			ValuesFilePath: "yet-another-fake-values.yaml",
 			Image:          "image-that-is-not-in-list",
@@ -309,6 +328,10 @@ func TestBuildValuesFilesChanges(t *testing.T) {
 			"another-fake-values.yaml": {
 				"fake-key": "fake-tag",
 			},
+			"digest-values.yaml": {
+				"fake-key":         "fake-digest",
+				"another-fake-key": "fake-url@fake-digest",
+			},
 		},
 		result,
 	)
@@ -318,6 +341,8 @@ func TestBuildValuesFilesChanges(t *testing.T) {
 			"updated fake-values.yaml to use image fake-url:fake-tag",
 			"updated fake-values.yaml to use image another-fake-url:another-fake-tag",
 			"updated another-fake-values.yaml to use image fake-url:fake-tag",
+			"updated digest-values.yaml to use image fake-url@fake-digest",
+			"updated digest-values.yaml to use image fake-url@fake-digest",
 		},
 		changeSummary,
 	)
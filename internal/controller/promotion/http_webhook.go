@@ -0,0 +1,396 @@
+package promotion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+const (
+	// defaultHTTPWebhookTimeout is the amount of time to wait for a response to
+	// a webhook's request when the webhook does not specify a timeout.
+	defaultHTTPWebhookTimeout = 30 * time.Second
+	// maxHTTPWebhookTimeout is the longest timeout a webhook may specify. It is
+	// also enforced by the webhook HTTP client as a backstop.
+	maxHTTPWebhookTimeout = 300 * time.Second
+	// maxHTTPWebhookResponseBytes is the maximum number of bytes of a response
+	// body that will be read when evaluating success criteria.
+	maxHTTPWebhookResponseBytes = 1024 * 1024
+	// maxHTTPWebhookRecordedResponseBytes is the maximum number of bytes of a
+	// response body that will be recorded in a Promotion's status.
+	maxHTTPWebhookRecordedResponseBytes = 1024
+)
+
+// httpWebhookMechanism is an implementation of the Mechanism interface that
+// makes HTTP requests to external endpoints.
+type httpWebhookMechanism struct {
+	// These behaviors are overridable for testing purposes:
+	doSingleWebhookFn func(
+		ctx context.Context,
+		namespace string,
+		webhook kargoapi.HTTPWebhook,
+		data promotionTemplateData,
+	) (int32, string, error)
+	getSecretFn func(
+		ctx context.Context,
+		key client.ObjectKey,
+		obj client.Object,
+		opts ...client.GetOption,
+	) error
+	httpDoFn func(*http.Request) (*http.Response, error)
+}
+
+// newHTTPWebhookMechanism returns an implementation of the Mechanism interface
+// that makes HTTP requests to external endpoints.
+func newHTTPWebhookMechanism(kubeClient client.Client) Mechanism {
+	h := &httpWebhookMechanism{}
+	h.doSingleWebhookFn = h.doSingleWebhook
+	h.getSecretFn = kubeClient.Get
+	h.httpDoFn = newHTTPWebhookClient().Do
+	return h
+}
+
+// newHTTPWebhookClient returns an *http.Client suitable for calling webhooks.
+// Unlike http.DefaultClient, it does not follow redirects, since a webhook
+// could otherwise be used to send requests (including any headers obtained from
+// a Secret) to a destination other than the one specified by the Stage, and it
+// enforces timeouts at the transport level in addition to the per-request
+// timeout derived from each webhook's specification.
+func newHTTPWebhookClient() *http.Client {
+	return &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+		Timeout: maxHTTPWebhookTimeout,
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			DialContext: (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ResponseHeaderTimeout: maxHTTPWebhookTimeout,
+			IdleConnTimeout:       90 * time.Second,
+			MaxIdleConns:          100,
+		},
+	}
+}
+
+// GetName implements the Mechanism interface.
+func (*httpWebhookMechanism) GetName() string {
+	return "HTTP webhook promotion mechanism"
+}
+
+// Promote implements the Mechanism interface.
+func (h *httpWebhookMechanism) Promote(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	newFreight kargoapi.SimpleFreight,
+) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
+	webhooks := stage.Spec.PromotionMechanisms.HTTPWebhooks
+
+	if len(webhooks) == 0 {
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded),
+			newFreight, nil
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	logger.Debug("executing HTTP webhook promotion mechanisms")
+
+	promo = promo.DeepCopy()
+	data := newPromotionTemplateData(promo, newFreight)
+	preview := previewFromContext(ctx)
+	for _, webhook := range webhooks {
+		resultIndex := -1
+		for i, result := range promo.Status.HTTPWebhookResults {
+			if result.Name == webhook.Name {
+				resultIndex = i
+				break
+			}
+		}
+		if resultIndex >= 0 &&
+			promo.Status.HTTPWebhookResults[resultIndex].Succeeded {
+			// Webhooks are not necessarily idempotent, so we never repeat one that
+			// has already succeeded.
+			continue
+		}
+
+		statusCode, response, err :=
+			h.doSingleWebhookFn(ctx, stage.Namespace, webhook, data)
+		if preview != nil {
+			// Nothing was actually sent, so there is no result to record.
+			if err != nil {
+				return nil, newFreight, err
+			}
+			continue
+		}
+
+		result := kargoapi.HTTPWebhookResult{Name: webhook.Name}
+		if resultIndex >= 0 {
+			result = promo.Status.HTTPWebhookResults[resultIndex]
+		}
+		result.Attempts++
+		result.StatusCode = statusCode
+		result.Response = response
+		result.Succeeded = err == nil
+		result.Message = ""
+		if err != nil {
+			result.Message = err.Error()
+		}
+		if resultIndex >= 0 {
+			promo.Status.HTTPWebhookResults[resultIndex] = result
+		} else {
+			promo.Status.HTTPWebhookResults =
+				append(promo.Status.HTTPWebhookResults, result)
+		}
+
+		if result.Succeeded {
+			recordStep(
+				&promo.Status,
+				h.GetName(),
+				webhook.Name,
+				kargoapi.PromotionPhaseSucceeded,
+				"",
+				nil,
+			)
+			continue
+		}
+		if result.Attempts > webhook.Retries {
+			err = errors.Wrapf(
+				err,
+				"webhook %q failed after %d attempt(s)",
+				webhook.Name,
+				result.Attempts,
+			)
+			recordStep(
+				&promo.Status,
+				h.GetName(),
+				webhook.Name,
+				kargoapi.PromotionPhaseErrored,
+				"",
+				err,
+			)
+			return &promo.Status, newFreight, err
+		}
+		// The step has not finished, but the error is still worth recording.
+		recordStep(
+			&promo.Status,
+			h.GetName(),
+			webhook.Name,
+			kargoapi.PromotionPhaseRunning,
+			"",
+			err,
+		)
+		logger.WithFields(log.Fields{
+			"webhook":  webhook.Name,
+			"attempts": result.Attempts,
+		}).Debugf("webhook failed; will retry: %s", err)
+		// Later webhooks may depend on the outcome of this one, so we cannot
+		// proceed until it has succeeded.
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseRunning),
+			newFreight, nil
+	}
+
+	logger.Debug("done executing HTTP webhook promotion mechanisms")
+
+	return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded),
+		newFreight, nil
+}
+
+// doSingleWebhook makes the request described by a single webhook and
+// evaluates the response against the webhook's success criteria. It returns
+// the response's status code and a (possibly truncated) copy of the response
+// body, along with an error if the request failed or the response did not
+// satisfy the success criteria.
+func (h *httpWebhookMechanism) doSingleWebhook(
+	ctx context.Context,
+	namespace string,
+	webhook kargoapi.HTTPWebhook,
+	data promotionTemplateData,
+) (int32, string, error) {
+	method := webhook.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+
+	var body []byte
+	if webhook.Body != "" {
+		var err error
+		if body, err = renderPromotionTemplate("body", webhook.Body, data); err != nil {
+			return 0, "", errors.Wrap(err, "error rendering request body")
+		}
+		if !json.Valid(body) {
+			return 0, "", errors.Errorf(
+				"rendered request body is not valid JSON; use quote or toJson to "+
+					"embed values that may contain special characters: %s",
+				body,
+			)
+		}
+	}
+
+	if preview := previewFromContext(ctx); preview != nil {
+		warning := fmt.Sprintf(
+			"webhook %q cannot be previewed; a %s request would be sent to %s",
+			webhook.Name,
+			method,
+			webhook.URL,
+		)
+		if len(body) > 0 {
+			warning = fmt.Sprintf("%s with the following body: %s", warning, body)
+		}
+		preview.Warnings = append(preview.Warnings, warning)
+		return 0, "", nil
+	}
+
+	timeout := defaultHTTPWebhookTimeout
+	if webhook.TimeoutSeconds > 0 {
+		timeout = time.Duration(webhook.TimeoutSeconds) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		method,
+		webhook.URL,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return 0, "", errors.Wrap(err, "error building request")
+	}
+	if len(body) > 0 {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for _, header := range webhook.Headers {
+		req.Header.Set(header.Name, header.Value)
+	}
+	if webhook.HeadersSecretName != "" {
+		secret := &corev1.Secret{}
+		if err = h.getSecretFn(
+			ctx,
+			client.ObjectKey{
+				Namespace: namespace,
+				Name:      webhook.HeadersSecretName,
+			},
+			secret,
+		); err != nil {
+			return 0, "", errors.Wrapf(
+				err,
+				"error getting Secret %q in namespace %q",
+				webhook.HeadersSecretName,
+				namespace,
+			)
+		}
+		if err = credentials.ValidateWebhookHeadersSecret(secret); err != nil {
+			return 0, "", err
+		}
+		for name, value := range secret.Data {
+			req.Header.Set(name, string(value))
+		}
+	}
+
+	res, err := h.httpDoFn(req)
+	if err != nil {
+		return 0, "", errors.Wrapf(err, "error sending request to %s", webhook.URL)
+	}
+	defer res.Body.Close()
+	statusCode := int32(res.StatusCode)
+	resBody, err := io.ReadAll(io.LimitReader(res.Body, maxHTTPWebhookResponseBytes))
+	if err != nil {
+		return statusCode, "", errors.Wrap(err, "error reading response body")
+	}
+	response := truncateUTF8(string(resBody), maxHTTPWebhookRecordedResponseBytes)
+
+	return statusCode, response,
+		evaluateHTTPSuccessCriteria(webhook.SuccessCriteria, statusCode, resBody)
+}
+
+// evaluateHTTPSuccessCriteria returns an error if the provided status code and
+// response body do not satisfy the provided success criteria. If no criteria
+// are provided, any 2xx status code is considered successful.
+func evaluateHTTPSuccessCriteria(
+	criteria *kargoapi.HTTPSuccessCriteria,
+	statusCode int32,
+	body []byte,
+) error {
+	if criteria == nil || len(criteria.StatusCodes) == 0 {
+		if statusCode < 200 || statusCode > 299 {
+			return errors.Errorf("received unexpected status code %d", statusCode)
+		}
+	} else {
+		var ok bool
+		for _, code := range criteria.StatusCodes {
+			if code == statusCode {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return errors.Errorf("received unexpected status code %d", statusCode)
+		}
+	}
+
+	if criteria == nil || criteria.JSONPath == "" {
+		return nil
+	}
+	jp := jsonpath.New("success")
+	if err := jp.Parse(criteria.JSONPath); err != nil {
+		return errors.Wrapf(err, "error parsing JSONPath %q", criteria.JSONPath)
+	}
+	var obj any
+	if err := json.Unmarshal(body, &obj); err != nil {
+		return errors.Wrap(err, "error parsing response body as JSON")
+	}
+	buf := &bytes.Buffer{}
+	if err := jp.Execute(buf, obj); err != nil {
+		return errors.Wrapf(
+			err,
+			"error evaluating JSONPath %q against response body",
+			criteria.JSONPath,
+		)
+	}
+	expected := criteria.Value
+	if expected == "" {
+		expected = "true"
+	}
+	if actual := strings.TrimSpace(buf.String()); actual != expected {
+		return errors.Errorf(
+			"JSONPath %q evaluated to %q; expected %q",
+			criteria.JSONPath,
+			actual,
+			expected,
+		)
+	}
+	return nil
+}
+
+// truncateUTF8 truncates the provided string to at most maxBytes bytes without
+// splitting a multi-byte UTF-8 encoded rune.
+func truncateUTF8(s string, maxBytes int) string {
+	if len(s) <= maxBytes {
+		return s
+	}
+	i := maxBytes
+	for i > 0 && !utf8.RuneStart(s[i]) {
+		i--
+	}
+	return s[:i]
+}
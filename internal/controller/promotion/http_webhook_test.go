@@ -0,0 +1,613 @@
+package promotion
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewHTTPWebhookMechanism(t *testing.T) {
+	pm := newHTTPWebhookMechanism(
+		fake.NewClientBuilder().Build(),
+	)
+	hwm, ok := pm.(*httpWebhookMechanism)
+	require.True(t, ok)
+	require.NotNil(t, hwm.doSingleWebhookFn)
+	require.NotNil(t, hwm.getSecretFn)
+	require.NotNil(t, hwm.httpDoFn)
+}
+
+func TestHTTPWebhookGetName(t *testing.T) {
+	require.NotEmpty(t, (&httpWebhookMechanism{}).GetName())
+}
+
+func TestHTTPWebhookPromote(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-stage",
+			Namespace: "fake-project",
+		},
+		Spec: &kargoapi.StageSpec{
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{
+				HTTPWebhooks: []kargoapi.HTTPWebhook{
+					{
+						Name:    "first",
+						Retries: 1,
+					},
+					{
+						Name: "second",
+					},
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		promoMech  *httpWebhookMechanism
+		stage      *kargoapi.Stage
+		status     kargoapi.PromotionStatus
+		ctx        context.Context
+		assertions func(*kargoapi.PromotionStatus, error)
+	}{
+		{
+			name:      "no webhooks",
+			promoMech: &httpWebhookMechanism{},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name: "failure with retries remaining",
+			promoMech: &httpWebhookMechanism{
+				doSingleWebhookFn: func(
+					context.Context,
+					string,
+					kargoapi.HTTPWebhook,
+					promotionTemplateData,
+				) (int32, string, error) {
+					return http.StatusBadGateway, "bad gateway", errors.New("something went wrong")
+				},
+			},
+			stage: testStage,
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Equal(
+					t,
+					[]kargoapi.HTTPWebhookResult{
+						{
+							Name:       "first",
+							Attempts:   1,
+							StatusCode: http.StatusBadGateway,
+							Response:   "bad gateway",
+							Message:    "something went wrong",
+						},
+					},
+					status.HTTPWebhookResults,
+				)
+			},
+		},
+		{
+			name: "failure with no retries remaining",
+			promoMech: &httpWebhookMechanism{
+				doSingleWebhookFn: func(
+					context.Context,
+					string,
+					kargoapi.HTTPWebhook,
+					promotionTemplateData,
+				) (int32, string, error) {
+					return http.StatusBadGateway, "", errors.New("something went wrong")
+				},
+			},
+			stage: testStage,
+			status: kargoapi.PromotionStatus{
+				HTTPWebhookResults: []kargoapi.HTTPWebhookResult{
+					{
+						Name:     "first",
+						Attempts: 1,
+					},
+				},
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), `webhook "first" failed after 2 attempt(s)`)
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Len(t, status.HTTPWebhookResults, 1)
+				require.Equal(t, int32(2), status.HTTPWebhookResults[0].Attempts)
+				require.False(t, status.HTTPWebhookResults[0].Succeeded)
+			},
+		},
+		{
+			name: "previously succeeded webhooks are skipped",
+			promoMech: &httpWebhookMechanism{
+				doSingleWebhookFn: func(
+					_ context.Context,
+					_ string,
+					webhook kargoapi.HTTPWebhook,
+					_ promotionTemplateData,
+				) (int32, string, error) {
+					require.Equal(t, "second", webhook.Name)
+					return http.StatusOK, "", nil
+				},
+			},
+			stage: testStage,
+			status: kargoapi.PromotionStatus{
+				HTTPWebhookResults: []kargoapi.HTTPWebhookResult{
+					{
+						Name:      "first",
+						Attempts:  1,
+						Succeeded: true,
+					},
+				},
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Len(t, status.HTTPWebhookResults, 2)
+				require.True(t, status.HTTPWebhookResults[1].Succeeded)
+			},
+		},
+		{
+			name: "preview",
+			promoMech: &httpWebhookMechanism{
+				doSingleWebhookFn: func(
+					context.Context,
+					string,
+					kargoapi.HTTPWebhook,
+					promotionTemplateData,
+				) (int32, string, error) {
+					return 0, "", nil
+				},
+			},
+			stage: testStage,
+			ctx:   ContextWithPreview(context.Background(), &Preview{}),
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Empty(t, status.HTTPWebhookResults)
+			},
+		},
+		{
+			name: "success",
+			promoMech: &httpWebhookMechanism{
+				doSingleWebhookFn: func(
+					_ context.Context,
+					namespace string,
+					_ kargoapi.HTTPWebhook,
+					data promotionTemplateData,
+				) (int32, string, error) {
+					require.Equal(t, "fake-project", namespace)
+					require.Equal(t, "fake-freight", data.Freight)
+					return http.StatusOK, "ok", nil
+				},
+			},
+			stage: testStage,
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Len(t, status.HTTPWebhookResults, 2)
+				for _, result := range status.HTTPWebhookResults {
+					require.True(t, result.Succeeded)
+					require.Equal(t, int32(1), result.Attempts)
+				}
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := testCase.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			status, _, err := testCase.promoMech.Promote(
+				ctx,
+				testCase.stage,
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-promotion",
+						Namespace: "fake-project",
+					},
+					Spec: &kargoapi.PromotionSpec{
+						Stage:   "fake-stage",
+						Freight: "fake-freight",
+					},
+					Status: testCase.status,
+				},
+				kargoapi.SimpleFreight{ID: "fake-freight"},
+			)
+			testCase.assertions(status, err)
+		})
+	}
+}
+
+func TestHTTPWebhookDoSingleWebhook(t *testing.T) {
+	testData := promotionTemplateData{
+		Freight: "fake-freight",
+	}
+	getSecret := func(
+		_ context.Context,
+		_ client.ObjectKey,
+		obj client.Object,
+		_ ...client.GetOption,
+	) error {
+		secret := obj.(*corev1.Secret) // nolint: forcetypeassert
+		secret.Name = "fake-secret"
+		secret.Labels = map[string]string{
+			kargoapi.LabelSecretTypeKey: kargoapi.LabelSecretTypeWebhookHeaders,
+		}
+		secret.Data = map[string][]byte{
+			"Authorization": []byte("Bearer fake-token"),
+		}
+		return nil
+	}
+	testCases := []struct {
+		name       string
+		webhook    kargoapi.HTTPWebhook
+		handler    http.HandlerFunc
+		promoMech  *httpWebhookMechanism
+		ctx        context.Context
+		data       promotionTemplateData
+		assertions func(int32, string, error)
+	}{
+		{
+			name: "error rendering body",
+			webhook: kargoapi.HTTPWebhook{
+				Body: "{{ .Bogus }}",
+			},
+			promoMech: &httpWebhookMechanism{},
+			assertions: func(_ int32, _ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error rendering request body")
+			},
+		},
+		{
+			name: "rendered body is not valid JSON",
+			webhook: kargoapi.HTTPWebhook{
+				Body: `{"message":"{{ .Freight }}"}`,
+			},
+			handler: func(http.ResponseWriter, *http.Request) {
+				require.Fail(t, "request should not have been sent")
+			},
+			promoMech: &httpWebhookMechanism{},
+			data: promotionTemplateData{
+				Freight: `a "quoted" value`,
+			},
+			assertions: func(_ int32, _ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "rendered request body is not valid JSON")
+			},
+		},
+		{
+			name: "quoted value is escaped",
+			webhook: kargoapi.HTTPWebhook{
+				Body: `{"message":{{ quote .Freight }}}`,
+			},
+			handler: func(_ http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.Equal(t, `{"message":"a \"quoted\" value"}`, string(body))
+			},
+			promoMech: &httpWebhookMechanism{},
+			data: promotionTemplateData{
+				Freight: `a "quoted" value`,
+			},
+			assertions: func(_ int32, _ string, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "preview",
+			webhook: kargoapi.HTTPWebhook{
+				Body: `{"freight":"{{ .Freight }}"}`,
+			},
+			handler: func(http.ResponseWriter, *http.Request) {
+				require.Fail(t, "request should not have been sent")
+			},
+			promoMech: &httpWebhookMechanism{},
+			ctx:       ContextWithPreview(context.Background(), &Preview{}),
+			assertions: func(_ int32, _ string, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "error getting headers Secret",
+			webhook: kargoapi.HTTPWebhook{
+				HeadersSecretName: "fake-secret",
+			},
+			promoMech: &httpWebhookMechanism{
+				getSecretFn: func(
+					context.Context,
+					client.ObjectKey,
+					client.Object,
+					...client.GetOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ int32, _ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), `error getting Secret "fake-secret"`)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "headers Secret holds credentials",
+			webhook: kargoapi.HTTPWebhook{
+				HeadersSecretName: "fake-secret",
+			},
+			handler: func(http.ResponseWriter, *http.Request) {
+				require.Fail(t, "request should not have been sent")
+			},
+			promoMech: &httpWebhookMechanism{
+				getSecretFn: func(
+					_ context.Context,
+					_ client.ObjectKey,
+					obj client.Object,
+					_ ...client.GetOption,
+				) error {
+					secret := obj.(*corev1.Secret) // nolint: forcetypeassert
+					secret.Name = "fake-secret"
+					secret.Labels = map[string]string{
+						kargoapi.LabelSecretTypeKey: "repository",
+					}
+					secret.Data = map[string][]byte{
+						"password": []byte("fake-password"),
+					}
+					return nil
+				},
+			},
+			assertions: func(_ int32, _ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "holds credentials")
+			},
+		},
+		{
+			name: "headers Secret is not labeled for webhooks",
+			webhook: kargoapi.HTTPWebhook{
+				HeadersSecretName: "fake-secret",
+			},
+			handler: func(http.ResponseWriter, *http.Request) {
+				require.Fail(t, "request should not have been sent")
+			},
+			promoMech: &httpWebhookMechanism{
+				getSecretFn: func(
+					_ context.Context,
+					_ client.ObjectKey,
+					obj client.Object,
+					_ ...client.GetOption,
+				) error {
+					obj.SetName("fake-secret")
+					return nil
+				},
+			},
+			assertions: func(_ int32, _ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "unless it is labeled")
+			},
+		},
+		{
+			name:    "unexpected status code",
+			webhook: kargoapi.HTTPWebhook{},
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte("oops"))
+			},
+			promoMech: &httpWebhookMechanism{},
+			assertions: func(statusCode int32, response string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "unexpected status code 500")
+				require.Equal(t, int32(http.StatusInternalServerError), statusCode)
+				require.Equal(t, "oops", response)
+			},
+		},
+		{
+			name:    "long response is truncated",
+			webhook: kargoapi.HTTPWebhook{},
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(strings.Repeat("a", 2*maxHTTPWebhookRecordedResponseBytes)))
+			},
+			promoMech: &httpWebhookMechanism{},
+			assertions: func(_ int32, response string, err error) {
+				require.NoError(t, err)
+				require.Len(t, response, maxHTTPWebhookRecordedResponseBytes)
+			},
+		},
+		{
+			name:    "long multi-byte response is truncated on a rune boundary",
+			webhook: kargoapi.HTTPWebhook{},
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write(
+					[]byte("a" + strings.Repeat("é", maxHTTPWebhookRecordedResponseBytes)),
+				)
+			},
+			promoMech: &httpWebhookMechanism{},
+			assertions: func(_ int32, response string, err error) {
+				require.NoError(t, err)
+				require.True(t, utf8.ValidString(response))
+				require.Len(t, response, maxHTTPWebhookRecordedResponseBytes-1)
+			},
+		},
+		{
+			name:    "redirect is not followed",
+			webhook: kargoapi.HTTPWebhook{},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/", r.URL.Path, "redirect should not have been followed")
+				http.Redirect(w, r, "/elsewhere", http.StatusFound)
+			},
+			promoMech: &httpWebhookMechanism{},
+			assertions: func(statusCode int32, _ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "unexpected status code 302")
+				require.Equal(t, int32(http.StatusFound), statusCode)
+			},
+		},
+		{
+			name: "success",
+			webhook: kargoapi.HTTPWebhook{
+				Method: http.MethodPut,
+				Headers: []kargoapi.HTTPHeader{
+					{
+						Name:  "X-Fake",
+						Value: "fake-value",
+					},
+				},
+				HeadersSecretName: "fake-secret",
+				Body:              `{"freight":"{{ .Freight }}"}`,
+				SuccessCriteria: &kargoapi.HTTPSuccessCriteria{
+					StatusCodes: []int32{http.StatusAccepted},
+					JSONPath:    "{.status}",
+					Value:       "queued",
+				},
+			},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodPut, r.Method)
+				require.Equal(t, "fake-value", r.Header.Get("X-Fake"))
+				require.Equal(t, "Bearer fake-token", r.Header.Get("Authorization"))
+				require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.Equal(t, `{"freight":"fake-freight"}`, string(body))
+				w.WriteHeader(http.StatusAccepted)
+				_, _ = w.Write([]byte(`{"status":"queued"}`))
+			},
+			promoMech: &httpWebhookMechanism{
+				getSecretFn: getSecret,
+			},
+			assertions: func(statusCode int32, response string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int32(http.StatusAccepted), statusCode)
+				require.Equal(t, `{"status":"queued"}`, response)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			handler := testCase.handler
+			if handler == nil {
+				handler = func(http.ResponseWriter, *http.Request) {}
+			}
+			srv := httptest.NewServer(handler)
+			defer srv.Close()
+			testCase.webhook.URL = srv.URL
+			testCase.promoMech.httpDoFn = newHTTPWebhookClient().Do
+			ctx := testCase.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			data := testCase.data
+			if data.Freight == "" {
+				data = testData
+			}
+			testCase.assertions(
+				testCase.promoMech.doSingleWebhook(
+					ctx,
+					"fake-project",
+					testCase.webhook,
+					data,
+				),
+			)
+		})
+	}
+}
+
+func TestEvaluateHTTPSuccessCriteria(t *testing.T) {
+	testCases := []struct {
+		name       string
+		criteria   *kargoapi.HTTPSuccessCriteria
+		statusCode int32
+		body       string
+		assertions func(error)
+	}{
+		{
+			name:       "no criteria; 2xx",
+			statusCode: http.StatusNoContent,
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:       "no criteria; non-2xx",
+			statusCode: http.StatusNotFound,
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "unexpected status code 404")
+			},
+		},
+		{
+			name: "status code not in list",
+			criteria: &kargoapi.HTTPSuccessCriteria{
+				StatusCodes: []int32{http.StatusCreated},
+			},
+			statusCode: http.StatusOK,
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "unexpected status code 200")
+			},
+		},
+		{
+			name: "body is not JSON",
+			criteria: &kargoapi.HTTPSuccessCriteria{
+				JSONPath: "{.ok}",
+			},
+			statusCode: http.StatusOK,
+			body:       "not json",
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing response body as JSON")
+			},
+		},
+		{
+			name: "JSONPath evaluates to unexpected value",
+			criteria: &kargoapi.HTTPSuccessCriteria{
+				JSONPath: "{.ok}",
+			},
+			statusCode: http.StatusOK,
+			body:       `{"ok":false}`,
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), `evaluated to "false"; expected "true"`)
+			},
+		},
+		{
+			name: "JSONPath evaluates to default value",
+			criteria: &kargoapi.HTTPSuccessCriteria{
+				JSONPath: "{.ok}",
+			},
+			statusCode: http.StatusOK,
+			body:       `{"ok":true}`,
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				evaluateHTTPSuccessCriteria(
+					testCase.criteria,
+					testCase.statusCode,
+					[]byte(testCase.body),
+				),
+			)
+		})
+	}
+}
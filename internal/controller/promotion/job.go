@@ -0,0 +1,633 @@
+package promotion
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+const (
+	// jobFreightAnnotationKey is the key of the annotation on a promotion Job's
+	// Pod template that holds a JSON representation of the Freight being
+	// promoted. The annotation is projected into the Job's containers as a file
+	// using the downward API.
+	jobFreightAnnotationKey = "kargo.akuity.io/freight"
+	// jobPromotionAnnotationKey is the key of the annotation on a promotion Job
+	// that identifies the Promotion that created it.
+	jobPromotionAnnotationKey = "kargo.akuity.io/promotion"
+
+	jobFreightVolumeName = "kargo-freight"
+	jobFreightMountPath  = "/kargo"
+	jobFreightFileName   = "freight.json"
+
+	// jobLogTailLines is the number of lines of a failed Job's logs that are
+	// included in the error describing the failure.
+	jobLogTailLines int64 = 20
+)
+
+// jobMechanism is an implementation of the Mechanism interface that runs
+// user-defined Kubernetes Jobs.
+type jobMechanism struct {
+	// These behaviors are overridable for testing purposes:
+	getJobFn func(
+		ctx context.Context,
+		namespace string,
+		name string,
+	) (*batchv1.Job, error)
+	createJobFn func(ctx context.Context, job *batchv1.Job) error
+	setJobTTLFn func(
+		ctx context.Context,
+		namespace string,
+		name string,
+		ttlSeconds int32,
+	) error
+	getJobLogsFn func(ctx context.Context, job *batchv1.Job) (string, error)
+	listPodsFn   func(
+		ctx context.Context,
+		namespace string,
+		opts metav1.ListOptions,
+	) (*corev1.PodList, error)
+	getPodLogsFn func(
+		ctx context.Context,
+		namespace string,
+		name string,
+		opts *corev1.PodLogOptions,
+	) ([]byte, error)
+}
+
+// newJobMechanism returns an implementation of the Mechanism interface that
+// runs user-defined Kubernetes Jobs.
+func newJobMechanism(kubeClientset kubernetes.Interface) Mechanism {
+	j := &jobMechanism{}
+	j.getJobFn = func(
+		ctx context.Context,
+		namespace string,
+		name string,
+	) (*batchv1.Job, error) {
+		return kubeClientset.BatchV1().Jobs(namespace).Get(
+			ctx,
+			name,
+			metav1.GetOptions{},
+		)
+	}
+	j.createJobFn = func(ctx context.Context, job *batchv1.Job) error {
+		_, err := kubeClientset.BatchV1().Jobs(job.Namespace).Create(
+			ctx,
+			job,
+			metav1.CreateOptions{},
+		)
+		return err
+	}
+	j.setJobTTLFn = func(
+		ctx context.Context,
+		namespace string,
+		name string,
+		ttlSeconds int32,
+	) error {
+		_, err := kubeClientset.BatchV1().Jobs(namespace).Patch(
+			ctx,
+			name,
+			types.MergePatchType,
+			[]byte(fmt.Sprintf(`{"spec":{"ttlSecondsAfterFinished":%d}}`, ttlSeconds)),
+			metav1.PatchOptions{},
+		)
+		return err
+	}
+	j.getJobLogsFn = j.getJobLogs
+	j.listPodsFn = func(
+		ctx context.Context,
+		namespace string,
+		opts metav1.ListOptions,
+	) (*corev1.PodList, error) {
+		return kubeClientset.CoreV1().Pods(namespace).List(ctx, opts)
+	}
+	j.getPodLogsFn = func(
+		ctx context.Context,
+		namespace string,
+		name string,
+		opts *corev1.PodLogOptions,
+	) ([]byte, error) {
+		return kubeClientset.CoreV1().Pods(namespace).GetLogs(name, opts).DoRaw(ctx)
+	}
+	return j
+}
+
+// GetName implements the Mechanism interface.
+func (*jobMechanism) GetName() string {
+	return "Job promotion mechanism"
+}
+
+// Promote implements the Mechanism interface.
+func (j *jobMechanism) Promote(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	newFreight kargoapi.SimpleFreight,
+) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
+	promoJobs := stage.Spec.PromotionMechanisms.Jobs
+
+	if len(promoJobs) == 0 {
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded),
+			newFreight, nil
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	logger.Debug("executing Job promotion mechanisms")
+
+	status := promo.Status.DeepCopy()
+	for _, promoJob := range promoJobs {
+		if j.succeededInEarlierAttempt(promo, stage.Namespace, promoJob) {
+			logger.WithField("job", promoJob.Name).
+				Debug("Job succeeded during an earlier attempt; not running it again")
+			continue
+		}
+		job, err := buildPromotionJob(stage, promo, newFreight, promoJob)
+		if err != nil {
+			recordStep(
+				status,
+				j.GetName(),
+				promoJob.Name,
+				kargoapi.PromotionPhaseErrored,
+				"",
+				err,
+			)
+			return status, newFreight, err
+		}
+		target := fmt.Sprintf("%s/%s", job.Namespace, job.Name)
+
+		// If the Job was deleted after its success was recorded, for instance
+		// because its TTL elapsed, it must not be created and run again.
+		if j.stepSucceeded(status, target) {
+			continue
+		}
+
+		// A Job that is garbage collected before its outcome has been recorded
+		// would be indistinguishable from one that was never created, so the TTL
+		// from the template is only applied once the outcome has been recorded.
+		ttlSeconds := job.Spec.TTLSecondsAfterFinished
+		job.Spec.TTLSecondsAfterFinished = nil
+
+		if preview := previewFromContext(ctx); preview != nil {
+			preview.Warnings = append(
+				preview.Warnings,
+				fmt.Sprintf(
+					"Job %q cannot be previewed; it would be run in namespace %q",
+					promoJob.Name,
+					job.Namespace,
+				),
+			)
+			continue
+		}
+
+		jobLogger := logger.WithFields(log.Fields{
+			"job":       job.Name,
+			"namespace": job.Namespace,
+		})
+
+		existingJob, err := j.getJobFn(ctx, job.Namespace, job.Name)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				err = errors.Wrapf(
+					err,
+					"error getting Job %q in namespace %q",
+					job.Name,
+					job.Namespace,
+				)
+				recordStep(
+					status,
+					j.GetName(),
+					target,
+					kargoapi.PromotionPhaseErrored,
+					"",
+					err,
+				)
+				return status, newFreight, err
+			}
+			if err = j.createJobFn(ctx, job); err != nil {
+				err = errors.Wrapf(
+					err,
+					"error creating Job %q in namespace %q",
+					job.Name,
+					job.Namespace,
+				)
+				recordStep(
+					status,
+					j.GetName(),
+					target,
+					kargoapi.PromotionPhaseErrored,
+					"",
+					err,
+				)
+				return status, newFreight, err
+			}
+			jobLogger.Debug("created Job")
+			recordStep(
+				status,
+				j.GetName(),
+				target,
+				kargoapi.PromotionPhaseRunning,
+				"",
+				nil,
+			)
+			// Subsequent Jobs may depend on the outcome of this one, so we cannot
+			// proceed until it has completed.
+			return status.WithPhase(kargoapi.PromotionPhaseRunning), newFreight, nil
+		}
+
+		complete, failed, reason := getJobOutcome(existingJob)
+		if complete {
+			jobLogger.Debug("Job has completed")
+			recordStep(
+				status,
+				j.GetName(),
+				target,
+				kargoapi.PromotionPhaseSucceeded,
+				"",
+				nil,
+			)
+			j.setJobTTL(ctx, existingJob, ttlSeconds)
+			continue
+		}
+		if !failed {
+			jobLogger.Debug("Job has not completed yet")
+			recordStep(
+				status,
+				j.GetName(),
+				target,
+				kargoapi.PromotionPhaseRunning,
+				"",
+				nil,
+			)
+			return status.WithPhase(kargoapi.PromotionPhaseRunning), newFreight, nil
+		}
+
+		msg := fmt.Sprintf(
+			"Job %q in namespace %q failed: %s",
+			existingJob.Name,
+			existingJob.Namespace,
+			reason,
+		)
+		logs, err := j.getJobLogsFn(ctx, existingJob)
+		if err != nil {
+			jobLogger.Errorf("error getting logs of failed Job: %s", err)
+		} else if logs != "" {
+			msg = fmt.Sprintf("%s; last lines of output:\n%s", msg, logs)
+		}
+		err = errors.New(msg)
+		recordStep(
+			status,
+			j.GetName(),
+			target,
+			kargoapi.PromotionPhaseErrored,
+			"",
+			err,
+		)
+		j.setJobTTL(ctx, existingJob, ttlSeconds)
+		return status, newFreight, err
+	}
+
+	logger.Debug("done executing Job promotion mechanisms")
+
+	return status.WithPhase(kargoapi.PromotionPhaseSucceeded), newFreight, nil
+}
+
+// setJobTTL sets the TTL of the provided finished Job, if its template
+// specified one, so that the Job is garbage collected. Failure to do so is
+// logged, but does not affect the outcome of the Promotion since the Job is
+// deleted along with the Promotion that owns it in any case.
+func (j *jobMechanism) setJobTTL(
+	ctx context.Context,
+	job *batchv1.Job,
+	ttlSeconds *int32,
+) {
+	if ttlSeconds == nil || job.Spec.TTLSecondsAfterFinished != nil {
+		return
+	}
+	if err := j.setJobTTLFn(ctx, job.Namespace, job.Name, *ttlSeconds); err != nil {
+		logging.LoggerFromContext(ctx).WithFields(log.Fields{
+			"job":       job.Name,
+			"namespace": job.Namespace,
+		}).Errorf("error setting TTL of finished Job: %s", err)
+	}
+}
+
+// getJobLogs returns the tail of the logs of the most recently created Pod
+// belonging to the provided Job. If any of the Pod's containers terminated
+// unsuccessfully, the logs of the first such container are returned.
+// Otherwise, the logs of the Pod's first container are returned.
+func (j *jobMechanism) getJobLogs(
+	ctx context.Context,
+	job *batchv1.Job,
+) (string, error) {
+	selector, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+	if err != nil {
+		return "", errors.Wrap(err, "error parsing Job's selector")
+	}
+	pods, err := j.listPodsFn(
+		ctx,
+		job.Namespace,
+		metav1.ListOptions{LabelSelector: selector.String()},
+	)
+	if err != nil {
+		return "", errors.Wrap(err, "error listing Job's Pods")
+	}
+	if len(pods.Items) == 0 {
+		return "", nil
+	}
+	sort.Slice(pods.Items, func(i, k int) bool {
+		return pods.Items[k].CreationTimestamp.Before(
+			&pods.Items[i].CreationTimestamp,
+		)
+	})
+	pod := pods.Items[0]
+	if len(pod.Spec.Containers) == 0 {
+		return "", nil
+	}
+	container := pod.Spec.Containers[0].Name
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Terminated != nil &&
+			status.State.Terminated.ExitCode != 0 {
+			container = status.Name
+			break
+		}
+	}
+	tailLines := jobLogTailLines
+	logs, err := j.getPodLogsFn(
+		ctx,
+		pod.Namespace,
+		pod.Name,
+		&corev1.PodLogOptions{
+			Container: container,
+			TailLines: &tailLines,
+		},
+	)
+	if err != nil {
+		return "", errors.Wrapf(
+			err,
+			"error getting logs of container %q of Pod %q",
+			container,
+			pod.Name,
+		)
+	}
+	return strings.TrimSpace(string(logs)), nil
+}
+
+// getJobOutcome returns whether the provided Job has completed or failed and,
+// if it has failed, the reason.
+func getJobOutcome(job *batchv1.Job) (complete bool, failed bool, reason string) {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return true, false, ""
+		case batchv1.JobFailed:
+			reason = condition.Reason
+			if condition.Message != "" {
+				reason = fmt.Sprintf("%s: %s", reason, condition.Message)
+			}
+			return false, true, reason
+		}
+	}
+	return false, false, ""
+}
+
+// DeleteActiveJobs deletes any Jobs that were created on behalf of the provided
+// Promotion and that have neither completed nor failed. It is intended to be
+// used to stop the Jobs of a Promotion that has been aborted or has timed out.
+// Jobs are deleted using background propagation so that their Pods are deleted
+// as well.
+func DeleteActiveJobs(
+	ctx context.Context,
+	kubeClientset kubernetes.Interface,
+	promo *kargoapi.Promotion,
+) error {
+	jobs, err := kubeClientset.BatchV1().Jobs(promo.Namespace).List(
+		ctx,
+		metav1.ListOptions{},
+	)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"error listing Jobs in namespace %q",
+			promo.Namespace,
+		)
+	}
+	propagationPolicy := metav1.DeletePropagationBackground
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		if !metav1.IsControlledBy(job, promo) {
+			continue
+		}
+		if complete, failed, _ := getJobOutcome(job); complete || failed {
+			continue
+		}
+		if err = kubeClientset.BatchV1().Jobs(job.Namespace).Delete(
+			ctx,
+			job.Name,
+			metav1.DeleteOptions{PropagationPolicy: &propagationPolicy},
+		); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(
+				err,
+				"error deleting Job %q in namespace %q",
+				job.Name,
+				job.Namespace,
+			)
+		}
+	}
+	return nil
+}
+
+// getPromotionJobName returns a name for the Job that runs the provided
+// PromotionJob during the specified attempt at executing the provided
+// Promotion. The name is deterministic, so a Job that has already been created
+// can be found again, and short enough to be used as a label value. Jobs
+// created by retries are suffixed with the number of the attempt so that they
+// do not collide with Jobs created by earlier attempts.
+func getPromotionJobName(
+	promo *kargoapi.Promotion,
+	promoJob kargoapi.PromotionJob,
+	attempt int,
+) string {
+	hash := sha256.Sum256([]byte(promo.Namespace + "/" + promo.Name))
+	if attempt > 1 {
+		return fmt.Sprintf("%s-%x-%d", promoJob.Name, hash[:5], attempt)
+	}
+	return fmt.Sprintf("%s-%x", promoJob.Name, hash[:5])
+}
+
+// succeededInEarlierAttempt returns whether the provided PromotionJob already
+// completed successfully during an earlier attempt at executing the provided
+// Promotion. Such a Job is not run again.
+func (j *jobMechanism) succeededInEarlierAttempt(
+	promo *kargoapi.Promotion,
+	namespace string,
+	promoJob kargoapi.PromotionJob,
+) bool {
+	for attempt := 1; attempt < promo.Status.CurrentAttempt(); attempt++ {
+		target := fmt.Sprintf(
+			"%s/%s",
+			namespace,
+			getPromotionJobName(promo, promoJob, attempt),
+		)
+		if j.stepSucceeded(&promo.Status, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// stepSucceeded returns whether the provided status records that the Job
+// identified by the provided target completed successfully.
+func (j *jobMechanism) stepSucceeded(
+	status *kargoapi.PromotionStatus,
+	target string,
+) bool {
+	for _, step := range status.Steps {
+		if step.Mechanism == j.GetName() && step.Target == target &&
+			step.FinishedAt != nil && step.Error == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// buildPromotionJob builds the Job that runs the provided PromotionJob on
+// behalf of the provided Promotion. Information about the Freight being
+// promoted is injected into all of the Job's containers.
+func buildPromotionJob(
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	freight kargoapi.SimpleFreight,
+	promoJob kargoapi.PromotionJob,
+) (*batchv1.Job, error) {
+	spec := batchv1.JobSpec{}
+	if err := yaml.Unmarshal([]byte(promoJob.Template), &spec); err != nil {
+		return nil, errors.Wrapf(err, "error parsing template of Job %q", promoJob.Name)
+	}
+	if spec.Template.Spec.RestartPolicy == "" {
+		spec.Template.Spec.RestartPolicy = corev1.RestartPolicyNever
+	}
+	// Stages are validated when they are created or updated, but we check again
+	// here since a Job that could choose its own ServiceAccount would run with
+	// the permissions of any ServiceAccount in the Stage's namespace.
+	if spec.Template.Spec.ServiceAccountName != "" ||
+		spec.Template.Spec.DeprecatedServiceAccount != "" {
+		return nil, errors.Errorf(
+			"template of Job %q may not specify a service account",
+			promoJob.Name,
+		)
+	}
+
+	freightJSON, err := json.Marshal(freight)
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshaling Freight")
+	}
+	commitsJSON, err := marshalJSONList(freight.Commits)
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshaling Freight commits")
+	}
+	imagesJSON, err := marshalJSONList(freight.Images)
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshaling Freight images")
+	}
+	chartsJSON, err := marshalJSONList(freight.Charts)
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshaling Freight charts")
+	}
+	data := newPromotionTemplateData(promo, freight)
+	env := []corev1.EnvVar{
+		{Name: "KARGO_PROJECT", Value: data.Project},
+		{Name: "KARGO_STAGE", Value: data.Stage},
+		{Name: "KARGO_PROMOTION", Value: data.Promotion},
+		{Name: "KARGO_FREIGHT", Value: data.Freight},
+		{Name: "KARGO_FREIGHT_COMMITS", Value: string(commitsJSON)},
+		{Name: "KARGO_FREIGHT_IMAGES", Value: string(imagesJSON)},
+		{Name: "KARGO_FREIGHT_CHARTS", Value: string(chartsJSON)},
+		{
+			Name:  "KARGO_FREIGHT_FILE",
+			Value: jobFreightMountPath + "/" + jobFreightFileName,
+		},
+	}
+	mount := corev1.VolumeMount{
+		Name:      jobFreightVolumeName,
+		MountPath: jobFreightMountPath,
+		ReadOnly:  true,
+	}
+	podSpec := &spec.Template.Spec
+	for i := range podSpec.InitContainers {
+		podSpec.InitContainers[i].Env = append(podSpec.InitContainers[i].Env, env...)
+		podSpec.InitContainers[i].VolumeMounts =
+			append(podSpec.InitContainers[i].VolumeMounts, mount)
+	}
+	for i := range podSpec.Containers {
+		podSpec.Containers[i].Env = append(podSpec.Containers[i].Env, env...)
+		podSpec.Containers[i].VolumeMounts =
+			append(podSpec.Containers[i].VolumeMounts, mount)
+	}
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: jobFreightVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			DownwardAPI: &corev1.DownwardAPIVolumeSource{
+				Items: []corev1.DownwardAPIVolumeFile{
+					{
+						Path: jobFreightFileName,
+						FieldRef: &corev1.ObjectFieldSelector{
+							FieldPath: fmt.Sprintf(
+								"metadata.annotations['%s']",
+								jobFreightAnnotationKey,
+							),
+						},
+					},
+				},
+			},
+		},
+	})
+	if spec.Template.Annotations == nil {
+		spec.Template.Annotations = map[string]string{}
+	}
+	spec.Template.Annotations[jobFreightAnnotationKey] = string(freightJSON)
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getPromotionJobName(promo, promoJob, promo.Status.CurrentAttempt()),
+			Namespace: stage.Namespace,
+			Annotations: map[string]string{
+				jobPromotionAnnotationKey: promo.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(
+					promo,
+					kargoapi.GroupVersion.WithKind("Promotion"),
+				),
+			},
+		},
+		Spec: spec,
+	}, nil
+}
+
+// marshalJSONList marshals the provided slice to JSON, representing a nil slice
+// as an empty list rather than as null.
+func marshalJSONList[T any](items []T) ([]byte, error) {
+	if items == nil {
+		items = []T{}
+	}
+	return json.Marshal(items)
+}
@@ -0,0 +1,766 @@
+package promotion
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewJobMechanism(t *testing.T) {
+	pm := newJobMechanism(kubefake.NewSimpleClientset())
+	jpm, ok := pm.(*jobMechanism)
+	require.True(t, ok)
+	require.NotNil(t, jpm.getJobFn)
+	require.NotNil(t, jpm.createJobFn)
+	require.NotNil(t, jpm.setJobTTLFn)
+	require.NotNil(t, jpm.getJobLogsFn)
+	require.NotNil(t, jpm.listPodsFn)
+	require.NotNil(t, jpm.getPodLogsFn)
+}
+
+func TestJobGetName(t *testing.T) {
+	require.NotEmpty(t, (&jobMechanism{}).GetName())
+}
+
+func TestJobPromote(t *testing.T) {
+	const testTemplate = `
+template:
+  spec:
+    containers:
+    - name: migrate
+      image: migrate:latest
+`
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-stage",
+			Namespace: "fake-project",
+		},
+		Spec: &kargoapi.StageSpec{
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{
+				Jobs: []kargoapi.PromotionJob{
+					{
+						Name:     "migrate",
+						Template: testTemplate,
+					},
+				},
+			},
+		},
+	}
+	ttlStage := testStage.DeepCopy()
+	ttlStage.Spec.PromotionMechanisms.Jobs[0].Template = `
+ttlSecondsAfterFinished: 60
+` + testTemplate
+	jobWithCondition := func(
+		conditionType batchv1.JobConditionType,
+	) func(context.Context, string, string) (*batchv1.Job, error) {
+		return func(_ context.Context, namespace, name string) (*batchv1.Job, error) {
+			job := &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+			}
+			if conditionType != "" {
+				job.Status.Conditions = []batchv1.JobCondition{
+					{
+						Type:    conditionType,
+						Status:  corev1.ConditionTrue,
+						Reason:  "BackoffLimitExceeded",
+						Message: "Job has reached the specified backoff limit",
+					},
+				}
+			}
+			return job, nil
+		}
+	}
+	testCases := []struct {
+		name       string
+		promoMech  *jobMechanism
+		stage      *kargoapi.Stage
+		ctx        context.Context
+		assertions func(*kargoapi.PromotionStatus, error)
+	}{
+		{
+			name:      "no jobs",
+			promoMech: &jobMechanism{},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name:      "invalid template",
+			promoMech: &jobMechanism{},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						Jobs: []kargoapi.PromotionJob{
+							{
+								Name:     "migrate",
+								Template: "template: [",
+							},
+						},
+					},
+				},
+			},
+			assertions: func(_ *kargoapi.PromotionStatus, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), `error parsing template of Job "migrate"`)
+			},
+		},
+		{
+			name: "preview",
+			promoMech: &jobMechanism{
+				getJobFn: func(context.Context, string, string) (*batchv1.Job, error) {
+					require.Fail(t, "Job should not have been looked up")
+					return nil, nil
+				},
+			},
+			stage: testStage,
+			ctx:   ContextWithPreview(context.Background(), &Preview{}),
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name: "error getting Job",
+			promoMech: &jobMechanism{
+				getJobFn: func(context.Context, string, string) (*batchv1.Job, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			stage: testStage,
+			assertions: func(_ *kargoapi.PromotionStatus, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error getting Job")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "error creating Job",
+			promoMech: &jobMechanism{
+				getJobFn: func(_ context.Context, _, name string) (*batchv1.Job, error) {
+					return nil, apierrors.NewNotFound(
+						schema.GroupResource{Group: "batch", Resource: "jobs"},
+						name,
+					)
+				},
+				createJobFn: func(context.Context, *batchv1.Job) error {
+					return errors.New("something went wrong")
+				},
+			},
+			stage: testStage,
+			assertions: func(_ *kargoapi.PromotionStatus, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error creating Job")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "Job created",
+			promoMech: &jobMechanism{
+				getJobFn: func(_ context.Context, _, name string) (*batchv1.Job, error) {
+					return nil, apierrors.NewNotFound(
+						schema.GroupResource{Group: "batch", Resource: "jobs"},
+						name,
+					)
+				},
+				createJobFn: func(_ context.Context, job *batchv1.Job) error {
+					require.Equal(t, "fake-project", job.Namespace)
+					return nil
+				},
+			},
+			stage: testStage,
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+			},
+		},
+		{
+			name: "Job still running",
+			promoMech: &jobMechanism{
+				getJobFn: jobWithCondition(""),
+			},
+			stage: testStage,
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+			},
+		},
+		{
+			name: "Job failed",
+			promoMech: &jobMechanism{
+				getJobFn: jobWithCondition(batchv1.JobFailed),
+				getJobLogsFn: func(context.Context, *batchv1.Job) (string, error) {
+					return "error: relation \"users\" does not exist", nil
+				},
+			},
+			stage: testStage,
+			assertions: func(_ *kargoapi.PromotionStatus, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed: BackoffLimitExceeded")
+				require.Contains(t, err.Error(), "last lines of output")
+				require.Contains(t, err.Error(), `relation "users" does not exist`)
+			},
+		},
+		{
+			name: "Job failed; error getting logs",
+			promoMech: &jobMechanism{
+				getJobFn: jobWithCondition(batchv1.JobFailed),
+				getJobLogsFn: func(context.Context, *batchv1.Job) (string, error) {
+					return "", errors.New("something went wrong")
+				},
+			},
+			stage: testStage,
+			assertions: func(_ *kargoapi.PromotionStatus, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "failed: BackoffLimitExceeded")
+				require.NotContains(t, err.Error(), "last lines of output")
+			},
+		},
+		{
+			name: "Job complete",
+			promoMech: &jobMechanism{
+				getJobFn: jobWithCondition(batchv1.JobComplete),
+			},
+			stage: testStage,
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name: "Job with TTL created",
+			promoMech: &jobMechanism{
+				getJobFn: func(_ context.Context, _, name string) (*batchv1.Job, error) {
+					return nil, apierrors.NewNotFound(
+						schema.GroupResource{Group: "batch", Resource: "jobs"},
+						name,
+					)
+				},
+				createJobFn: func(_ context.Context, job *batchv1.Job) error {
+					// The TTL must not be set until the outcome has been recorded
+					require.Nil(t, job.Spec.TTLSecondsAfterFinished)
+					return nil
+				},
+			},
+			stage: ttlStage,
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+			},
+		},
+		{
+			name: "Job with TTL complete",
+			promoMech: &jobMechanism{
+				getJobFn: jobWithCondition(batchv1.JobComplete),
+				setJobTTLFn: func(_ context.Context, _, _ string, ttlSeconds int32) error {
+					require.Equal(t, int32(60), ttlSeconds)
+					return nil
+				},
+			},
+			stage: ttlStage,
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Len(t, status.Steps, 1)
+				require.NotNil(t, status.Steps[0].FinishedAt)
+			},
+		},
+		{
+			name: "Job with TTL complete; error setting TTL",
+			promoMech: &jobMechanism{
+				getJobFn: jobWithCondition(batchv1.JobComplete),
+				setJobTTLFn: func(context.Context, string, string, int32) error {
+					return errors.New("something went wrong")
+				},
+			},
+			stage: ttlStage,
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := testCase.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			status, _, err := testCase.promoMech.Promote(
+				ctx,
+				testCase.stage,
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-promotion",
+						Namespace: "fake-project",
+					},
+					Spec: &kargoapi.PromotionSpec{
+						Stage:   "fake-stage",
+						Freight: "fake-freight",
+					},
+				},
+				kargoapi.SimpleFreight{ID: "fake-freight"},
+			)
+			testCase.assertions(status, err)
+		})
+	}
+}
+
+func TestJobPromoteRetry(t *testing.T) {
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-stage",
+			Namespace: "fake-project",
+		},
+		Spec: &kargoapi.StageSpec{
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{
+				Jobs: []kargoapi.PromotionJob{
+					{
+						Name: "migrate",
+						Template: `
+template:
+  spec:
+    containers:
+    - name: migrate
+      image: migrate:latest
+`,
+					},
+				},
+			},
+		},
+	}
+	newPromo := func() *kargoapi.Promotion {
+		return &kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "fake-promotion",
+				Namespace: "fake-project",
+			},
+			Spec: &kargoapi.PromotionSpec{
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+			},
+			Status: kargoapi.PromotionStatus{
+				// The first attempt failed
+				Attempts: []kargoapi.PromotionAttempt{
+					{Phase: kargoapi.PromotionPhaseErrored},
+				},
+			},
+		}
+	}
+	firstJobName := getPromotionJobName(newPromo(), kargoapi.PromotionJob{Name: "migrate"}, 1)
+	secondJobName := getPromotionJobName(newPromo(), kargoapi.PromotionJob{Name: "migrate"}, 2)
+	require.NotEqual(t, firstJobName, secondJobName)
+	require.Regexp(t, "^migrate-[0-9a-f]{10}-2$", secondJobName)
+
+	t.Run("Job that failed is run again", func(t *testing.T) {
+		var createdJob *batchv1.Job
+		promoMech := &jobMechanism{
+			getJobFn: func(_ context.Context, _, name string) (*batchv1.Job, error) {
+				return nil, apierrors.NewNotFound(schema.GroupResource{}, name)
+			},
+			createJobFn: func(_ context.Context, job *batchv1.Job) error {
+				createdJob = job
+				return nil
+			},
+		}
+		status, _, err := promoMech.Promote(
+			context.Background(),
+			stage,
+			newPromo(),
+			kargoapi.SimpleFreight{ID: "fake-freight"},
+		)
+		require.NoError(t, err)
+		require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+		require.NotNil(t, createdJob)
+		require.Equal(t, secondJobName, createdJob.Name)
+	})
+
+	t.Run("Job that succeeded is not run again", func(t *testing.T) {
+		promo := newPromo()
+		promo.Status.Steps = []kargoapi.PromotionStepResult{
+			{
+				Mechanism:  (&jobMechanism{}).GetName(),
+				Target:     "fake-project/" + firstJobName,
+				FinishedAt: &metav1.Time{Time: time.Now()},
+			},
+		}
+		promoMech := &jobMechanism{
+			getJobFn: func(context.Context, string, string) (*batchv1.Job, error) {
+				require.Fail(t, "Job should not have been looked up")
+				return nil, nil
+			},
+		}
+		status, _, err := promoMech.Promote(
+			context.Background(),
+			stage,
+			promo,
+			kargoapi.SimpleFreight{ID: "fake-freight"},
+		)
+		require.NoError(t, err)
+		require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+	})
+
+	t.Run("Job that succeeded and was deleted is not run again", func(t *testing.T) {
+		promo := newPromo()
+		promo.Status.Steps = []kargoapi.PromotionStepResult{
+			{
+				Mechanism:  (&jobMechanism{}).GetName(),
+				Target:     "fake-project/" + secondJobName,
+				FinishedAt: &metav1.Time{Time: time.Now()},
+			},
+		}
+		promoMech := &jobMechanism{
+			getJobFn: func(_ context.Context, _, name string) (*batchv1.Job, error) {
+				return nil, apierrors.NewNotFound(schema.GroupResource{}, name)
+			},
+			createJobFn: func(context.Context, *batchv1.Job) error {
+				require.Fail(t, "Job should not have been created")
+				return nil
+			},
+		}
+		status, _, err := promoMech.Promote(
+			context.Background(),
+			stage,
+			promo,
+			kargoapi.SimpleFreight{ID: "fake-freight"},
+		)
+		require.NoError(t, err)
+		require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+	})
+}
+
+func TestJobGetJobLogs(t *testing.T) {
+	testJob := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-job",
+			Namespace: "fake-project",
+		},
+		Spec: batchv1.JobSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"controller-uid": "fake-uid",
+				},
+			},
+		},
+	}
+	now := time.Now()
+	testCases := []struct {
+		name       string
+		promoMech  *jobMechanism
+		assertions func(string, error)
+	}{
+		{
+			name: "error listing Pods",
+			promoMech: &jobMechanism{
+				listPodsFn: func(
+					context.Context,
+					string,
+					metav1.ListOptions,
+				) (*corev1.PodList, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error listing Job's Pods")
+			},
+		},
+		{
+			name: "no Pods",
+			promoMech: &jobMechanism{
+				listPodsFn: func(
+					context.Context,
+					string,
+					metav1.ListOptions,
+				) (*corev1.PodList, error) {
+					return &corev1.PodList{}, nil
+				},
+			},
+			assertions: func(logs string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, logs)
+			},
+		},
+		{
+			name: "success",
+			promoMech: &jobMechanism{
+				listPodsFn: func(
+					_ context.Context,
+					namespace string,
+					opts metav1.ListOptions,
+				) (*corev1.PodList, error) {
+					require.Equal(t, "fake-project", namespace)
+					require.Equal(t, "controller-uid=fake-uid", opts.LabelSelector)
+					return &corev1.PodList{
+						Items: []corev1.Pod{
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:              "older-pod",
+									Namespace:         "fake-project",
+									CreationTimestamp: metav1.NewTime(now.Add(-time.Minute)),
+								},
+							},
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:              "newer-pod",
+									Namespace:         "fake-project",
+									CreationTimestamp: metav1.NewTime(now),
+								},
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{
+										{Name: "sidecar"},
+										{Name: "migrate"},
+									},
+								},
+								Status: corev1.PodStatus{
+									ContainerStatuses: []corev1.ContainerStatus{
+										{
+											Name: "sidecar",
+										},
+										{
+											Name: "migrate",
+											State: corev1.ContainerState{
+												Terminated: &corev1.ContainerStateTerminated{
+													ExitCode: 1,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					}, nil
+				},
+				getPodLogsFn: func(
+					_ context.Context,
+					_ string,
+					name string,
+					opts *corev1.PodLogOptions,
+				) ([]byte, error) {
+					require.Equal(t, "newer-pod", name)
+					require.Equal(t, "migrate", opts.Container)
+					require.Equal(t, jobLogTailLines, *opts.TailLines)
+					return []byte("something went wrong\n"), nil
+				},
+			},
+			assertions: func(logs string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "something went wrong", logs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.promoMech.getJobLogs(context.Background(), testJob),
+			)
+		})
+	}
+}
+
+func TestBuildPromotionJob(t *testing.T) {
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-stage",
+			Namespace: "fake-project",
+		},
+	}
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-promotion",
+			Namespace: "fake-project",
+			UID:       "fake-uid",
+		},
+		Spec: &kargoapi.PromotionSpec{
+			Stage:   "fake-stage",
+			Freight: "fake-freight",
+		},
+	}
+	freight := kargoapi.SimpleFreight{
+		ID: "fake-freight",
+		Images: []kargoapi.Image{
+			{
+				RepoURL: "nginx",
+				Tag:     "1.25.0",
+			},
+		},
+	}
+	job, err := buildPromotionJob(
+		stage,
+		promo,
+		freight,
+		kargoapi.PromotionJob{
+			Name: "migrate",
+			Template: `
+backoffLimit: 2
+template:
+  spec:
+    containers:
+    - name: migrate
+      image: migrate:latest
+`,
+		},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, "fake-project", job.Namespace)
+	require.Equal(t, getPromotionJobName(promo, kargoapi.PromotionJob{Name: "migrate"}, 1), job.Name)
+	require.Regexp(t, "^migrate-[0-9a-f]{10}$", job.Name)
+	require.Equal(t, "fake-promotion", job.Annotations[jobPromotionAnnotationKey])
+	require.Len(t, job.OwnerReferences, 1)
+	require.Equal(t, "Promotion", job.OwnerReferences[0].Kind)
+	require.Equal(t, promo.UID, job.OwnerReferences[0].UID)
+
+	require.Equal(t, int32(2), *job.Spec.BackoffLimit)
+	podSpec := job.Spec.Template.Spec
+	require.Equal(t, corev1.RestartPolicyNever, podSpec.RestartPolicy)
+
+	freightJSON := job.Spec.Template.Annotations[jobFreightAnnotationKey]
+	decodedFreight := kargoapi.SimpleFreight{}
+	require.NoError(t, json.Unmarshal([]byte(freightJSON), &decodedFreight))
+	require.Equal(t, freight, decodedFreight)
+
+	require.Len(t, podSpec.Volumes, 1)
+	require.Equal(t, jobFreightVolumeName, podSpec.Volumes[0].Name)
+	require.NotNil(t, podSpec.Volumes[0].DownwardAPI)
+
+	container := podSpec.Containers[0]
+	require.Equal(
+		t,
+		[]corev1.VolumeMount{
+			{
+				Name:      jobFreightVolumeName,
+				MountPath: jobFreightMountPath,
+				ReadOnly:  true,
+			},
+		},
+		container.VolumeMounts,
+	)
+	env := map[string]string{}
+	for _, envVar := range container.Env {
+		env[envVar.Name] = envVar.Value
+	}
+	require.Equal(t, "fake-project", env["KARGO_PROJECT"])
+	require.Equal(t, "fake-stage", env["KARGO_STAGE"])
+	require.Equal(t, "fake-promotion", env["KARGO_PROMOTION"])
+	require.Equal(t, "fake-freight", env["KARGO_FREIGHT"])
+	require.Equal(t, "[]", env["KARGO_FREIGHT_COMMITS"])
+	require.JSONEq(t, `[{"repoURL":"nginx","tag":"1.25.0"}]`, env["KARGO_FREIGHT_IMAGES"])
+	require.Equal(t, "/kargo/freight.json", env["KARGO_FREIGHT_FILE"])
+
+	_, err = buildPromotionJob(
+		stage,
+		promo,
+		freight,
+		kargoapi.PromotionJob{
+			Name: "migrate",
+			Template: `
+template:
+  spec:
+    serviceAccountName: admin
+    containers:
+    - name: migrate
+      image: migrate:latest
+`,
+		},
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "may not specify a service account")
+}
+
+func TestDeleteActiveJobs(t *testing.T) {
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-promotion",
+			Namespace: "fake-project",
+			UID:       "fake-uid",
+		},
+	}
+	ownerRefs := []metav1.OwnerReference{
+		*metav1.NewControllerRef(
+			promo,
+			kargoapi.GroupVersion.WithKind("Promotion"),
+		),
+	}
+	kubeClientset := kubefake.NewSimpleClientset(
+		&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "running",
+				Namespace:       "fake-project",
+				OwnerReferences: ownerRefs,
+			},
+		},
+		&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "complete",
+				Namespace:       "fake-project",
+				OwnerReferences: ownerRefs,
+			},
+			Status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{
+					{
+						Type:   batchv1.JobComplete,
+						Status: corev1.ConditionTrue,
+					},
+				},
+			},
+		},
+		&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "unrelated",
+				Namespace: "fake-project",
+			},
+		},
+	)
+
+	err := DeleteActiveJobs(context.Background(), kubeClientset, promo)
+	require.NoError(t, err)
+
+	jobs, err := kubeClientset.BatchV1().Jobs("fake-project").List(
+		context.Background(),
+		metav1.ListOptions{},
+	)
+	require.NoError(t, err)
+	names := make([]string, len(jobs.Items))
+	for i, job := range jobs.Items {
+		names[i] = job.Name
+	}
+	require.ElementsMatch(t, []string{"complete", "unrelated"}, names)
+
+	var deleteAction bool
+	for _, action := range kubeClientset.Actions() {
+		deletion, ok := action.(k8stesting.DeleteAction)
+		if !ok {
+			continue
+		}
+		deleteAction = true
+		require.Equal(t, "running", deletion.GetName())
+		require.Equal(
+			t,
+			metav1.DeletePropagationBackground,
+			*deletion.GetDeleteOptions().PropagationPolicy,
+		)
+	}
+	require.True(t, deleteAction)
+}
@@ -0,0 +1,239 @@
+package promotion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// kubernetesPatchMechanism is an implementation of the Mechanism interface
+// that patches arbitrary Kubernetes resources.
+type kubernetesPatchMechanism struct {
+	// These behaviors are overridable for testing purposes:
+	doSinglePatchFn func(
+		ctx context.Context,
+		stageMeta metav1.ObjectMeta,
+		patch kargoapi.KubernetesPatch,
+		data promotionTemplateData,
+	) error
+	getObjectFn func(
+		ctx context.Context,
+		key client.ObjectKey,
+		obj client.Object,
+		opts ...client.GetOption,
+	) error
+	patchObjectFn func(
+		ctx context.Context,
+		obj client.Object,
+		patch client.Patch,
+		opts ...client.PatchOption,
+	) error
+}
+
+// newKubernetesPatchMechanism returns an implementation of the Mechanism
+// interface that patches arbitrary Kubernetes resources.
+func newKubernetesPatchMechanism(kubeClient client.Client) Mechanism {
+	k := &kubernetesPatchMechanism{}
+	k.doSinglePatchFn = k.doSinglePatch
+	k.getObjectFn = kubeClient.Get
+	k.patchObjectFn = kubeClient.Patch
+	return k
+}
+
+// GetName implements the Mechanism interface.
+func (*kubernetesPatchMechanism) GetName() string {
+	return "Kubernetes patch promotion mechanism"
+}
+
+// Promote implements the Mechanism interface.
+func (k *kubernetesPatchMechanism) Promote(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	newFreight kargoapi.SimpleFreight,
+) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
+	patches := stage.Spec.PromotionMechanisms.KubernetesPatches
+
+	if len(patches) == 0 {
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded),
+			newFreight, nil
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	logger.Debug("executing Kubernetes patch promotion mechanisms")
+
+	status := promo.Status.DeepCopy()
+	data := newPromotionTemplateData(promo, newFreight)
+	for _, patch := range patches {
+		namespace := patch.Namespace
+		if namespace == "" {
+			namespace = stage.Namespace
+		}
+		target := fmt.Sprintf("%s %s/%s", patch.Kind, namespace, patch.Name)
+		if err := k.doSinglePatchFn(
+			ctx,
+			stage.ObjectMeta,
+			patch,
+			data,
+		); err != nil {
+			recordStep(
+				status,
+				k.GetName(),
+				target,
+				kargoapi.PromotionPhaseErrored,
+				"",
+				err,
+			)
+			return status, newFreight, err
+		}
+		recordStep(
+			status,
+			k.GetName(),
+			target,
+			kargoapi.PromotionPhaseSucceeded,
+			"",
+			nil,
+		)
+	}
+
+	logger.Debug("done executing Kubernetes patch promotion mechanisms")
+
+	return status.WithPhase(kargoapi.PromotionPhaseSucceeded), newFreight, nil
+}
+
+// doSinglePatch renders a single patch and applies it to the resource it
+// targets.
+func (k *kubernetesPatchMechanism) doSinglePatch(
+	ctx context.Context,
+	stageMeta metav1.ObjectMeta,
+	patch kargoapi.KubernetesPatch,
+	data promotionTemplateData,
+) error {
+	gv, err := schema.ParseGroupVersion(patch.APIVersion)
+	if err != nil {
+		return errors.Wrapf(err, "error parsing API version %q", patch.APIVersion)
+	}
+	namespace := patch.Namespace
+	if namespace == "" {
+		namespace = stageMeta.Namespace
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gv.WithKind(patch.Kind))
+	if err = k.getObjectFn(
+		ctx,
+		client.ObjectKey{
+			Namespace: namespace,
+			Name:      patch.Name,
+		},
+		obj,
+	); err != nil {
+		if err = client.IgnoreNotFound(err); err == nil {
+			return errors.Errorf(
+				"unable to find %s %q in namespace %q",
+				patch.Kind,
+				patch.Name,
+				namespace,
+			)
+		}
+		return errors.Wrapf(
+			err,
+			"error finding %s %q in namespace %q",
+			patch.Kind,
+			patch.Name,
+			namespace,
+		)
+	}
+
+	// Make sure this is allowed!
+	if err = authorizeUpdate(
+		patch.Kind,
+		stageMeta,
+		metav1.ObjectMeta{
+			Name:        obj.GetName(),
+			Namespace:   obj.GetNamespace(),
+			Annotations: obj.GetAnnotations(),
+		},
+	); err != nil {
+		return err
+	}
+
+	patchBytes, err := renderPromotionTemplate("patch", patch.Patch, data)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"error rendering patch for %s %q in namespace %q",
+			patch.Kind,
+			patch.Name,
+			namespace,
+		)
+	}
+	if !json.Valid(patchBytes) {
+		return errors.Errorf(
+			"rendered patch for %s %q in namespace %q is not valid JSON; use "+
+				"quote or toJson to embed values that may contain special "+
+				"characters: %s",
+			patch.Kind,
+			patch.Name,
+			namespace,
+			patchBytes,
+		)
+	}
+
+	var patchType types.PatchType
+	switch patch.Type {
+	case kargoapi.KubernetesPatchTypeJSON:
+		patchType = types.JSONPatchType
+	case kargoapi.KubernetesPatchTypeStrategicMerge:
+		patchType = types.StrategicMergePatchType
+	default:
+		return errors.Errorf("unsupported patch type %q", patch.Type)
+	}
+
+	if preview := previewFromContext(ctx); preview != nil {
+		preview.Warnings = append(
+			preview.Warnings,
+			fmt.Sprintf(
+				"changes to %s %q in namespace %q cannot be previewed; the "+
+					"following %s patch would be applied: %s",
+				patch.Kind,
+				patch.Name,
+				namespace,
+				patch.Type,
+				patchBytes,
+			),
+		)
+		return nil
+	}
+
+	if err = k.patchObjectFn(
+		ctx,
+		obj,
+		client.RawPatch(patchType, patchBytes),
+	); err != nil {
+		return errors.Wrapf(
+			err,
+			"error patching %s %q in namespace %q",
+			patch.Kind,
+			patch.Name,
+			namespace,
+		)
+	}
+	logging.LoggerFromContext(ctx).WithFields(log.Fields{
+		"kind":      patch.Kind,
+		"namespace": namespace,
+		"name":      patch.Name,
+	}).Debug("patched Kubernetes resource")
+	return nil
+}
@@ -0,0 +1,418 @@
+package promotion
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewKubernetesPatchMechanism(t *testing.T) {
+	pm := newKubernetesPatchMechanism(
+		fake.NewClientBuilder().Build(),
+	)
+	kpm, ok := pm.(*kubernetesPatchMechanism)
+	require.True(t, ok)
+	require.NotNil(t, kpm.doSinglePatchFn)
+	require.NotNil(t, kpm.getObjectFn)
+	require.NotNil(t, kpm.patchObjectFn)
+}
+
+func TestKubernetesPatchGetName(t *testing.T) {
+	require.NotEmpty(t, (&kubernetesPatchMechanism{}).GetName())
+}
+
+func TestKubernetesPatchPromote(t *testing.T) {
+	testCases := []struct {
+		name       string
+		promoMech  *kubernetesPatchMechanism
+		stage      *kargoapi.Stage
+		assertions func(*kargoapi.PromotionStatus, error)
+	}{
+		{
+			name:      "no patches",
+			promoMech: &kubernetesPatchMechanism{},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name: "error applying patch",
+			promoMech: &kubernetesPatchMechanism{
+				doSinglePatchFn: func(
+					context.Context,
+					metav1.ObjectMeta,
+					kargoapi.KubernetesPatch,
+					promotionTemplateData,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						KubernetesPatches: []kargoapi.KubernetesPatch{{}},
+					},
+				},
+			},
+			assertions: func(_ *kargoapi.PromotionStatus, err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "success",
+			promoMech: &kubernetesPatchMechanism{
+				doSinglePatchFn: func(
+					_ context.Context,
+					_ metav1.ObjectMeta,
+					_ kargoapi.KubernetesPatch,
+					data promotionTemplateData,
+				) error {
+					require.Equal(t, "fake-project", data.Project)
+					require.Equal(t, "fake-stage", data.Stage)
+					require.Equal(t, "fake-promotion", data.Promotion)
+					require.Equal(t, "fake-freight", data.Freight)
+					return nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						KubernetesPatches: []kargoapi.KubernetesPatch{{}},
+					},
+				},
+			},
+			assertions: func(status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status, _, err := testCase.promoMech.Promote(
+				context.Background(),
+				testCase.stage,
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-promotion",
+						Namespace: "fake-project",
+					},
+					Spec: &kargoapi.PromotionSpec{
+						Stage:   "fake-stage",
+						Freight: "fake-freight",
+					},
+				},
+				kargoapi.SimpleFreight{ID: "fake-freight"},
+			)
+			testCase.assertions(status, err)
+		})
+	}
+}
+
+func TestKubernetesPatchDoSinglePatch(t *testing.T) {
+	testStageMeta := metav1.ObjectMeta{
+		Name:      "fake-stage",
+		Namespace: "fake-project",
+	}
+	testPatch := kargoapi.KubernetesPatch{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "fake-name",
+		Type:       kargoapi.KubernetesPatchTypeStrategicMerge,
+		Patch:      `{"spec":{"template":{"spec":{"containers":[{"name":"app","image":"nginx:{{ .ImageTag "nginx" }}"}]}}}}`,
+	}
+	testData := promotionTemplateData{
+		Commits: []kargoapi.GitCommit{
+			{
+				ID:      "fake-commit",
+				Message: `Fix "quoting" \ escaping","injected":"value`,
+			},
+		},
+		Images: []kargoapi.Image{
+			{
+				RepoURL: "nginx",
+				Tag:     "1.25.0",
+			},
+		},
+	}
+	getAuthorizedObject := func(
+		_ context.Context,
+		key client.ObjectKey,
+		obj client.Object,
+		_ ...client.GetOption,
+	) error {
+		obj.SetName(key.Name)
+		obj.SetNamespace(key.Namespace)
+		obj.SetAnnotations(map[string]string{
+			authorizedStageAnnotationKey: "fake-project:fake-stage",
+		})
+		return nil
+	}
+	testCases := []struct {
+		name       string
+		promoMech  *kubernetesPatchMechanism
+		patch      kargoapi.KubernetesPatch
+		ctx        context.Context
+		assertions func(error)
+	}{
+		{
+			name:      "invalid API version",
+			promoMech: &kubernetesPatchMechanism{},
+			patch: kargoapi.KubernetesPatch{
+				APIVersion: "a/b/c",
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing API version")
+			},
+		},
+		{
+			name: "error getting resource",
+			promoMech: &kubernetesPatchMechanism{
+				getObjectFn: func(
+					context.Context,
+					client.ObjectKey,
+					client.Object,
+					...client.GetOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			patch: testPatch,
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error finding Deployment")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "resource not found",
+			promoMech: &kubernetesPatchMechanism{
+				getObjectFn: func(
+					context.Context,
+					client.ObjectKey,
+					client.Object,
+					...client.GetOption,
+				) error {
+					return apierrors.NewNotFound(
+						schema.GroupResource{Group: "apps", Resource: "deployments"},
+						"fake-name",
+					)
+				},
+			},
+			patch: testPatch,
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					`unable to find Deployment "fake-name" in namespace "fake-project"`,
+				)
+			},
+		},
+		{
+			name: "patch not authorized",
+			promoMech: &kubernetesPatchMechanism{
+				getObjectFn: func(
+					context.Context,
+					client.ObjectKey,
+					client.Object,
+					...client.GetOption,
+				) error {
+					return nil
+				},
+			},
+			patch: testPatch,
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "does not permit mutation")
+			},
+		},
+		{
+			name: "error rendering patch",
+			promoMech: &kubernetesPatchMechanism{
+				getObjectFn: getAuthorizedObject,
+			},
+			patch: kargoapi.KubernetesPatch{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "fake-name",
+				Type:       kargoapi.KubernetesPatchTypeJSON,
+				Patch:      "{{ .Bogus }}",
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error rendering patch")
+			},
+		},
+		{
+			name: "rendered patch is not valid JSON",
+			promoMech: &kubernetesPatchMechanism{
+				getObjectFn: getAuthorizedObject,
+				patchObjectFn: func(
+					context.Context,
+					client.Object,
+					client.Patch,
+					...client.PatchOption,
+				) error {
+					require.Fail(t, "resource should not have been patched")
+					return nil
+				},
+			},
+			patch: kargoapi.KubernetesPatch{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "fake-name",
+				Type:       kargoapi.KubernetesPatchTypeStrategicMerge,
+				Patch: `{"metadata":{"annotations":` +
+					`{"message":"{{ (index .Commits 0).Message }}"}}}`,
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "is not valid JSON")
+			},
+		},
+		{
+			name: "commit message is quoted",
+			promoMech: &kubernetesPatchMechanism{
+				getObjectFn: getAuthorizedObject,
+				patchObjectFn: func(
+					_ context.Context,
+					obj client.Object,
+					patch client.Patch,
+					_ ...client.PatchOption,
+				) error {
+					data, err := patch.Data(obj)
+					require.NoError(t, err)
+					patched := map[string]map[string]map[string]string{}
+					require.NoError(t, json.Unmarshal(data, &patched))
+					// The message is embedded intact and adds no other fields
+					require.Equal(
+						t,
+						map[string]map[string]map[string]string{
+							"metadata": {
+								"annotations": {
+									"message": testData.Commits[0].Message,
+								},
+							},
+						},
+						patched,
+					)
+					return nil
+				},
+			},
+			patch: kargoapi.KubernetesPatch{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "fake-name",
+				Type:       kargoapi.KubernetesPatchTypeStrategicMerge,
+				Patch: `{"metadata":{"annotations":` +
+					`{"message":{{ (index .Commits 0).Message | quote }}}}}`,
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "preview",
+			promoMech: &kubernetesPatchMechanism{
+				getObjectFn: getAuthorizedObject,
+				patchObjectFn: func(
+					context.Context,
+					client.Object,
+					client.Patch,
+					...client.PatchOption,
+				) error {
+					require.Fail(t, "resource should not have been patched")
+					return nil
+				},
+			},
+			patch: testPatch,
+			ctx:   ContextWithPreview(context.Background(), &Preview{}),
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "error patching resource",
+			promoMech: &kubernetesPatchMechanism{
+				getObjectFn: getAuthorizedObject,
+				patchObjectFn: func(
+					context.Context,
+					client.Object,
+					client.Patch,
+					...client.PatchOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			patch: testPatch,
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error patching Deployment")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			promoMech: &kubernetesPatchMechanism{
+				getObjectFn: getAuthorizedObject,
+				patchObjectFn: func(
+					_ context.Context,
+					obj client.Object,
+					patch client.Patch,
+					_ ...client.PatchOption,
+				) error {
+					require.Equal(t, "fake-project", obj.GetNamespace())
+					require.Equal(t, types.StrategicMergePatchType, patch.Type())
+					data, err := patch.Data(obj)
+					require.NoError(t, err)
+					require.Equal(
+						t,
+						`{"spec":{"template":{"spec":{"containers":[{"name":"app","image":"nginx:1.25.0"}]}}}}`,
+						string(data),
+					)
+					return nil
+				},
+			},
+			patch: testPatch,
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := testCase.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			testCase.assertions(
+				testCase.promoMech.doSinglePatch(
+					ctx,
+					testStageMeta,
+					testCase.patch,
+					testData,
+				),
+			)
+		})
+	}
+}
@@ -21,7 +21,10 @@ func newKustomizeMechanism(
 		credentialsDB,
 		selectKustomizeUpdates,
 		(&kustomizer{
-			setImageFn: kustomize.SetImage,
+			setImageFn:       kustomize.SetImage,
+			setImageDigestFn: kustomize.SetImageDigest,
+			buildFn:          kustomize.Build,
+			writeManifestsFn: writeRenderedManifests,
 		}).apply,
 	)
 }
@@ -41,11 +44,15 @@ func selectKustomizeUpdates(updates []kargoapi.GitRepoUpdate) []kargoapi.GitRepo
 // kustomizer is a helper struct whose sole purpose is to close over several
 // other functions that are used in the implementation of the apply() function.
 type kustomizer struct {
-	setImageFn func(dir, image, tag string) error
+	setImageFn       func(dir, image, tag string) error
+	setImageDigestFn func(dir, image, digest string) error
+	buildFn          func(dir string) ([]byte, error)
+	writeManifestsFn func(workingDir, outputPath string, manifests []byte) error
 }
 
 // apply uses Kustomize to carry out the provided update in the specified
-// working directory.
+// working directory. If the update calls for it, the contents of the working
+// directory are then replaced with the output of `kustomize build`.
 func (k *kustomizer) apply(
 	update kargoapi.GitRepoUpdate,
 	newFreight kargoapi.SimpleFreight,
@@ -54,24 +61,52 @@ func (k *kustomizer) apply(
 ) ([]string, error) {
 	changeSummary := make([]string, 0, len(update.Kustomize.Images))
 	for _, imgUpdate := range update.Kustomize.Images {
-		var tag string
-		for _, img := range newFreight.Images {
-			if img.RepoURL == imgUpdate.Image {
-				tag = img.Tag
+		var image *kargoapi.Image
+		for i := range newFreight.Images {
+			if newFreight.Images[i].RepoURL == imgUpdate.Image {
+				image = &newFreight.Images[i]
 				break
 			}
 		}
-		if tag == "" {
+		if image == nil {
 			// TODO: Warn?
 			continue
 		}
 		dir := filepath.Join(workingDir, imgUpdate.Path)
-		if err := k.setImageFn(dir, imgUpdate.Image, tag); err != nil {
+		if imgUpdate.UseDigest {
+			if image.Digest == "" {
+				// There's no change to make in this case.
+				continue
+			}
+			if err := k.setImageDigestFn(dir, imgUpdate.Image, image.Digest); err != nil {
+				return nil, errors.Wrapf(
+					err,
+					"error updating image %q to digest %q using Kustomize",
+					imgUpdate.Image,
+					image.Digest,
+				)
+			}
+			changeSummary = append(
+				changeSummary,
+				fmt.Sprintf(
+					"updated %s/kustomization.yaml to use image %s@%s",
+					imgUpdate.Path,
+					imgUpdate.Image,
+					image.Digest,
+				),
+			)
+			continue
+		}
+		if image.Tag == "" {
+			// There's no change to make in this case.
+			continue
+		}
+		if err := k.setImageFn(dir, imgUpdate.Image, image.Tag); err != nil {
 			return nil, errors.Wrapf(
 				err,
 				"error updating image %q to tag %q using Kustomize",
 				imgUpdate.Image,
-				tag,
+				image.Tag,
 			)
 		}
 		changeSummary = append(
@@ -80,9 +115,36 @@ func (k *kustomizer) apply(
 				"updated %s/kustomization.yaml to use image %s:%s",
 				imgUpdate.Path,
 				imgUpdate.Image,
-				tag,
+				image.Tag,
 			),
 		)
 	}
-	return changeSummary, nil
+
+	build := update.Kustomize.Build
+	if build == nil {
+		return changeSummary, nil
+	}
+	manifests, err := k.buildFn(filepath.Join(workingDir, build.Path))
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error building %q using Kustomize",
+			build.Path,
+		)
+	}
+	if err = k.writeManifestsFn(workingDir, build.OutputPath, manifests); err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error writing manifests built from %q",
+			build.Path,
+		)
+	}
+	return append(
+		changeSummary,
+		fmt.Sprintf(
+			"built %s to %s",
+			build.Path,
+			filepath.Join(build.OutputPath, renderedManifestsFile),
+		),
+	), nil
 }
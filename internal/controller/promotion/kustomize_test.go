@@ -70,18 +70,24 @@ func TestSelectKustomizeUpdates(t *testing.T) {
 
 func TestKustomizerApply(t *testing.T) {
 	const (
-		testImage = "fake-image"
-		testTag   = "fake-tag"
+		testImage  = "fake-image"
+		testTag    = "fake-tag"
+		testDigest = "fake-digest"
 	)
 	testCases := []struct {
 		name       string
-		setImageFn func(dir, image, tag string) error
+		kustomizer *kustomizer
+		useDigest  bool
+		noDigest   bool
+		build      *kargoapi.KustomizeBuild
 		assertions func(changes []string, err error)
 	}{
 		{
 			name: "error running kustomize edit set image",
-			setImageFn: func(string, string, string) error {
-				return errors.New("something went wrong")
+			kustomizer: &kustomizer{
+				setImageFn: func(string, string, string) error {
+					return errors.New("something went wrong")
+				},
 			},
 			assertions: func(_ []string, err error) {
 				require.Error(t, err)
@@ -91,29 +97,161 @@ func TestKustomizerApply(t *testing.T) {
 		},
 		{
 			name: "success",
-			setImageFn: func(string, string, string) error {
-				return nil
+			kustomizer: &kustomizer{
+				setImageFn: func(string, string, string) error {
+					return nil
+				},
 			},
 			assertions: func(changes []string, err error) {
 				require.NoError(t, err)
 				require.Len(t, changes, 1)
 			},
 		},
+		{
+			name: "error running kustomize edit set image with digest",
+			kustomizer: &kustomizer{
+				setImageDigestFn: func(string, string, string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			useDigest: true,
+			assertions: func(_ []string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error updating image")
+				require.Contains(t, err.Error(), "to digest")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "success with digest",
+			kustomizer: &kustomizer{
+				setImageDigestFn: func(_, image, digest string) error {
+					require.Equal(t, testImage, image)
+					require.Equal(t, testDigest, digest)
+					return nil
+				},
+			},
+			useDigest: true,
+			assertions: func(changes []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"updated fake-path/kustomization.yaml to use image " +
+							"fake-image@fake-digest",
+					},
+					changes,
+				)
+			},
+		},
+		{
+			name: "digest requested but not recorded in Freight",
+			kustomizer: &kustomizer{
+				setImageDigestFn: func(string, string, string) error {
+					require.Fail(t, "setImageDigestFn should not have been called")
+					return nil
+				},
+			},
+			useDigest: true,
+			noDigest:  true,
+			assertions: func(changes []string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, changes)
+			},
+		},
+		{
+			name: "error running kustomize build",
+			kustomizer: &kustomizer{
+				setImageFn: func(string, string, string) error {
+					return nil
+				},
+				buildFn: func(string) ([]byte, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			build: &kargoapi.KustomizeBuild{
+				Path: "fake-overlay",
+			},
+			assertions: func(_ []string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error building")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "error writing built manifests",
+			kustomizer: &kustomizer{
+				setImageFn: func(string, string, string) error {
+					return nil
+				},
+				buildFn: func(string) ([]byte, error) {
+					return []byte("fake-manifests"), nil
+				},
+				writeManifestsFn: func(string, string, []byte) error {
+					return errors.New("something went wrong")
+				},
+			},
+			build: &kargoapi.KustomizeBuild{
+				Path: "fake-overlay",
+			},
+			assertions: func(_ []string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error writing manifests")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "success with build",
+			kustomizer: &kustomizer{
+				setImageFn: func(string, string, string) error {
+					return nil
+				},
+				buildFn: func(dir string) ([]byte, error) {
+					require.Equal(t, "fake-overlay", dir)
+					return []byte("fake-manifests"), nil
+				},
+				writeManifestsFn: func(_, outputPath string, manifests []byte) error {
+					require.Equal(t, "fake-output", outputPath)
+					require.Equal(t, []byte("fake-manifests"), manifests)
+					return nil
+				},
+			},
+			build: &kargoapi.KustomizeBuild{
+				Path:       "fake-overlay",
+				OutputPath: "fake-output",
+			},
+			assertions: func(changes []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"updated fake-path/kustomization.yaml to use image " +
+							"fake-image:fake-tag",
+						"built fake-overlay to fake-output/all.yaml",
+					},
+					changes,
+				)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
+			digest := testDigest
+			if testCase.noDigest {
+				digest = ""
+			}
 			testCase.assertions(
-				(&kustomizer{
-					setImageFn: testCase.setImageFn,
-				}).apply(
+				testCase.kustomizer.apply(
 					kargoapi.GitRepoUpdate{
 						Kustomize: &kargoapi.KustomizePromotionMechanism{
 							Images: []kargoapi.KustomizeImageUpdate{
 								{
-									Image: testImage,
-									Path:  "fake-path",
+									Image:     testImage,
+									Path:      "fake-path",
+									UseDigest: testCase.useDigest,
 								},
 							},
+							Build: testCase.build,
 						},
 					},
 					kargoapi.SimpleFreight{
@@ -121,6 +259,7 @@ func TestKustomizerApply(t *testing.T) {
 							{
 								RepoURL: testImage,
 								Tag:     testTag,
+								Digest:  digest,
 							},
 						},
 					},
@@ -0,0 +1,92 @@
+package promotion
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"sigs.k8s.io/yaml"
+)
+
+type previewContextKey struct{}
+
+// Preview describes the changes that Mechanisms would make if a Promotion were
+// actually executed. Mechanisms executed using a context returned from
+// ContextWithPreview record their would-be changes in a Preview instead of
+// pushing to Git repositories or patching Argo CD Applications.
+type Preview struct {
+	// GitRepoDiffs describes the changes that would be committed to Git
+	// repositories.
+	GitRepoDiffs []GitRepoDiff
+	// ArgoCDAppDiffs describes the changes that would be made to the specs of
+	// Argo CD Applications.
+	ArgoCDAppDiffs []ArgoCDAppDiff
+	// Warnings describes any changes that cannot be previewed.
+	Warnings []string
+}
+
+// GitRepoDiff describes the changes that would be committed to a single branch
+// of a Git repository.
+type GitRepoDiff struct {
+	// RepoURL is the URL of the repository.
+	RepoURL string
+	// Branch is the branch to which changes would be committed.
+	Branch string
+	// CommitMessage is the message that would be used for the commit.
+	CommitMessage string
+	// Diff is a unified diff of the changes that would be committed. It is empty
+	// if there would be no changes.
+	Diff string
+}
+
+// ArgoCDAppDiff describes the changes that would be made to the spec of a
+// single Argo CD Application.
+type ArgoCDAppDiff struct {
+	// Namespace is the namespace of the Application.
+	Namespace string
+	// Name is the name of the Application.
+	Name string
+	// Diff is a unified diff of the Application's spec, rendered as YAML. It is
+	// empty if there would be no changes.
+	Diff string
+}
+
+// ContextWithPreview returns a context that, when passed to a Mechanism's
+// Promote function, causes the Mechanism to record the changes it would make
+// in the provided Preview instead of making them.
+func ContextWithPreview(ctx context.Context, preview *Preview) context.Context {
+	return context.WithValue(ctx, previewContextKey{}, preview)
+}
+
+// previewFromContext returns the Preview bound to the provided context, if
+// any. A nil return value indicates that Mechanisms should make changes as
+// usual.
+func previewFromContext(ctx context.Context) *Preview {
+	if preview, ok := ctx.Value(previewContextKey{}).(*Preview); ok {
+		return preview
+	}
+	return nil
+}
+
+// yamlDiff renders the provided objects as YAML and returns a unified diff of
+// the results. If the objects render identically, the empty string is
+// returned.
+func yamlDiff(fromName, toName string, from, to any) (string, error) {
+	fromBytes, err := yaml.Marshal(from)
+	if err != nil {
+		return "", errors.Wrap(err, "error marshaling original object to YAML")
+	}
+	toBytes, err := yaml.Marshal(to)
+	if err != nil {
+		return "", errors.Wrap(err, "error marshaling updated object to YAML")
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(strings.TrimSuffix(string(fromBytes), "\n")),
+		B:        difflib.SplitLines(strings.TrimSuffix(string(toBytes), "\n")),
+		FromFile: fromName,
+		ToFile:   toName,
+		Context:  3,
+	})
+	return diff, errors.Wrap(err, "error computing diff")
+}
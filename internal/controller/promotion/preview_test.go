@@ -0,0 +1,61 @@
+package promotion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextWithPreview(t *testing.T) {
+	require.Nil(t, previewFromContext(context.Background()))
+	preview := &Preview{}
+	require.Same(
+		t,
+		preview,
+		previewFromContext(ContextWithPreview(context.Background(), preview)),
+	)
+}
+
+func TestYAMLDiff(t *testing.T) {
+	type obj struct {
+		Foo string `json:"foo"`
+		Bar string `json:"bar"`
+	}
+	testCases := []struct {
+		name       string
+		from       obj
+		to         obj
+		assertions func(diff string, err error)
+	}{
+		{
+			name: "no differences",
+			from: obj{Foo: "foo", Bar: "bar"},
+			to:   obj{Foo: "foo", Bar: "bar"},
+			assertions: func(diff string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, diff)
+			},
+		},
+		{
+			name: "differences",
+			from: obj{Foo: "foo", Bar: "bar"},
+			to:   obj{Foo: "foo", Bar: "baz"},
+			assertions: func(diff string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"--- from\n+++ to\n@@ -1,2 +1,2 @@\n-bar: bar\n+bar: baz\n foo: foo\n",
+					diff,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				yamlDiff("from", "to", testCase.from, testCase.to),
+			)
+		})
+	}
+}
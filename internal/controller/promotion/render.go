@@ -33,6 +33,10 @@ type kargoRenderMechanism struct {
 		credType credentials.Type,
 		repo string,
 	) (credentials.Credentials, bool, error)
+	getGitUserFn func(
+		ctx context.Context,
+		namespace string,
+	) (credentials.GitUser, bool, error)
 	renderManifestsFn func(
 		context.Context,
 		render.Request,
@@ -49,6 +53,7 @@ func newKargoRenderMechanism(
 	b.doSingleUpdateFn = b.doSingleUpdate
 	b.getReadRefFn = getReadRef
 	b.getCredentialsFn = credentialsDB.Get
+	b.getGitUserFn = credentialsDB.GetGitUser
 	b.renderManifestsFn = renderService.RenderManifests
 	return b
 }
@@ -62,8 +67,9 @@ func (*kargoRenderMechanism) GetName() string {
 func (b *kargoRenderMechanism) Promote(
 	ctx context.Context,
 	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
 	newFreight kargoapi.SimpleFreight,
-) (kargoapi.SimpleFreight, error) {
+) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
 	updates := make([]kargoapi.GitRepoUpdate, 0, len(stage.Spec.PromotionMechanisms.GitRepoUpdates))
 	for _, update := range stage.Spec.PromotionMechanisms.GitRepoUpdates {
 		if update.Render != nil {
@@ -72,12 +78,65 @@ func (b *kargoRenderMechanism) Promote(
 	}
 
 	if len(updates) == 0 {
-		return newFreight, nil
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded),
+			newFreight, nil
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+
+	// Kargo Render authors its own commits and has no support for signing them.
+	// If the project has a signing key configured, every commit is expected to
+	// be signed, so we refuse to make any unsigned ones.
+	user, ok, err := b.getGitUserFn(ctx, stage.Namespace)
+	if err != nil {
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseErrored), newFreight,
+			errors.Wrapf(
+				err,
+				"error obtaining git user for namespace %q",
+				stage.Namespace,
+			)
+	}
+	if ok && user.SigningKey != "" {
+		err = errors.Errorf(
+			"a signing key is configured for project %q, but commits made using "+
+				"Kargo Render cannot be signed; use a Git-based promotion mechanism "+
+				"instead, or remove the signing key",
+			stage.Namespace,
+		)
+		status := promo.Status.DeepCopy()
+		for _, update := range updates {
+			recordStep(
+				status,
+				b.GetName(),
+				update.RepoURL,
+				kargoapi.PromotionPhaseErrored,
+				"",
+				err,
+			)
+		}
+		return status.WithPhase(kargoapi.PromotionPhaseErrored), newFreight, err
+	}
+
+	if preview := previewFromContext(ctx); preview != nil {
+		// Kargo Render cannot render manifests without also committing them, so
+		// there is nothing we can safely do here.
+		for _, update := range updates {
+			preview.Warnings = append(
+				preview.Warnings,
+				fmt.Sprintf(
+					"changes to branch %q of repo %q made using Kargo Render cannot "+
+						"be previewed",
+					update.WriteBranch,
+					update.RepoURL,
+				),
+			)
+		}
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded),
+			newFreight, nil
 	}
 
 	newFreight = *newFreight.DeepCopy()
 
-	logger := logging.LoggerFromContext(ctx)
 	logger.Debug("executing Kargo Render-based promotion mechanisms")
 
 	images := make([]string, len(newFreight.Images))
@@ -85,8 +144,8 @@ func (b *kargoRenderMechanism) Promote(
 		images[i] = fmt.Sprintf("%s:%s", image.RepoURL, image.Tag)
 	}
 
+	status := promo.Status.DeepCopy()
 	for _, update := range updates {
-		var err error
 		if newFreight, err = b.doSingleUpdateFn(
 			ctx,
 			stage.Namespace,
@@ -94,13 +153,29 @@ func (b *kargoRenderMechanism) Promote(
 			newFreight,
 			images,
 		); err != nil {
-			return newFreight, err
+			recordStep(
+				status,
+				b.GetName(),
+				update.RepoURL,
+				kargoapi.PromotionPhaseErrored,
+				"",
+				err,
+			)
+			return status, newFreight, err
 		}
+		recordStep(
+			status,
+			b.GetName(),
+			update.RepoURL,
+			kargoapi.PromotionPhaseSucceeded,
+			getHealthCheckCommit(newFreight, update.RepoURL),
+			nil,
+		)
 	}
 
 	logger.Debug("done executing Kargo Render-based promotion mechanisms")
 
-	return newFreight, nil
+	return status.WithPhase(kargoapi.PromotionPhaseSucceeded), newFreight, nil
 }
 
 // doSingleUpdateFn updates configuration in a single Git repository using
@@ -176,3 +251,14 @@ func (b *kargoRenderMechanism) doSingleUpdate(
 
 	return newFreight, nil
 }
+
+// getHealthCheckCommit returns the health check commit, if any, that was
+// recorded in the provided Freight for the specified Git repository.
+func getHealthCheckCommit(freight kargoapi.SimpleFreight, repoURL string) string {
+	for _, commit := range freight.Commits {
+		if commit.RepoURL == repoURL {
+			return commit.HealthCheckCommit
+		}
+	}
+	return ""
+}
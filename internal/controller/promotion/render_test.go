@@ -22,6 +22,7 @@ func TestNewKargoRenderMechanism(t *testing.T) {
 	require.NotNil(t, krpm.doSingleUpdateFn)
 	require.NotNil(t, krpm.getReadRefFn)
 	require.NotNil(t, krpm.getCredentialsFn)
+	require.NotNil(t, krpm.getGitUserFn)
 	require.NotNil(t, krpm.renderManifestsFn)
 }
 
@@ -36,6 +37,7 @@ func TestKargoRenderPromote(t *testing.T) {
 		stage      *kargoapi.Stage
 		newFreight kargoapi.SimpleFreight
 		assertions func(
+			status *kargoapi.PromotionStatus,
 			newFreightIn kargoapi.SimpleFreight,
 			newFreightOut kargoapi.SimpleFreight,
 			err error,
@@ -50,6 +52,7 @@ func TestKargoRenderPromote(t *testing.T) {
 				},
 			},
 			assertions: func(
+				_ *kargoapi.PromotionStatus,
 				newFreightIn kargoapi.SimpleFreight,
 				newFreightOut kargoapi.SimpleFreight,
 				err error,
@@ -58,9 +61,49 @@ func TestKargoRenderPromote(t *testing.T) {
 				require.Equal(t, newFreightIn, newFreightOut)
 			},
 		},
+		{
+			name: "error getting git user",
+			promoMech: &kargoRenderMechanism{
+				getGitUserFn: func(
+					context.Context,
+					string,
+				) (credentials.GitUser, bool, error) {
+					return credentials.GitUser{}, false,
+						errors.New("something went wrong")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						GitRepoUpdates: []kargoapi.GitRepoUpdate{
+							{
+								Render: &kargoapi.KargoRenderPromotionMechanism{},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(
+				_ *kargoapi.PromotionStatus,
+				newFreightIn kargoapi.SimpleFreight,
+				newFreightOut kargoapi.SimpleFreight,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error obtaining git user")
+				require.Contains(t, err.Error(), "something went wrong")
+				require.Equal(t, newFreightIn, newFreightOut)
+			},
+		},
 		{
 			name: "error applying update",
 			promoMech: &kargoRenderMechanism{
+				getGitUserFn: func(
+					context.Context,
+					string,
+				) (credentials.GitUser, bool, error) {
+					return credentials.GitUser{}, false, nil
+				},
 				doSingleUpdateFn: func(
 					_ context.Context,
 					_ string,
@@ -92,6 +135,7 @@ func TestKargoRenderPromote(t *testing.T) {
 				},
 			},
 			assertions: func(
+				_ *kargoapi.PromotionStatus,
 				newFreightIn kargoapi.SimpleFreight,
 				newFreightOut kargoapi.SimpleFreight,
 				err error,
@@ -104,6 +148,12 @@ func TestKargoRenderPromote(t *testing.T) {
 		{
 			name: "success",
 			promoMech: &kargoRenderMechanism{
+				getGitUserFn: func(
+					context.Context,
+					string,
+				) (credentials.GitUser, bool, error) {
+					return credentials.GitUser{}, false, nil
+				},
 				doSingleUpdateFn: func(
 					_ context.Context,
 					_ string,
@@ -135,6 +185,7 @@ func TestKargoRenderPromote(t *testing.T) {
 				},
 			},
 			assertions: func(
+				_ *kargoapi.PromotionStatus,
 				newFreightIn kargoapi.SimpleFreight,
 				newFreightOut kargoapi.SimpleFreight,
 				err error,
@@ -143,19 +194,114 @@ func TestKargoRenderPromote(t *testing.T) {
 				require.Equal(t, newFreightIn, newFreightOut)
 			},
 		},
+		{
+			name: "signing key configured",
+			promoMech: &kargoRenderMechanism{
+				getGitUserFn: func(
+					context.Context,
+					string,
+				) (credentials.GitUser, bool, error) {
+					return credentials.GitUser{
+						SigningKeyType: "ssh",
+						SigningKey:     "fake-key",
+					}, true, nil
+				},
+				doSingleUpdateFn: func(
+					context.Context,
+					string,
+					kargoapi.GitRepoUpdate,
+					kargoapi.SimpleFreight,
+					[]string,
+				) (kargoapi.SimpleFreight, error) {
+					require.Fail(t, "unsigned commits should not have been made")
+					return kargoapi.SimpleFreight{}, nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: &kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						GitRepoUpdates: []kargoapi.GitRepoUpdate{
+							{
+								RepoURL: "fake-url",
+								Render:  &kargoapi.KargoRenderPromotionMechanism{},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(
+				status *kargoapi.PromotionStatus,
+				newFreightIn kargoapi.SimpleFreight,
+				newFreightOut kargoapi.SimpleFreight,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "cannot be signed")
+				require.Equal(t, newFreightIn, newFreightOut)
+				require.Equal(t, kargoapi.PromotionPhaseErrored, status.Phase)
+				require.Len(t, status.Steps, 1)
+				require.Equal(t, "fake-url", status.Steps[0].Target)
+				require.Contains(t, status.Steps[0].Error, "cannot be signed")
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			newFreightOut, err := testCase.promoMech.Promote(
+			status, newFreightOut, err := testCase.promoMech.Promote(
 				context.Background(),
 				testCase.stage,
+				&kargoapi.Promotion{},
 				testCase.newFreight,
 			)
-			testCase.assertions(testCase.newFreight, newFreightOut, err)
+			testCase.assertions(status, testCase.newFreight, newFreightOut, err)
 		})
 	}
 }
 
+func TestKargoRenderPromotePreview(t *testing.T) {
+	promoMech := &kargoRenderMechanism{
+		getGitUserFn: func(
+			context.Context,
+			string,
+		) (credentials.GitUser, bool, error) {
+			return credentials.GitUser{}, false, nil
+		},
+		doSingleUpdateFn: func(
+			context.Context,
+			string,
+			kargoapi.GitRepoUpdate,
+			kargoapi.SimpleFreight,
+			[]string,
+		) (kargoapi.SimpleFreight, error) {
+			require.Fail(t, "Kargo Render should not be invoked when previewing")
+			return kargoapi.SimpleFreight{}, nil
+		},
+	}
+	preview := &Preview{}
+	status, _, err := promoMech.Promote(
+		ContextWithPreview(context.Background(), preview),
+		&kargoapi.Stage{
+			Spec: &kargoapi.StageSpec{
+				PromotionMechanisms: &kargoapi.PromotionMechanisms{
+					GitRepoUpdates: []kargoapi.GitRepoUpdate{
+						{
+							RepoURL:     "fake-url",
+							WriteBranch: "fake-branch",
+							Render:      &kargoapi.KargoRenderPromotionMechanism{},
+						},
+					},
+				},
+			},
+		},
+		&kargoapi.Promotion{},
+		kargoapi.SimpleFreight{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+	require.Len(t, preview.Warnings, 1)
+	require.Contains(t, preview.Warnings[0], "cannot be previewed")
+}
+
 func TestKargoRenderDoSingleUpdate(t *testing.T) {
 	const testRef = "fake-ref"
 	testCases := []struct {
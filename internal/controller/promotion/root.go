@@ -3,6 +3,7 @@ package promotion
 import (
 	"context"
 
+	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	render "github.com/akuity/kargo-render"
@@ -15,19 +16,26 @@ type Mechanism interface {
 	// GetName returns the name of a promotion mechanism.
 	GetName() string
 	// Promote consults rules in the provided Stage to perform some portion of the
-	// transition into the specified Freight. It returns the Freight, which may
-	// possibly be updated by the process.
+	// transition into the specified Freight on behalf of the provided Promotion.
+	// It returns an updated PromotionStatus and the Freight, which may possibly
+	// be updated by the process. A PromotionStatus with a phase of Running
+	// indicates that the transition cannot be completed yet and that Promote
+	// should be invoked again later.
 	Promote(
 		context.Context,
 		*kargoapi.Stage,
+		*kargoapi.Promotion,
 		kargoapi.SimpleFreight,
-	) (kargoapi.SimpleFreight, error)
+	) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error)
 }
 
 // NewMechanisms returns the entrypoint to a hierarchical tree of promotion
 // mechanisms.
 func NewMechanisms(
 	argoClient client.Client,
+	fluxClient client.Client,
+	kubeClient client.Client,
+	kubeClientset kubernetes.Interface,
 	credentialsDB credentials.Database,
 	renderService render.Service,
 ) Mechanism {
@@ -39,7 +47,13 @@ func NewMechanisms(
 			newKargoRenderMechanism(credentialsDB, renderService),
 			newKustomizeMechanism(credentialsDB),
 			newHelmMechanism(credentialsDB),
+			newHelmTemplateMechanism(credentialsDB),
+			newYAMLMechanism(credentialsDB),
 		),
 		newArgoCDMechanism(argoClient),
+		newFluxMechanism(fluxClient),
+		newKubernetesPatchMechanism(kubeClient),
+		newJobMechanism(kubeClientset),
+		newHTTPWebhookMechanism(kubeClient),
 	)
 }
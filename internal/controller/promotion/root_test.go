@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	render "github.com/akuity/kargo-render"
@@ -15,6 +16,9 @@ import (
 func TestNewMechanisms(t *testing.T) {
 	promoMechs := NewMechanisms(
 		fake.NewClientBuilder().Build(),
+		fake.NewClientBuilder().Build(),
+		fake.NewClientBuilder().Build(),
+		kubefake.NewSimpleClientset(),
 		credentials.NewKubernetesDatabase("", nil, nil),
 		render.NewService(nil),
 	)
@@ -28,8 +32,9 @@ type FakeMechanism struct {
 	PromoteFn func(
 		context.Context,
 		*kargoapi.Stage,
+		*kargoapi.Promotion,
 		kargoapi.SimpleFreight,
-	) (kargoapi.SimpleFreight, error)
+	) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error)
 }
 
 // GetName implements the Mechanism interface.
@@ -41,7 +46,8 @@ func (f *FakeMechanism) GetName() string {
 func (f *FakeMechanism) Promote(
 	ctx context.Context,
 	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
 	freight kargoapi.SimpleFreight,
-) (kargoapi.SimpleFreight, error) {
-	return f.PromoteFn(ctx, stage, freight)
+) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
+	return f.PromoteFn(ctx, stage, promo, freight)
 }
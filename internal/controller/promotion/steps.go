@@ -0,0 +1,61 @@
+package promotion
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// recordStep records the result of a single step -- the work done by the
+// specified promotion mechanism on the specified target -- in the provided
+// PromotionStatus. Because a Promotion is executed repeatedly until it reaches
+// a terminal phase, the same step is likely to be recorded many times. When it
+// is, the existing result is updated in place, retaining the time the step
+// was first started, the time it first finished, and any commit that was
+// previously recorded. The step is regarded as finished if the provided phase
+// is a terminal one. A non-nil error is recorded as the step's error and a nil
+// error clears any error that was previously recorded. The recorded result is
+// returned so that callers may add further detail to it.
+func recordStep(
+	status *kargoapi.PromotionStatus,
+	mechanism string,
+	target string,
+	phase kargoapi.PromotionPhase,
+	commit string,
+	err error,
+) *kargoapi.PromotionStepResult {
+	now := metav1.Now()
+	var step *kargoapi.PromotionStepResult
+	for i := range status.Steps {
+		if status.Steps[i].Mechanism == mechanism &&
+			status.Steps[i].Target == target {
+			step = &status.Steps[i]
+			break
+		}
+	}
+	if step == nil {
+		status.Steps = append(
+			status.Steps,
+			kargoapi.PromotionStepResult{
+				Mechanism: mechanism,
+				Target:    target,
+				StartedAt: &now,
+			},
+		)
+		step = &status.Steps[len(status.Steps)-1]
+	}
+	if step.StartedAt == nil {
+		step.StartedAt = &now
+	}
+	if step.FinishedAt == nil && phase.IsTerminal() {
+		step.FinishedAt = &now
+	}
+	if commit != "" {
+		step.Commit = commit
+	}
+	step.Error = ""
+	if err != nil {
+		step.Error = err.Error()
+	}
+	return step
+}
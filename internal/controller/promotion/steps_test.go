@@ -0,0 +1,118 @@
+package promotion
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestRecordStep(t *testing.T) {
+	testTime := metav1.Now()
+	testCases := []struct {
+		name       string
+		status     *kargoapi.PromotionStatus
+		phase      kargoapi.PromotionPhase
+		commit     string
+		err        error
+		assertions func(*kargoapi.PromotionStatus)
+	}{
+		{
+			name:   "new step in progress",
+			status: &kargoapi.PromotionStatus{},
+			phase:  kargoapi.PromotionPhaseRunning,
+			assertions: func(status *kargoapi.PromotionStatus) {
+				require.Len(t, status.Steps, 1)
+				step := status.Steps[0]
+				require.Equal(t, "fake-mechanism", step.Mechanism)
+				require.Equal(t, "fake-target", step.Target)
+				require.NotNil(t, step.StartedAt)
+				require.Nil(t, step.FinishedAt)
+				require.Empty(t, step.Commit)
+				require.Empty(t, step.Error)
+			},
+		},
+		{
+			name: "new step for a different target",
+			status: &kargoapi.PromotionStatus{
+				Steps: []kargoapi.PromotionStepResult{
+					{
+						Mechanism: "fake-mechanism",
+						Target:    "another-fake-target",
+					},
+				},
+			},
+			phase:  kargoapi.PromotionPhaseSucceeded,
+			commit: "fake-commit",
+			assertions: func(status *kargoapi.PromotionStatus) {
+				require.Len(t, status.Steps, 2)
+				step := status.Steps[1]
+				require.Equal(t, "fake-target", step.Target)
+				require.NotNil(t, step.StartedAt)
+				require.NotNil(t, step.FinishedAt)
+				require.Equal(t, "fake-commit", step.Commit)
+			},
+		},
+		{
+			name: "existing step fails",
+			status: &kargoapi.PromotionStatus{
+				Steps: []kargoapi.PromotionStepResult{
+					{
+						Mechanism: "fake-mechanism",
+						Target:    "fake-target",
+						StartedAt: &testTime,
+					},
+				},
+			},
+			phase: kargoapi.PromotionPhaseErrored,
+			err:   errors.New("something went wrong"),
+			assertions: func(status *kargoapi.PromotionStatus) {
+				require.Len(t, status.Steps, 1)
+				step := status.Steps[0]
+				require.Equal(t, &testTime, step.StartedAt)
+				require.NotNil(t, step.FinishedAt)
+				require.Equal(t, "something went wrong", step.Error)
+			},
+		},
+		{
+			name: "existing step is recorded again",
+			status: &kargoapi.PromotionStatus{
+				Steps: []kargoapi.PromotionStepResult{
+					{
+						Mechanism:  "fake-mechanism",
+						Target:     "fake-target",
+						StartedAt:  &testTime,
+						FinishedAt: &testTime,
+						Commit:     "fake-commit",
+						Error:      "something went wrong",
+					},
+				},
+			},
+			phase: kargoapi.PromotionPhaseSucceeded,
+			assertions: func(status *kargoapi.PromotionStatus) {
+				require.Len(t, status.Steps, 1)
+				step := status.Steps[0]
+				require.Equal(t, &testTime, step.StartedAt)
+				require.Equal(t, &testTime, step.FinishedAt)
+				require.Equal(t, "fake-commit", step.Commit)
+				require.Empty(t, step.Error)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recordStep(
+				testCase.status,
+				"fake-mechanism",
+				"fake-target",
+				testCase.phase,
+				testCase.commit,
+				testCase.err,
+			)
+			testCase.assertions(testCase.status)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package promotion
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/git"
+)
+
+// promotionTemplateData is the data made available to user-supplied templates,
+// such as those used for constructing commit messages, Kubernetes patches, and
+// webhook request bodies.
+type promotionTemplateData struct {
+	// Project is the name of the Project (namespace) of the Promotion.
+	Project string
+	// Stage is the name of the Stage into which Freight is being promoted.
+	Stage string
+	// Promotion is the name of the Promotion.
+	Promotion string
+	// Freight is the ID of the Freight being promoted.
+	Freight string
+	// Commits are the Git commits that make up the Freight.
+	Commits []kargoapi.GitCommit
+	// Images are the container images that make up the Freight.
+	Images []kargoapi.Image
+	// Charts are the Helm charts that make up the Freight.
+	Charts []kargoapi.Chart
+	// Changes is a summary of the changes that were made to a Git repository.
+	// It is only populated for commit message templates.
+	Changes []string
+}
+
+// newPromotionTemplateData returns the promotionTemplateData describing the
+// promotion of the provided Freight by the provided Promotion.
+func newPromotionTemplateData(
+	promo *kargoapi.Promotion,
+	freight kargoapi.SimpleFreight,
+) promotionTemplateData {
+	data := promotionTemplateData{
+		Freight: freight.ID,
+		Commits: freight.Commits,
+		Images:  freight.Images,
+		Charts:  freight.Charts,
+	}
+	if promo != nil {
+		data.Project = promo.Namespace
+		data.Promotion = promo.Name
+		if promo.Spec != nil {
+			data.Stage = promo.Spec.Stage
+			if data.Freight == "" {
+				data.Freight = promo.Spec.Freight
+			}
+		}
+	}
+	return data
+}
+
+// ImageTag returns the tag of the image from the specified repository, or the
+// empty string if the Freight contains no such image. It is intended for use
+// in templates; for instance: {{ .ImageTag "nginx" }}.
+func (p promotionTemplateData) ImageTag(repoURL string) string {
+	for _, image := range p.Images {
+		if image.RepoURL == repoURL {
+			return image.Tag
+		}
+	}
+	return ""
+}
+
+// CommitID returns the ID of the commit from the specified Git repository, or
+// the empty string if the Freight contains no such commit.
+func (p promotionTemplateData) CommitID(repoURL string) string {
+	for _, commit := range p.Commits {
+		if git.NormalizeGitURL(commit.RepoURL) == git.NormalizeGitURL(repoURL) {
+			return commit.ID
+		}
+	}
+	return ""
+}
+
+// ChartVersion returns the version of the specified chart from the specified
+// registry, or the empty string if the Freight contains no such chart.
+func (p promotionTemplateData) ChartVersion(registryURL, name string) string {
+	for _, chart := range p.Charts {
+		if chart.RegistryURL == registryURL && chart.Name == name {
+			return chart.Version
+		}
+	}
+	return ""
+}
+
+// TemplateFuncs returns the functions that are available to user-supplied
+// templates in addition to the methods of promotionTemplateData. They make it
+// possible to safely embed values that may contain characters with special
+// meaning in JSON, such as commit messages, in patches and request bodies:
+//
+//   - toJson encodes any value as JSON; for instance: {{ toJson .Commits }}.
+//   - quote encodes any value as a JSON string, including the surrounding
+//     quotes; for instance: {{ quote .Stage }}.
+//
+// It is exported so that templates can be validated before they are used.
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"toJson": toJSON,
+		"quote": func(v any) (string, error) {
+			return toJSON(fmt.Sprint(v))
+		},
+	}
+}
+
+// toJSON returns the JSON encoding of the provided value.
+func toJSON(v any) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// renderPromotionTemplate executes the provided template against the provided
+// data. The provided name describes the template in error messages.
+func renderPromotionTemplate(
+	name string,
+	tmpl string,
+	data promotionTemplateData,
+) ([]byte, error) {
+	t, err := template.New(name).
+		Option("missingkey=error").
+		Funcs(TemplateFuncs()).
+		Parse(tmpl)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing %s template", name)
+	}
+	buf := &strings.Builder{}
+	if err = t.Execute(buf, data); err != nil {
+		return nil, errors.Wrapf(err, "error executing %s template", name)
+	}
+	return []byte(buf.String()), nil
+}
@@ -0,0 +1,142 @@
+package promotion
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewPromotionTemplateData(t *testing.T) {
+	freight := kargoapi.SimpleFreight{
+		Commits: []kargoapi.GitCommit{{ID: "fake-commit-id"}},
+		Images:  []kargoapi.Image{{RepoURL: "fake-url"}},
+		Charts:  []kargoapi.Chart{{Name: "fake-chart"}},
+	}
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-promo",
+			Namespace: "fake-namespace",
+		},
+		Spec: &kargoapi.PromotionSpec{
+			Stage:   "fake-stage",
+			Freight: "fake-freight",
+		},
+	}
+	expected := promotionTemplateData{
+		Project:   "fake-namespace",
+		Stage:     "fake-stage",
+		Promotion: "fake-promo",
+		Freight:   "fake-freight",
+		Commits:   freight.Commits,
+		Images:    freight.Images,
+		Charts:    freight.Charts,
+	}
+	// The Freight's ID is used when present...
+	freight.ID = "fake-freight"
+	require.Equal(t, expected, newPromotionTemplateData(promo, freight))
+	// ...and the Promotion's reference to the Freight is used otherwise.
+	freight.ID = ""
+	require.Equal(t, expected, newPromotionTemplateData(promo, freight))
+}
+
+func TestPromotionTemplateData(t *testing.T) {
+	data := promotionTemplateData{
+		Commits: []kargoapi.GitCommit{
+			{
+				RepoURL: "https://github.com/example/repo.git",
+				ID:      "fake-commit",
+			},
+		},
+		Images: []kargoapi.Image{
+			{
+				RepoURL: "nginx",
+				Tag:     "1.25.0",
+			},
+		},
+		Charts: []kargoapi.Chart{
+			{
+				RegistryURL: "https://charts.example.com",
+				Name:        "fake-chart",
+				Version:     "1.0.0",
+			},
+		},
+	}
+	require.Equal(t, "1.25.0", data.ImageTag("nginx"))
+	require.Empty(t, data.ImageTag("redis"))
+	require.Equal(t, "fake-commit", data.CommitID("https://github.com/example/repo"))
+	require.Empty(t, data.CommitID("https://github.com/example/other-repo"))
+	require.Equal(
+		t,
+		"1.0.0",
+		data.ChartVersion("https://charts.example.com", "fake-chart"),
+	)
+	require.Empty(t, data.ChartVersion("https://charts.example.com", "other-chart"))
+}
+
+func TestRenderPromotionTemplate(t *testing.T) {
+	data := promotionTemplateData{
+		Stage: "fake-stage",
+		Commits: []kargoapi.GitCommit{
+			{
+				ID:      "fake-commit",
+				Message: `Say "hello" \ goodbye`,
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		tmpl       string
+		assertions func([]byte, error)
+	}{
+		{
+			name: "error parsing template",
+			tmpl: "{{ .Stage",
+			assertions: func(_ []byte, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing fake template")
+			},
+		},
+		{
+			name: "error executing template",
+			tmpl: "{{ .Bogus }}",
+			assertions: func(_ []byte, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error executing fake template")
+			},
+		},
+		{
+			name: "quote",
+			tmpl: `{"stage":{{ quote .Stage }},` +
+				`"message":{{ (index .Commits 0).Message | quote }}}`,
+			assertions: func(rendered []byte, err error) {
+				require.NoError(t, err)
+				require.True(t, json.Valid(rendered))
+				var body map[string]string
+				require.NoError(t, json.Unmarshal(rendered, &body))
+				require.Equal(t, "fake-stage", body["stage"])
+				require.Equal(t, `Say "hello" \ goodbye`, body["message"])
+			},
+		},
+		{
+			name: "toJson",
+			tmpl: `{"commits":{{ toJson .Commits }}}`,
+			assertions: func(rendered []byte, err error) {
+				require.NoError(t, err)
+				var body struct {
+					Commits []kargoapi.GitCommit `json:"commits"`
+				}
+				require.NoError(t, json.Unmarshal(rendered, &body))
+				require.Equal(t, data.Commits, body.Commits)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(renderPromotionTemplate("fake", testCase.tmpl, data))
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package promotion
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	libYAML "github.com/akuity/kargo/internal/yaml"
+)
+
+// newYAMLMechanism returns a gitMechanism that only only selects and performs
+// updates that involve setting arbitrary keys in arbitrary YAML files.
+func newYAMLMechanism(
+	credentialsDB credentials.Database,
+) Mechanism {
+	return newGitMechanism(
+		"YAML promotion mechanism",
+		credentialsDB,
+		selectYAMLUpdates,
+		(&yamlUpdater{
+			buildYAMLFilesChangesFn: buildYAMLFilesChanges,
+			setStringsInYAMLFileFn:  libYAML.SetStringsInFile,
+		}).apply,
+	)
+}
+
+// selectYAMLUpdates returns a subset of the given updates that involve setting
+// keys in YAML files.
+func selectYAMLUpdates(updates []kargoapi.GitRepoUpdate) []kargoapi.GitRepoUpdate {
+	selectedUpdates := make([]kargoapi.GitRepoUpdate, 0, len(updates))
+	for _, update := range updates {
+		if len(update.YAMLUpdates) > 0 {
+			selectedUpdates = append(selectedUpdates, update)
+		}
+	}
+	return selectedUpdates
+}
+
+// yamlUpdater is a helper struct whose sole purpose is to close over several
+// other functions that are used in the implementation of the apply() function.
+type yamlUpdater struct {
+	buildYAMLFilesChangesFn func(
+		kargoapi.SimpleFreight,
+		[]kargoapi.YAMLUpdate,
+	) (map[string]map[string]string, []string)
+	setStringsInYAMLFileFn func(file string, changes map[string]string) error
+}
+
+// apply carries out the provided update in the specified working directory.
+func (y *yamlUpdater) apply(
+	update kargoapi.GitRepoUpdate,
+	newFreight kargoapi.SimpleFreight,
+	_ string,
+	workingDir string,
+) ([]string, error) {
+	changesByFile, changeSummary :=
+		y.buildYAMLFilesChangesFn(newFreight, update.YAMLUpdates)
+	for file, changes := range changesByFile {
+		if err := y.setStringsInYAMLFileFn(
+			filepath.Join(workingDir, file),
+			changes,
+		); err != nil {
+			return nil, errors.Wrapf(err, "error updating values in file %q", file)
+		}
+	}
+	return changeSummary, nil
+}
+
+// buildYAMLFilesChanges takes a piece of Freight and a list of instructions
+// about changes that should be made to various YAML files and distills them
+// into a map of maps that indexes new values for each YAML file by file name
+// and key.
+func buildYAMLFilesChanges(
+	newFreight kargoapi.SimpleFreight,
+	yamlUpdates []kargoapi.YAMLUpdate,
+) (map[string]map[string]string, []string) {
+	imagesByRepo := make(map[string]kargoapi.Image, len(newFreight.Images))
+	for _, image := range newFreight.Images {
+		imagesByRepo[image.RepoURL] = image
+	}
+	commitsByRepo := make(map[string]string, len(newFreight.Commits))
+	for _, commit := range newFreight.Commits {
+		commitsByRepo[commit.RepoURL] = commit.ID
+	}
+	versionsByChart := make(map[string]string, len(newFreight.Charts))
+	for _, chart := range newFreight.Charts {
+		key := fmt.Sprintf("%s:%s", chart.RegistryURL, chart.Name)
+		versionsByChart[key] = chart.Version
+	}
+
+	changesByFile := make(map[string]map[string]string, len(yamlUpdates))
+	changeSummary := make([]string, 0, len(yamlUpdates))
+	for _, yamlUpdate := range yamlUpdates {
+		var value, summary string
+		switch yamlUpdate.Value {
+		case kargoapi.YAMLUpdateValueTypeImage,
+			kargoapi.YAMLUpdateValueTypeTag,
+			kargoapi.YAMLUpdateValueTypeDigest:
+			image, found := imagesByRepo[yamlUpdate.Image]
+			if !found {
+				// There's no change to make in this case.
+				continue
+			}
+			switch yamlUpdate.Value {
+			case kargoapi.YAMLUpdateValueTypeImage:
+				value = fmt.Sprintf("%s:%s", image.RepoURL, image.Tag)
+			case kargoapi.YAMLUpdateValueTypeTag:
+				value = image.Tag
+			default:
+				value = image.Digest
+			}
+			summary = fmt.Sprintf("image %s:%s", image.RepoURL, image.Tag)
+			if yamlUpdate.Value == kargoapi.YAMLUpdateValueTypeDigest {
+				summary = fmt.Sprintf("image %s@%s", image.RepoURL, image.Digest)
+			}
+		case kargoapi.YAMLUpdateValueTypeCommitID:
+			value = commitsByRepo[yamlUpdate.GitRepoURL]
+			summary = fmt.Sprintf("commit %s", value)
+		case kargoapi.YAMLUpdateValueTypeChartVersion:
+			value = versionsByChart[fmt.Sprintf(
+				"%s:%s",
+				yamlUpdate.ChartRegistryURL,
+				yamlUpdate.ChartName,
+			)]
+			summary = fmt.Sprintf("chart %s:%s", yamlUpdate.ChartName, value)
+		default:
+			// This really shouldn't happen, so we'll ignore it.
+			continue
+		}
+		if value == "" {
+			// There's no change to make in this case.
+			continue
+		}
+		if _, found := changesByFile[yamlUpdate.File]; !found {
+			changesByFile[yamlUpdate.File] = map[string]string{}
+		}
+		changesByFile[yamlUpdate.File][yamlUpdate.Key] = value
+		changeSummary = append(
+			changeSummary,
+			fmt.Sprintf("updated %s to use %s", yamlUpdate.File, summary),
+		)
+	}
+
+	return changesByFile, changeSummary
+}
@@ -0,0 +1,245 @@
+package promotion
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+)
+
+func TestNewYAMLMechanism(t *testing.T) {
+	pm := newYAMLMechanism(&credentials.FakeDB{})
+	ypm, ok := pm.(*gitMechanism)
+	require.True(t, ok)
+	require.NotNil(t, ypm.selectUpdatesFn)
+	require.NotNil(t, ypm.applyConfigManagementFn)
+}
+
+func TestSelectYAMLUpdates(t *testing.T) {
+	testCases := []struct {
+		name       string
+		updates    []kargoapi.GitRepoUpdate
+		assertions func(selectedUpdates []kargoapi.GitRepoUpdate)
+	}{
+		{
+			name: "no updates",
+			assertions: func(selectedUpdates []kargoapi.GitRepoUpdate) {
+				require.Empty(t, selectedUpdates)
+			},
+		},
+		{
+			name: "no yaml updates",
+			updates: []kargoapi.GitRepoUpdate{
+				{
+					RepoURL: "fake-url",
+				},
+			},
+			assertions: func(selectedUpdates []kargoapi.GitRepoUpdate) {
+				require.Empty(t, selectedUpdates)
+			},
+		},
+		{
+			name: "some yaml updates",
+			updates: []kargoapi.GitRepoUpdate{
+				{
+					RepoURL:   "fake-url",
+					Kustomize: &kargoapi.KustomizePromotionMechanism{},
+				},
+				{
+					RepoURL:     "fake-url",
+					YAMLUpdates: []kargoapi.YAMLUpdate{{}},
+				},
+				{
+					RepoURL: "fake-url",
+				},
+			},
+			assertions: func(selectedUpdates []kargoapi.GitRepoUpdate) {
+				require.Len(t, selectedUpdates, 1)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(selectYAMLUpdates(testCase.updates))
+		})
+	}
+}
+
+func TestYAMLUpdaterApply(t *testing.T) {
+	testCases := []struct {
+		name        string
+		yamlUpdater *yamlUpdater
+		assertions  func(changes []string, err error)
+	}{
+		{
+			name: "error updating file",
+			yamlUpdater: &yamlUpdater{
+				buildYAMLFilesChangesFn: func(
+					kargoapi.SimpleFreight,
+					[]kargoapi.YAMLUpdate,
+				) (map[string]map[string]string, []string) {
+					return map[string]map[string]string{
+						"fake-file.yaml": {
+							"fake-key": "fake-value",
+						},
+					}, nil
+				},
+				setStringsInYAMLFileFn: func(string, map[string]string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ []string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error updating values in file")
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			yamlUpdater: &yamlUpdater{
+				buildYAMLFilesChangesFn: func(
+					kargoapi.SimpleFreight,
+					[]kargoapi.YAMLUpdate,
+				) (map[string]map[string]string, []string) {
+					return map[string]map[string]string{
+						"fake-file.yaml": {
+							"fake-key": "fake-value",
+						},
+					}, []string{"fake-update"}
+				},
+				setStringsInYAMLFileFn: func(string, map[string]string) error {
+					return nil
+				},
+			},
+			assertions: func(changes []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"fake-update"}, changes)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.yamlUpdater.apply(
+					kargoapi.GitRepoUpdate{
+						YAMLUpdates: []kargoapi.YAMLUpdate{{}},
+					},
+					kargoapi.SimpleFreight{}, // The way the tests are structured, this value doesn't matter
+					"",
+					"",
+				),
+			)
+		})
+	}
+}
+
+func TestBuildYAMLFilesChanges(t *testing.T) {
+	freight := kargoapi.SimpleFreight{
+		Commits: []kargoapi.GitCommit{
+			{
+				RepoURL: "fake-git-url",
+				ID:      "fake-commit-id",
+			},
+		},
+		Images: []kargoapi.Image{
+			{
+				RepoURL: "fake-url",
+				Tag:     "fake-tag",
+				Digest:  "fake-digest",
+			},
+			{
+				RepoURL: "another-fake-url",
+				Tag:     "another-fake-tag",
+			},
+		},
+		Charts: []kargoapi.Chart{
+			{
+				RegistryURL: "fake-registry",
+				Name:        "fake-chart",
+				Version:     "fake-version",
+			},
+		},
+	}
+	yamlUpdates := []kargoapi.YAMLUpdate{
+		{
+			File:  "fake-file.yaml",
+			Key:   "fake-key",
+			Value: kargoapi.YAMLUpdateValueTypeImage,
+			Image: "fake-url",
+		},
+		{
+			File:  "fake-file.yaml",
+			Key:   "another-fake-key",
+			Value: kargoapi.YAMLUpdateValueTypeTag,
+			Image: "another-fake-url",
+		},
+		{
+			File:  "another-fake-file.yaml",
+			Key:   "fake-key",
+			Value: kargoapi.YAMLUpdateValueTypeDigest,
+			Image: "fake-url",
+		},
+		{
+			File:       "another-fake-file.yaml",
+			Key:        "another-fake-key",
+			Value:      kargoapi.YAMLUpdateValueTypeCommitID,
+			GitRepoURL: "fake-git-url",
+		},
+		{
+			File:             "another-fake-file.yaml",
+			Key:              "yet-another-fake-key",
+			Value:            kargoapi.YAMLUpdateValueTypeChartVersion,
+			ChartRegistryURL: "fake-registry",
+			ChartName:        "fake-chart",
+		},
+		{
+			// This image has no digest, so there is no change to make
+			File:  "yet-another-fake-file.yaml",
+			Key:   "fake-key",
+			Value: kargoapi.YAMLUpdateValueTypeDigest,
+			Image: "another-fake-url",
+		},
+		{
+			File:  "yet-another-fake-file.yaml",
+			Key:   "fake-key",
+			Value: kargoapi.YAMLUpdateValueTypeTag,
+			Image: "image-that-is-not-in-list",
+		},
+		{
+			File:       "yet-another-fake-file.yaml",
+			Key:        "fake-key",
+			Value:      kargoapi.YAMLUpdateValueTypeCommitID,
+			GitRepoURL: "repo-that-is-not-in-list",
+		},
+	}
+	result, changeSummary := buildYAMLFilesChanges(freight, yamlUpdates)
+	require.Equal(
+		t,
+		map[string]map[string]string{
+			"fake-file.yaml": {
+				"fake-key":         "fake-url:fake-tag",
+				"another-fake-key": "another-fake-tag",
+			},
+			"another-fake-file.yaml": {
+				"fake-key":             "fake-digest",
+				"another-fake-key":     "fake-commit-id",
+				"yet-another-fake-key": "fake-version",
+			},
+		},
+		result,
+	)
+	require.Equal(
+		t,
+		[]string{
+			"updated fake-file.yaml to use image fake-url:fake-tag",
+			"updated fake-file.yaml to use image another-fake-url:another-fake-tag",
+			"updated another-fake-file.yaml to use image fake-url@fake-digest",
+			"updated another-fake-file.yaml to use commit fake-commit-id",
+			"updated another-fake-file.yaml to use chart fake-chart:fake-version",
+		},
+		changeSummary,
+	)
+}
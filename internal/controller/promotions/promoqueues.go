@@ -49,7 +49,10 @@ func (pqs *promoQueues) initializeQueues(ctx context.Context, promos kargoapi.Pr
 	logger := logging.LoggerFromContext(ctx)
 	for _, promo := range promos.Items {
 		promo := promo // This is to sidestep implicit memory aliasing in this for loop
-		if promo.Status.Phase.IsTerminal() || promo.Spec == nil {
+		// A Promotion that is awaiting approval will join its Stage's queue once
+		// it has been approved.
+		if promo.Status.Phase.IsTerminal() || promo.Spec == nil ||
+			promo.Status.AwaitingApproval {
 			continue
 		}
 		stage := types.NamespacedName{
@@ -136,6 +139,60 @@ func (pqs *promoQueues) tryBegin(ctx context.Context, promo *kargoapi.Promotion)
 	return false
 }
 
+// supersede ensures the given Pending promotion is in its Stage's queue and
+// then removes from that queue every promotion except the newest, which may or
+// may not be the given promotion. It returns the name of the newest promotion
+// along with the promotions that were removed. The caller is responsible for
+// moving the removed promotions to a terminal phase. If the given promotion is
+// already active, nothing is removed.
+func (pqs *promoQueues) supersede(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) (string, []*kargoapi.Promotion) {
+	if promo == nil || promo.Spec == nil {
+		return "", nil
+	}
+	stageKey := types.NamespacedName{
+		Namespace: promo.Namespace,
+		Name:      promo.Spec.Stage,
+	}
+	logger := logging.LoggerFromContext(ctx)
+
+	pqs.promoQueuesByStageMu.Lock()
+	defer pqs.promoQueuesByStageMu.Unlock()
+
+	if pqs.activePromoByStage[stageKey] == promo.Name {
+		return promo.Name, nil
+	}
+
+	pq, ok := pqs.pendingPromoQueuesByStage[stageKey]
+	if !ok {
+		pq = newPriorityQueue()
+		pqs.pendingPromoQueuesByStage[stageKey] = pq
+	}
+	pq.Push(promo)
+
+	// The queue is ordered from oldest to newest, so everything that is popped
+	// before the last item is stale.
+	var superseded []*kargoapi.Promotion
+	var newest client.Object
+	for pq.Depth() > 0 {
+		if newest != nil {
+			superseded = append(superseded, newest.(*kargoapi.Promotion)) // nolint: forcetypeassert
+		}
+		newest = pq.Pop()
+	}
+	pq.Push(newest)
+	for _, p := range superseded {
+		logger.WithFields(log.Fields{
+			"promotion":    p.Name,
+			"namespace":    p.Namespace,
+			"supersededBy": newest.GetName(),
+		}).Debug("removed superseded Promotion from Stage-specific Promotion queue")
+	}
+	return newest.GetName(), superseded
+}
+
 // conclude removes the given active promotion entry for the given stage key.
 // This should only be called after the active promotion has become terminal.
 func (pqs *promoQueues) conclude(ctx context.Context, stageKey types.NamespacedName, promoName string) {
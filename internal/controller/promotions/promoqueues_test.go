@@ -92,6 +92,27 @@ func TestInitializeQueues(t *testing.T) {
 	require.Nil(t, pqs.pendingPromoQueuesByStage[barStageKey].Pop())
 }
 
+func TestInitializeQueuesAwaitingApproval(t *testing.T) {
+	pqs := promoQueues{
+		activePromoByStage:        map[types.NamespacedName]string{},
+		pendingPromoQueuesByStage: map[types.NamespacedName]runtime.PriorityQueue{},
+	}
+	awaitingApproval := newPromo(testNamespace, "a", "foo", v1alpha1.PromotionPhasePending, before)
+	awaitingApproval.Status.AwaitingApproval = true
+	pqs.initializeQueues(
+		context.Background(),
+		v1alpha1.PromotionList{
+			Items: []v1alpha1.Promotion{
+				*awaitingApproval,
+				*newPromo(testNamespace, "b", "foo", v1alpha1.PromotionPhasePending, now),
+			},
+		},
+	)
+	// The Promotion awaiting approval must not hold up the other one
+	require.Equal(t, 1, pqs.pendingPromoQueuesByStage[fooStageKey].Depth())
+	require.Equal(t, "b", pqs.pendingPromoQueuesByStage[fooStageKey].Pop().GetName())
+}
+
 func TestNewPromotionsQueue(t *testing.T) {
 	// runtime.PriorityQueue is already tested pretty well, so what we mainly
 	// want to assert here is that our function for establishing relative priority
@@ -165,6 +186,49 @@ func TestTryBegin(t *testing.T) {
 	require.Equal(t, 3, pqs.pendingPromoQueuesByStage[fooStageKey].Depth())
 }
 
+func TestSupersede(t *testing.T) {
+	pqs := promoQueues{
+		activePromoByStage:        map[types.NamespacedName]string{},
+		pendingPromoQueuesByStage: map[types.NamespacedName]runtime.PriorityQueue{},
+	}
+	pqs.initializeQueues(context.Background(), testPromos)
+
+	ctx := context.TODO()
+
+	// 1. nil promotion
+	newest, superseded := pqs.supersede(ctx, nil)
+	require.Empty(t, newest)
+	require.Empty(t, superseded)
+
+	// 2. Supersede from an old promo. Everything but the newest is removed from
+	// the queue, including the promo itself.
+	newest, superseded = pqs.supersede(ctx, newPromo(testNamespace, "b", "foo", "", now))
+	require.Equal(t, "d", newest)
+	supersededNames := make([]string, len(superseded))
+	for i, promo := range superseded {
+		supersededNames[i] = promo.Name
+	}
+	require.Equal(t, []string{"a", "b", "c"}, supersededNames)
+	require.Equal(t, 1, pqs.pendingPromoQueuesByStage[fooStageKey].Depth())
+
+	// 3. Supersede from a newer promo that was not yet in the queue
+	newest, superseded = pqs.supersede(
+		ctx,
+		newPromo(testNamespace, "e", "foo", "", metav1.Time{Time: after.Add(time.Second)}),
+	)
+	require.Equal(t, "e", newest)
+	require.Len(t, superseded, 1)
+	require.Equal(t, "d", superseded[0].Name)
+	require.Equal(t, 1, pqs.pendingPromoQueuesByStage[fooStageKey].Depth())
+
+	// 4. The active promo is never superseded, nor does it supersede anything
+	require.True(t, pqs.tryBegin(ctx, newPromo(testNamespace, "e", "foo", "", now)))
+	newest, superseded = pqs.supersede(ctx, newPromo(testNamespace, "e", "foo", "", now))
+	require.Equal(t, "e", newest)
+	require.Empty(t, superseded)
+	require.Equal(t, "e", pqs.activePromoByStage[fooStageKey])
+}
+
 func TestConclude(t *testing.T) {
 	pqs := promoQueues{
 		activePromoByStage:        map[types.NamespacedName]string{},
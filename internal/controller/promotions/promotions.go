@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -18,6 +21,7 @@ import (
 	"github.com/akuity/kargo/api/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller"
+	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/promotion"
 	"github.com/akuity/kargo/internal/controller/runtime"
 	"github.com/akuity/kargo/internal/credentials"
@@ -26,6 +30,14 @@ import (
 	"github.com/akuity/kargo/internal/logging"
 )
 
+// runningPromotionRequeueInterval is how long to wait before reconciling a
+// Promotion again when it has not completed yet.
+const runningPromotionRequeueInterval = 30 * time.Second
+
+// abortedByUserMessage is recorded in the status of a Promotion that was
+// aborted at a user's request.
+const abortedByUserMessage = "Promotion was aborted by a user"
+
 // reconciler reconciles Promotion resources.
 type reconciler struct {
 	kargoClient     client.Client
@@ -34,9 +46,35 @@ type reconciler struct {
 	pqs            *promoQueues
 	initializeOnce sync.Once
 
+	// cancelFns holds functions for cancelling the context of each Promotion
+	// whose promotion mechanisms are currently being executed.
+	cancelFns map[types.NamespacedName]context.CancelFunc
+	// cancelFnsMu protects access to cancelFns
+	cancelFnsMu sync.Mutex
+
 	// The following behaviors are overridable for testing purposes:
 
-	promoteFn func(context.Context, kargoapi.Promotion) error
+	promoteFn func(
+		context.Context,
+		kargoapi.Promotion,
+	) (*kargoapi.PromotionStatus, error)
+
+	getRetryPolicyFn func(
+		context.Context,
+		kargoapi.Promotion,
+	) (*kargoapi.PromotionRetryPolicy, error)
+
+	getApprovalRequirementFn func(
+		context.Context,
+		kargoapi.Promotion,
+	) (*kargoapi.ApprovalRequirement, error)
+
+	supersedesStalePromotionsFn func(
+		context.Context,
+		kargoapi.Promotion,
+	) (bool, error)
+
+	deleteActiveJobsFn func(context.Context, *kargoapi.Promotion) error
 }
 
 // SetupReconcilerWithManager initializes a reconciler for Promotion resources
@@ -45,6 +83,7 @@ func SetupReconcilerWithManager(
 	ctx context.Context,
 	kargoMgr manager.Manager,
 	argoMgr manager.Manager,
+	fluxClient client.Client,
 	credentialsDB credentials.Database,
 	renderService render.Service,
 	shardName string,
@@ -55,9 +94,16 @@ func SetupReconcilerWithManager(
 		return errors.Wrap(err, "error creating shard selector predicate")
 	}
 
+	kubeClientset, err := kubernetes.NewForConfig(kargoMgr.GetConfig())
+	if err != nil {
+		return errors.Wrap(err, "error creating Kubernetes clientset")
+	}
+
 	reconciler := newReconciler(
 		kargoMgr.GetClient(),
 		argoMgr.GetClient(),
+		fluxClient,
+		kubeClientset,
 		credentialsDB,
 		renderService,
 	)
@@ -65,6 +111,7 @@ func SetupReconcilerWithManager(
 	changePredicate := predicate.Or(
 		predicate.GenerationChangedPredicate{},
 		predicate.AnnotationChangedPredicate{},
+		promoApprovalsChanged,
 	)
 
 	c, err := ctrl.NewControllerManagedBy(kargoMgr).
@@ -90,12 +137,26 @@ func SetupReconcilerWithManager(
 		return errors.Wrap(err, "unable to watch Promotions")
 	}
 
+	// Watch for Promotions that a user has asked to abort and cancel their
+	// in-progress execution, if any. This cannot wait for the Promotion to be
+	// reconciled, since reconciliation of the Promotion is blocked for as long
+	// as its execution is in progress.
+	abortHandler := &AbortPromotionHandler{
+		logger:  logger,
+		abortFn: reconciler.cancelPromotion,
+	}
+	if err := c.Watch(&source.Kind{Type: &kargoapi.Promotion{}}, abortHandler); err != nil {
+		return errors.Wrap(err, "unable to watch Promotions")
+	}
+
 	return nil
 }
 
 func newReconciler(
 	kargoClient client.Client,
 	argoClient client.Client,
+	fluxClient client.Client,
+	kubeClientset kubernetes.Interface,
 	credentialsDB credentials.Database,
 	renderService render.Service,
 ) *reconciler {
@@ -106,13 +167,26 @@ func newReconciler(
 	r := &reconciler{
 		kargoClient: kargoClient,
 		pqs:         &pqs,
+		cancelFns:   map[types.NamespacedName]context.CancelFunc{},
 		promoMechanisms: promotion.NewMechanisms(
 			argoClient,
+			fluxClient,
+			kargoClient,
+			kubeClientset,
 			credentialsDB,
 			renderService,
 		),
 	}
 	r.promoteFn = r.promote
+	r.getRetryPolicyFn = r.getRetryPolicy
+	r.getApprovalRequirementFn = r.getApprovalRequirement
+	r.supersedesStalePromotionsFn = r.supersedesStalePromotions
+	r.deleteActiveJobsFn = func(
+		ctx context.Context,
+		promo *kargoapi.Promotion,
+	) error {
+		return promotion.DeleteActiveJobs(ctx, kubeClientset, promo)
+	}
 	return r
 }
 
@@ -168,18 +242,78 @@ func (r *reconciler) Reconcile(
 		return result, nil
 	}
 
+	if !promo.Status.Phase.IsTerminal() && promo.IsAbortRequested() {
+		// Whether it is Running or still Pending, a Promotion that a user asked to
+		// abort goes straight to Aborted. Becoming terminal releases its place in
+		// the Stage's queue.
+		logger.Debug("Promotion was aborted")
+		err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
+			status.Phase = kargoapi.PromotionPhaseAborted
+			status.Error = abortedByUserMessage
+		})
+		if err == nil {
+			r.deleteActiveJobs(ctx, promo)
+		}
+		return result, err
+	}
+
 	if promo.Status.Phase == v1alpha1.PromotionPhaseRunning {
 		// anything we've already marked Running, we allow it to continue to reconcile
 	} else if promo.Status.Phase.IsTerminal() {
 		// if promo is already finished, nothing to do
 		return result, nil
 	} else {
-		// promo is Pending. Try to begin it.
+		// promo is Pending. It may not join the Stage's queue until it has
+		// received any approvals the Stage requires. Recording an approval
+		// triggers reconciliation, so there is no need to requeue.
+		requirement, err := r.getApprovalRequirementFn(ctx, *promo)
+		if err != nil {
+			return result, err
+		}
+		if !requirement.IsSatisfiedBy(promo.Status.Approvals) {
+			logger.Debug("Promotion is awaiting approval")
+			if promo.Status.Phase != v1alpha1.PromotionPhasePending ||
+				!promo.Status.AwaitingApproval {
+				err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
+					status.Phase = v1alpha1.PromotionPhasePending
+					status.AwaitingApproval = true
+				})
+			}
+			return result, err
+		}
+		// If the Stage is configured to do so, only the newest of its Pending
+		// Promotions may remain in its queue. The rest are superseded, which may
+		// include this one.
+		supersede, err := r.supersedesStalePromotionsFn(ctx, *promo)
+		if err != nil {
+			return result, err
+		}
+		if supersede {
+			newest, stale := r.pqs.supersede(ctx, promo)
+			for _, stalePromo := range stale {
+				if err = r.supersedePromotion(
+					ctx,
+					types.NamespacedName{
+						Namespace: stalePromo.Namespace,
+						Name:      stalePromo.Name,
+					},
+					newest,
+				); err != nil {
+					return result, err
+				}
+			}
+			if newest != promo.Name {
+				return result, nil
+			}
+		}
+		// Try to begin it.
 		if !r.pqs.tryBegin(ctx, promo) {
 			// It wasn't our turn. Mark this promo as Pending (if it wasn't already)
-			if promo.Status.Phase != v1alpha1.PromotionPhasePending {
+			if promo.Status.Phase != v1alpha1.PromotionPhasePending ||
+				promo.Status.AwaitingApproval {
 				err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
 					status.Phase = v1alpha1.PromotionPhasePending
+					status.AwaitingApproval = false
 				})
 				return result, err
 			}
@@ -198,15 +332,23 @@ func (r *reconciler) Reconcile(
 	if promo.Status.Phase != v1alpha1.PromotionPhaseRunning {
 		if err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
 			status.Phase = v1alpha1.PromotionPhaseRunning
+			status.AwaitingApproval = false
+			now := metav1.Now()
+			status.StartedAt = &now
 		}); err != nil {
 			return result, err
 		}
 	}
 
-	promoCtx := logging.ContextWithLogger(ctx, logger)
+	// The context is cancellable so that the Promotion can be aborted while its
+	// promotion mechanisms are executing.
+	promoCtx, cancel := context.WithCancel(logging.ContextWithLogger(ctx, logger))
+	defer cancel()
+	r.setCancelFn(req.NamespacedName, cancel)
+	defer r.clearCancelFn(req.NamespacedName)
 
-	phase := kargoapi.PromotionPhaseSucceeded
-	phaseError := ""
+	var newStatus *kargoapi.PromotionStatus
+	var promoteErr error
 
 	// Wrap the promoteFn() call in an anonymous function to recover() any panics, so
 	// we can update the promo's phase with Error if it does. This breaks an infinite
@@ -215,27 +357,99 @@ func (r *reconciler) Reconcile(
 		defer func() {
 			if err := recover(); err != nil {
 				logger.Errorf("Promotion panic: %v", err)
-				phase = kargoapi.PromotionPhaseErrored
-				phaseError = fmt.Sprintf("%v", err)
+				newStatus = promo.Status.WithPhase(kargoapi.PromotionPhaseErrored)
+				newStatus.Error = fmt.Sprintf("%v", err)
 			}
 		}()
-		if err = r.promoteFn(
+		if newStatus, promoteErr = r.promoteFn(
 			promoCtx,
 			*promo,
-		); err != nil {
-			phase = kargoapi.PromotionPhaseErrored
-			phaseError = err.Error()
-			logger.Errorf("error executing Promotion: %s", err)
+		); promoteErr != nil {
+			// The returned status, if any, may still carry information worth
+			// recording, such as a pull request that was closed without being
+			// merged.
+			if newStatus == nil {
+				newStatus = promo.Status.DeepCopy()
+			}
+			newStatus.Phase = kargoapi.PromotionPhaseErrored
+			newStatus.Error = promoteErr.Error()
+			logger.Errorf("error executing Promotion: %s", promoteErr)
 		}
 	}()
 
-	if phase.IsTerminal() {
-		logger.Debugf("promotion %s", phase)
+	// If the Promotion's context was cancelled while the context it was derived
+	// from was not, a user aborted the Promotion. Unless it managed to succeed
+	// anyway, whatever caused it to stop is of no further interest.
+	if promoCtx.Err() != nil && ctx.Err() == nil &&
+		newStatus.Phase != kargoapi.PromotionPhaseSucceeded {
+		logger.Debug("Promotion was aborted")
+		newStatus = newStatus.WithPhase(kargoapi.PromotionPhaseAborted)
+		newStatus.Error = abortedByUserMessage
+	}
+
+	// An error left behind by a previous, failed attempt is no longer of
+	// interest once an attempt has run without failing.
+	if newStatus.Phase == kargoapi.PromotionPhaseRunning ||
+		newStatus.Phase == kargoapi.PromotionPhaseSucceeded {
+		newStatus.Error = ""
+	}
+
+	var retryBackoff time.Duration
+	switch {
+	case newStatus.Phase == kargoapi.PromotionPhaseSucceeded:
+		now := metav1.Now()
+		newStatus.Attempts = append(
+			newStatus.Attempts,
+			kargoapi.PromotionAttempt{
+				Phase:      kargoapi.PromotionPhaseSucceeded,
+				FinishedAt: &now,
+			},
+		)
+	case newStatus.Phase == kargoapi.PromotionPhaseErrored && promoteErr != nil:
+		now := metav1.Now()
+		newStatus.Attempts = append(
+			newStatus.Attempts,
+			kargoapi.PromotionAttempt{
+				Phase:      kargoapi.PromotionPhaseErrored,
+				FinishedAt: &now,
+				Class:      promotion.GetErrorClass(promoteErr),
+				Error:      promoteErr.Error(),
+			},
+		)
+		if retryBackoff = r.getRetryBackoff(
+			ctx,
+			*promo,
+			len(newStatus.Attempts),
+			promoteErr,
+		); retryBackoff > 0 {
+			// The Promotion keeps its place at the head of the Stage's queue while
+			// it waits to be retried.
+			newStatus.Phase = kargoapi.PromotionPhaseRunning
+			resetForRetry(newStatus)
+		}
+	}
+
+	if newStatus.Phase == kargoapi.PromotionPhaseAborted {
+		// Whether it was aborted by a user or timed out, nothing the Promotion
+		// started should keep running.
+		r.deleteActiveJobs(ctx, promo)
+	}
+
+	if newStatus.Phase.IsTerminal() {
+		logger.Debugf("promotion %s", newStatus.Phase)
+	} else if retryBackoff > 0 {
+		logger.Debugf("promotion failed; will retry in %s", retryBackoff)
+		result.RequeueAfter = retryBackoff
+	} else {
+		// The Promotion is waiting on something external to complete (e.g. a pull
+		// request to be merged). Changes to the Promotion's status do not trigger
+		// reconciliation, so we check back periodically.
+		logger.Debug("promotion has not completed yet; will check again later")
+		result.RequeueAfter = runningPromotionRequeueInterval
 	}
 
 	err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
-		status.Phase = phase
-		status.Error = phaseError
+		*status = *newStatus
 	})
 	if err != nil {
 		logger.Errorf("error updating Promotion status: %s", err)
@@ -248,7 +462,7 @@ func (r *reconciler) Reconcile(
 func (r *reconciler) promote(
 	ctx context.Context,
 	promo kargoapi.Promotion,
-) error {
+) (*kargoapi.PromotionStatus, error) {
 	logger := logging.LoggerFromContext(ctx)
 	stageName := promo.Spec.Stage
 	stageNamespace := promo.Namespace
@@ -263,7 +477,7 @@ func (r *reconciler) promote(
 		},
 	)
 	if err != nil {
-		return errors.Wrapf(
+		return nil, errors.Wrapf(
 			err,
 			"error finding Stage %q in namespace %q",
 			stageName,
@@ -271,7 +485,7 @@ func (r *reconciler) promote(
 		)
 	}
 	if stage == nil {
-		return errors.Errorf(
+		return nil, errors.Errorf(
 			"could not find Stage %q in namespace %q",
 			stageName,
 			stageNamespace,
@@ -281,53 +495,52 @@ func (r *reconciler) promote(
 
 	if stage.Status.CurrentFreight != nil && stage.Status.CurrentFreight.ID == freightName {
 		logger.Debug("Stage already has the desired Freight")
-		return nil
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), nil
 	}
 
-	upstreamStages := make([]string, len(stage.Spec.Subscriptions.UpstreamStages))
-	for i, upstreamStage := range stage.Spec.Subscriptions.UpstreamStages {
-		upstreamStages[i] = upstreamStage.Name
-	}
-	targetFreight, err := kargoapi.GetQualifiedFreight(
-		ctx,
-		r.kargoClient,
-		types.NamespacedName{
-			Namespace: promo.Namespace,
-			Name:      promo.Spec.Freight,
-		},
-		upstreamStages,
-	)
-	if err != nil {
-		return err
-	}
-	if targetFreight == nil {
-		return errors.Errorf(
-			"no qualified Freight %q found in namespace %q",
-			promo.Spec.Freight,
-			promo.Namespace,
-		)
+	if timeout := stage.Spec.PromotionMechanisms.GetTimeout(); timeout > 0 &&
+		promo.Status.StartedAt != nil {
+		deadline := promo.Status.StartedAt.Add(timeout)
+		if !time.Now().Before(deadline) {
+			return timedOutStatus(promo.Status, timeout), nil
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, deadline)
+		defer cancel()
 	}
 
-	simpleTargetFreight := kargoapi.SimpleFreight{
-		ID:      targetFreight.ID,
-		Commits: targetFreight.Commits,
-		Images:  targetFreight.Images,
-		Charts:  targetFreight.Charts,
+	simpleTargetFreight, err := r.getTargetFreight(ctx, stage, promo)
+	if err != nil {
+		return nil, err
 	}
 
 	err = kubeclient.PatchStatus(ctx, r.kargoClient, stage, func(status *kargoapi.StageStatus) {
 		status.CurrentPromotion = &kargoapi.PromotionInfo{
 			Name:    promo.Name,
-			Freight: simpleTargetFreight,
+			Freight: *simpleTargetFreight,
 		}
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	nextFreight, err := r.promoMechanisms.Promote(ctx, stage, simpleTargetFreight)
+	newStatus, nextFreight, err :=
+		r.promoMechanisms.Promote(ctx, stage, &promo, *simpleTargetFreight)
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		if newStatus == nil {
+			newStatus = &promo.Status
+		}
+		return timedOutStatus(
+			*newStatus,
+			stage.Spec.PromotionMechanisms.GetTimeout(),
+		), nil
+	}
 	if err != nil {
-		return err
+		return newStatus, err
+	}
+	if newStatus.Phase != kargoapi.PromotionPhaseSucceeded {
+		// The Stage has not transitioned into the new Freight yet
+		return newStatus, nil
 	}
 
 	// The assumption is that controller does not process multiple promotions in one stage
@@ -344,10 +557,260 @@ func (r *reconciler) promote(
 		}
 	})
 
-	return errors.Wrapf(
+	return newStatus, errors.Wrapf(
 		err,
 		"error updating status of Stage %q in namespace %q",
 		stageName,
 		stageNamespace,
 	)
 }
+
+// getRetryPolicy returns the retry policy of the Stage that the provided
+// Promotion targets, if any.
+func (r *reconciler) getRetryPolicy(
+	ctx context.Context,
+	promo kargoapi.Promotion,
+) (*kargoapi.PromotionRetryPolicy, error) {
+	stage, err := r.getPromotionStage(ctx, promo)
+	if err != nil || stage == nil || stage.Spec == nil ||
+		stage.Spec.PromotionMechanisms == nil {
+		return nil, err
+	}
+	return stage.Spec.PromotionMechanisms.Retry, nil
+}
+
+// getApprovalRequirement returns the approval requirement of the Stage that
+// the provided Promotion targets, if any.
+func (r *reconciler) getApprovalRequirement(
+	ctx context.Context,
+	promo kargoapi.Promotion,
+) (*kargoapi.ApprovalRequirement, error) {
+	stage, err := r.getPromotionStage(ctx, promo)
+	if err != nil || stage == nil || stage.Spec == nil {
+		return nil, err
+	}
+	return stage.Spec.Approval, nil
+}
+
+// supersedesStalePromotions returns true if the Stage that the provided
+// Promotion targets is configured to supersede stale Promotions.
+func (r *reconciler) supersedesStalePromotions(
+	ctx context.Context,
+	promo kargoapi.Promotion,
+) (bool, error) {
+	stage, err := r.getPromotionStage(ctx, promo)
+	if err != nil || stage == nil || stage.Spec == nil {
+		return false, err
+	}
+	return stage.Spec.SupersedeStalePromotions, nil
+}
+
+// supersedePromotion moves the specified Promotion to the terminal Superseded
+// phase, recording the name of the Promotion that superseded it. Promotions
+// that no longer exist or that have already become terminal are left alone.
+func (r *reconciler) supersedePromotion(
+	ctx context.Context,
+	promoKey types.NamespacedName,
+	supersededBy string,
+) error {
+	promo, err := kargoapi.GetPromotion(ctx, r.kargoClient, promoKey)
+	if err != nil || promo == nil || promo.Status.Phase.IsTerminal() {
+		return err
+	}
+	logging.LoggerFromContext(ctx).WithFields(log.Fields{
+		"promotion":    promo.Name,
+		"supersededBy": supersededBy,
+	}).Debug("Promotion was superseded")
+	return kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
+		status.Phase = kargoapi.PromotionPhaseSuperseded
+		status.SupersededBy = supersededBy
+	})
+}
+
+// getPromotionStage returns the Stage that the provided Promotion targets. It
+// returns nil if no such Stage exists.
+func (r *reconciler) getPromotionStage(
+	ctx context.Context,
+	promo kargoapi.Promotion,
+) (*kargoapi.Stage, error) {
+	return kargoapi.GetStage(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Stage,
+		},
+	)
+}
+
+// getRetryBackoff returns how long to wait before retrying the provided
+// Promotion after the specified number of failed attempts, the latest of which
+// failed with the provided error. A return value of zero indicates that the
+// Promotion should not be retried.
+func (r *reconciler) getRetryBackoff(
+	ctx context.Context,
+	promo kargoapi.Promotion,
+	failedAttempts int,
+	err error,
+) time.Duration {
+	policy, policyErr := r.getRetryPolicyFn(ctx, promo)
+	if policyErr != nil {
+		logging.LoggerFromContext(ctx).Errorf(
+			"error getting retry policy for Promotion: %s",
+			policyErr,
+		)
+		return 0
+	}
+	if policy == nil || failedAttempts >= int(policy.MaxAttempts) ||
+		!policy.IsRetryable(promotion.GetErrorClass(err)) {
+		return 0
+	}
+	return policy.GetBackoff(failedAttempts)
+}
+
+// getTargetFreight returns the Freight that the provided Promotion transitions
+// the provided Stage into. Ordinarily, this must be Freight that is qualified
+// for at least one of the Stage's upstream Stages. A rollback Promotion,
+// however, may only transition the Stage into Freight from its own history,
+// regardless of whether that Freight is still qualified.
+func (r *reconciler) getTargetFreight(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo kargoapi.Promotion,
+) (*kargoapi.SimpleFreight, error) {
+	if promo.Spec.Rollback {
+		for _, freight := range stage.Status.History {
+			if freight.ID == promo.Spec.Freight {
+				return freight.DeepCopy(), nil
+			}
+		}
+		return nil, errors.Errorf(
+			"Freight %q not found in the history of Stage %q in namespace %q",
+			promo.Spec.Freight,
+			stage.Name,
+			stage.Namespace,
+		)
+	}
+
+	upstreamStages := make([]string, len(stage.Spec.Subscriptions.UpstreamStages))
+	for i, upstreamStage := range stage.Spec.Subscriptions.UpstreamStages {
+		upstreamStages[i] = upstreamStage.Name
+	}
+	targetFreight, err := kargoapi.GetQualifiedFreight(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: promo.Namespace,
+			Name:      promo.Spec.Freight,
+		},
+		upstreamStages,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if targetFreight == nil {
+		return nil, errors.Errorf(
+			"no qualified Freight %q found in namespace %q",
+			promo.Spec.Freight,
+			promo.Namespace,
+		)
+	}
+	return &kargoapi.SimpleFreight{
+		ID:      targetFreight.ID,
+		Commits: targetFreight.Commits,
+		Images:  targetFreight.Images,
+		Charts:  targetFreight.Charts,
+	}, nil
+}
+
+// cancelPromotion cancels the context of the specified Promotion if its
+// promotion mechanisms are currently being executed.
+func (r *reconciler) cancelPromotion(promoKey types.NamespacedName) {
+	r.cancelFnsMu.Lock()
+	defer r.cancelFnsMu.Unlock()
+	if cancel, ok := r.cancelFns[promoKey]; ok {
+		cancel()
+	}
+}
+
+func (r *reconciler) setCancelFn(
+	promoKey types.NamespacedName,
+	cancel context.CancelFunc,
+) {
+	r.cancelFnsMu.Lock()
+	defer r.cancelFnsMu.Unlock()
+	r.cancelFns[promoKey] = cancel
+}
+
+func (r *reconciler) clearCancelFn(promoKey types.NamespacedName) {
+	r.cancelFnsMu.Lock()
+	defer r.cancelFnsMu.Unlock()
+	delete(r.cancelFns, promoKey)
+}
+
+// resetForRetry discards everything the provided PromotionStatus records about
+// work that did not succeed during the attempt that just failed, so that the
+// next attempt starts that work over instead of picking up where the failed
+// attempt left off (and failing again immediately). Work that did succeed is
+// retained so that it is not repeated. Pull requests, Jobs, and Argo CD sync
+// operations created by the next attempt are distinguishable from those created
+// by earlier attempts (see PromotionStatus.CurrentAttempt).
+func resetForRetry(status *kargoapi.PromotionStatus) {
+	var pullRequests []kargoapi.PullRequestInfo
+	for _, pr := range status.PullRequests {
+		if pr.State == kargoapi.PullRequestStateMerged {
+			pullRequests = append(pullRequests, pr)
+		}
+	}
+	status.PullRequests = pullRequests
+	var appSyncs []kargoapi.ArgoCDAppSyncInfo
+	for _, appSync := range status.ArgoCDAppSyncs {
+		if appSync.Phase == string(argocd.OperationSucceeded) {
+			appSyncs = append(appSyncs, appSync)
+		}
+	}
+	status.ArgoCDAppSyncs = appSyncs
+	var webhookResults []kargoapi.HTTPWebhookResult
+	for _, result := range status.HTTPWebhookResults {
+		if result.Succeeded {
+			webhookResults = append(webhookResults, result)
+		}
+	}
+	status.HTTPWebhookResults = webhookResults
+	var steps []kargoapi.PromotionStepResult
+	for _, step := range status.Steps {
+		if step.FinishedAt != nil && step.Error == "" {
+			steps = append(steps, step)
+		}
+	}
+	status.Steps = steps
+}
+
+// deleteActiveJobs deletes any Jobs that the provided Promotion started and that
+// are still running. Failing to do so is not fatal, since such Jobs are owned
+// by the Promotion and are garbage collected along with it.
+func (r *reconciler) deleteActiveJobs(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) {
+	if err := r.deleteActiveJobsFn(ctx, promo); err != nil {
+		logging.LoggerFromContext(ctx).Errorf(
+			"error deleting active Jobs of aborted Promotion: %s",
+			err,
+		)
+	}
+}
+
+// timedOutStatus returns a copy of the provided PromotionStatus that reflects
+// the Promotion having exceeded the specified timeout.
+func timedOutStatus(
+	status kargoapi.PromotionStatus,
+	timeout time.Duration,
+) *kargoapi.PromotionStatus {
+	newStatus := status.WithPhase(kargoapi.PromotionPhaseAborted)
+	newStatus.Error = fmt.Sprintf(
+		"Promotion did not complete within the Stage's promotion timeout of %s",
+		timeout,
+	)
+	return newStatus
+}
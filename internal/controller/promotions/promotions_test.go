@@ -3,11 +3,15 @@ package promotions
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -15,6 +19,7 @@ import (
 	render "github.com/akuity/kargo-render"
 	"github.com/akuity/kargo/api/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/promotion"
 	"github.com/akuity/kargo/internal/credentials"
 )
 
@@ -23,6 +28,8 @@ func TestNewPromotionReconciler(t *testing.T) {
 	r := newReconciler(
 		kubeClient,
 		kubeClient,
+		kubeClient,
+		kubefake.NewSimpleClientset(),
 		&credentials.FakeDB{},
 		render.NewService(nil),
 	)
@@ -39,6 +46,8 @@ func newFakeReconciler(t *testing.T, objects ...client.Object) *reconciler {
 	return newReconciler(
 		kargoClient,
 		kubeClient,
+		kubeClient,
+		kubefake.NewSimpleClientset(),
 		&credentials.FakeDB{},
 		render.NewService(nil),
 	)
@@ -48,10 +57,14 @@ func TestReconcile(t *testing.T) {
 	testCases := []struct {
 		name                  string
 		promos                []client.Object
-		promoteFn             func(context.Context, v1alpha1.Promotion) error
+		promoteFn             func(
+			context.Context,
+			v1alpha1.Promotion,
+		) (*kargoapi.PromotionStatus, error)
 		promoToReconcile      *types.NamespacedName // if nil, uses the first of the promos
 		expectPromoteFnCalled bool
 		expectedPhase         kargoapi.PromotionPhase
+		expectRequeue         bool
 	}{
 		{
 			name:                  "normal reconcile",
@@ -109,7 +122,10 @@ func TestReconcile(t *testing.T) {
 			promos: []client.Object{
 				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, before),
 			},
-			promoteFn: func(ctx context.Context, p v1alpha1.Promotion) error {
+			promoteFn: func(
+				context.Context,
+				v1alpha1.Promotion,
+			) (*kargoapi.PromotionStatus, error) {
 				panic("expected panic")
 			},
 		},
@@ -120,8 +136,56 @@ func TestReconcile(t *testing.T) {
 			promos: []client.Object{
 				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, before),
 			},
-			promoteFn: func(ctx context.Context, p v1alpha1.Promotion) error {
-				return errors.New("expected error")
+			promoteFn: func(
+				context.Context,
+				v1alpha1.Promotion,
+			) (*kargoapi.PromotionStatus, error) {
+				return nil, errors.New("expected error")
+			},
+		},
+		{
+			name:                  "abort requested for pending promo",
+			expectPromoteFnCalled: false,
+			expectedPhase:         kargoapi.PromotionPhaseAborted,
+			promos: []client.Object{
+				withAbortRequested(
+					newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, before),
+				),
+			},
+		},
+		{
+			name:                  "abort requested for running promo",
+			expectPromoteFnCalled: false,
+			expectedPhase:         kargoapi.PromotionPhaseAborted,
+			promos: []client.Object{
+				withAbortRequested(
+					newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, before),
+				),
+			},
+		},
+		{
+			name:                  "abort requested for completed promo",
+			expectPromoteFnCalled: false,
+			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
+			promos: []client.Object{
+				withAbortRequested(
+					newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseSucceeded, before),
+				),
+			},
+		},
+		{
+			name:                  "promoteFn has not completed",
+			expectPromoteFnCalled: true,
+			expectedPhase:         kargoapi.PromotionPhaseRunning,
+			expectRequeue:         true,
+			promos: []client.Object{
+				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, before),
+			},
+			promoteFn: func(
+				_ context.Context,
+				p v1alpha1.Promotion,
+			) (*kargoapi.PromotionStatus, error) {
+				return p.Status.WithPhase(kargoapi.PromotionPhaseRunning), nil
 			},
 		},
 	}
@@ -134,12 +198,15 @@ func TestReconcile(t *testing.T) {
 				require.NoError(t, err)
 			}
 			promoteWasCalled := false
-			r.promoteFn = func(ctx context.Context, p v1alpha1.Promotion) error {
+			r.promoteFn = func(
+				ctx context.Context,
+				p v1alpha1.Promotion,
+			) (*kargoapi.PromotionStatus, error) {
 				promoteWasCalled = true
 				if tc.promoteFn != nil {
 					return tc.promoteFn(ctx, p)
 				}
-				return nil
+				return p.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), nil
 			}
 			var req ctrl.Request
 			if tc.promoToReconcile != nil {
@@ -151,8 +218,9 @@ func TestReconcile(t *testing.T) {
 				}}
 			}
 
-			_, err := r.Reconcile(ctx, req)
+			result, err := r.Reconcile(ctx, req)
 			require.NoError(t, err)
+			require.Equal(t, tc.expectRequeue, result.RequeueAfter > 0)
 			require.Equal(t, tc.expectPromoteFnCalled, promoteWasCalled,
 				"promoteFn called: %t, expected %t", promoteWasCalled, tc.expectPromoteFnCalled)
 
@@ -166,6 +234,529 @@ func TestReconcile(t *testing.T) {
 	}
 }
 
+func withAbortRequested(promo *kargoapi.Promotion) *kargoapi.Promotion {
+	promo.Annotations = map[string]string{
+		kargoapi.AnnotationKeyAbort: now.UTC().Format(time.RFC3339),
+	}
+	return promo
+}
+
+// Tests that a Promotion aborted while its promotion mechanisms are executing
+// becomes Aborted
+func TestReconcileAbortInProgress(t *testing.T) {
+	ctx := context.TODO()
+	promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now)
+	r := newFakeReconciler(t, promo)
+	req := ctrl.Request{NamespacedName: types.NamespacedName{
+		Namespace: promo.Namespace,
+		Name:      promo.Name,
+	}}
+	r.promoteFn = func(
+		ctx context.Context,
+		p v1alpha1.Promotion,
+	) (*kargoapi.PromotionStatus, error) {
+		require.NotNil(t, p.Status.StartedAt)
+		// Simulate the abort handler reacting to a user's request
+		r.cancelPromotion(req.NamespacedName)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	var deletedActiveJobs bool
+	r.deleteActiveJobsFn = func(_ context.Context, p *kargoapi.Promotion) error {
+		require.Equal(t, promo.Name, p.Name)
+		deletedActiveJobs = true
+		return nil
+	}
+
+	result, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.Zero(t, result.RequeueAfter)
+
+	var updatedPromo kargoapi.Promotion
+	err = r.kargoClient.Get(ctx, req.NamespacedName, &updatedPromo)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionPhaseAborted, updatedPromo.Status.Phase)
+	require.Equal(t, abortedByUserMessage, updatedPromo.Status.Error)
+	require.Empty(t, r.cancelFns)
+	require.True(t, deletedActiveJobs)
+}
+
+// Tests that a Promotion that has exceeded its Stage's promotion timeout
+// becomes Aborted
+func TestPromoteTimeout(t *testing.T) {
+	ctx := context.TODO()
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-stage",
+			Namespace: "fake-namespace",
+		},
+		Spec: &kargoapi.StageSpec{
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{
+				Timeout: &metav1.Duration{Duration: time.Minute},
+			},
+		},
+	}
+	promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, before)
+	promo.Status.StartedAt = &metav1.Time{Time: now.Add(-time.Hour)}
+	r := newFakeReconciler(t, stage, promo)
+
+	status, err := r.promote(ctx, *promo)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionPhaseAborted, status.Phase)
+	require.Contains(t, status.Error, "promotion timeout of 1m0s")
+}
+
+// Tests that a Promotion that fails is retried according to its Stage's
+// retry policy
+func TestReconcileRetry(t *testing.T) {
+	testCases := []struct {
+		name             string
+		policy           *kargoapi.PromotionRetryPolicy
+		previousAttempts int
+		promoteErr       error
+		expectedPhase    kargoapi.PromotionPhase
+		expectedRequeue  time.Duration
+	}{
+		{
+			name:          "no retry policy",
+			promoteErr:    errors.New("something went wrong"),
+			expectedPhase: kargoapi.PromotionPhaseErrored,
+		},
+		{
+			name: "retryable failure",
+			policy: &kargoapi.PromotionRetryPolicy{
+				MaxAttempts: 3,
+				Backoff:     &metav1.Duration{Duration: time.Minute},
+			},
+			previousAttempts: 1,
+			promoteErr:       errors.New("something went wrong"),
+			expectedPhase:    kargoapi.PromotionPhaseRunning,
+			expectedRequeue:  2 * time.Minute,
+		},
+		{
+			name: "attempts exhausted",
+			policy: &kargoapi.PromotionRetryPolicy{
+				MaxAttempts: 3,
+			},
+			previousAttempts: 2,
+			promoteErr:       errors.New("something went wrong"),
+			expectedPhase:    kargoapi.PromotionPhaseErrored,
+		},
+		{
+			name: "failure not retryable",
+			policy: &kargoapi.PromotionRetryPolicy{
+				MaxAttempts: 3,
+				RetryOn: []kargoapi.PromotionErrorClass{
+					kargoapi.PromotionErrorClassGit,
+				},
+			},
+			promoteErr: &promotion.MechanismError{
+				Class: kargoapi.PromotionErrorClassArgoCD,
+				Err:   errors.New("something went wrong"),
+			},
+			expectedPhase: kargoapi.PromotionPhaseErrored,
+		},
+		{
+			name: "retried attempt succeeds",
+			policy: &kargoapi.PromotionRetryPolicy{
+				MaxAttempts: 3,
+			},
+			previousAttempts: 1,
+			expectedPhase:    kargoapi.PromotionPhaseSucceeded,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.TODO()
+			promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhaseRunning, before)
+			for i := 0; i < testCase.previousAttempts; i++ {
+				promo.Status.Attempts = append(
+					promo.Status.Attempts,
+					kargoapi.PromotionAttempt{Error: "something went wrong"},
+				)
+				promo.Status.Error = "something went wrong"
+			}
+			r := newFakeReconciler(t, promo)
+			r.promoteFn = func(
+				_ context.Context,
+				p v1alpha1.Promotion,
+			) (*kargoapi.PromotionStatus, error) {
+				if testCase.promoteErr != nil {
+					return nil, testCase.promoteErr
+				}
+				return p.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), nil
+			}
+			r.getRetryPolicyFn = func(
+				context.Context,
+				v1alpha1.Promotion,
+			) (*kargoapi.PromotionRetryPolicy, error) {
+				return testCase.policy, nil
+			}
+			req := ctrl.Request{NamespacedName: types.NamespacedName{
+				Namespace: promo.Namespace,
+				Name:      promo.Name,
+			}}
+
+			result, err := r.Reconcile(ctx, req)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedRequeue, result.RequeueAfter)
+
+			var updatedPromo kargoapi.Promotion
+			err = r.kargoClient.Get(ctx, req.NamespacedName, &updatedPromo)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedPhase, updatedPromo.Status.Phase)
+			require.Len(t, updatedPromo.Status.Attempts, testCase.previousAttempts+1)
+			attempt := updatedPromo.Status.Attempts[testCase.previousAttempts]
+			require.NotNil(t, attempt.FinishedAt)
+			if testCase.promoteErr == nil {
+				// The successful attempt is recorded as well
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, attempt.Phase)
+				require.Empty(t, attempt.Error)
+				require.Empty(t, updatedPromo.Status.Error)
+				return
+			}
+			require.Equal(t, kargoapi.PromotionPhaseErrored, attempt.Phase)
+			require.Equal(t, "something went wrong", attempt.Error)
+			require.Equal(t, promotion.GetErrorClass(testCase.promoteErr), attempt.Class)
+			require.Equal(t, "something went wrong", updatedPromo.Status.Error)
+		})
+	}
+}
+
+// Tests that a Promotion does not begin until it has received the approvals
+// required by its Stage
+// fakeMechanism is an implementation of the promotion.Mechanism interface whose
+// behavior is defined by a function.
+type fakeMechanism struct {
+	promoteFn func(
+		context.Context,
+		*kargoapi.Stage,
+		*kargoapi.Promotion,
+		kargoapi.SimpleFreight,
+	) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error)
+}
+
+func (f *fakeMechanism) GetName() string {
+	return "fake promotion mechanism"
+}
+
+func (f *fakeMechanism) Promote(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	freight kargoapi.SimpleFreight,
+) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
+	return f.promoteFn(ctx, stage, promo, freight)
+}
+
+// Tests that a retry re-runs the promotion mechanism that failed instead of
+// inheriting the failed attempt's state and failing again immediately
+func TestReconcileRetryRerunsFailedMechanism(t *testing.T) {
+	ctx := context.TODO()
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-stage",
+			Namespace: "fake-namespace",
+		},
+		Spec: &kargoapi.StageSpec{
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+		},
+		Status: kargoapi.StageStatus{
+			History: kargoapi.SimpleFreightStack{{ID: "fake-freight"}},
+		},
+	}
+	promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now)
+	promo.Spec.Freight = "fake-freight"
+	promo.Spec.Rollback = true
+	r := newFakeReconciler(t, stage, promo)
+	r.getRetryPolicyFn = func(
+		context.Context,
+		v1alpha1.Promotion,
+	) (*kargoapi.PromotionRetryPolicy, error) {
+		return &kargoapi.PromotionRetryPolicy{MaxAttempts: 2}, nil
+	}
+	// Like a mechanism that opens a pull request, this one only opens a new
+	// pull request if the Promotion's status does not already record one, and
+	// fails if the pull request it is tracking was closed without being merged.
+	var openedBranches []string
+	r.promoMechanisms = &fakeMechanism{
+		promoteFn: func(
+			_ context.Context,
+			_ *kargoapi.Stage,
+			p *kargoapi.Promotion,
+			freight kargoapi.SimpleFreight,
+		) (*kargoapi.PromotionStatus, kargoapi.SimpleFreight, error) {
+			status := p.Status.DeepCopy()
+			if len(status.PullRequests) == 0 {
+				branch := fmt.Sprintf("attempt-%d", p.Status.CurrentAttempt())
+				openedBranches = append(openedBranches, branch)
+				state := kargoapi.PullRequestStateMerged
+				if p.Status.CurrentAttempt() == 1 {
+					state = kargoapi.PullRequestStateClosed
+				}
+				status.PullRequests = []kargoapi.PullRequestInfo{
+					{SourceBranch: branch, State: state},
+				}
+			}
+			if status.PullRequests[0].State == kargoapi.PullRequestStateClosed {
+				status.Steps = []kargoapi.PromotionStepResult{
+					{
+						Mechanism:  "fake promotion mechanism",
+						FinishedAt: &metav1.Time{Time: time.Now()},
+						Error:      "pull request was closed",
+					},
+				}
+				return status, freight, errors.New("pull request was closed")
+			}
+			return status.WithPhase(kargoapi.PromotionPhaseSucceeded), freight, nil
+		},
+	}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{
+		Namespace: promo.Namespace,
+		Name:      promo.Name,
+	}}
+
+	// The first attempt fails and the Promotion waits to be retried
+	result, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	require.NotZero(t, result.RequeueAfter)
+	var updatedPromo kargoapi.Promotion
+	err = r.kargoClient.Get(ctx, req.NamespacedName, &updatedPromo)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionPhaseRunning, updatedPromo.Status.Phase)
+	require.Len(t, updatedPromo.Status.Attempts, 1)
+	require.Equal(t, kargoapi.PromotionPhaseErrored, updatedPromo.Status.Attempts[0].Phase)
+	// The state of the failed attempt is not carried over to the next one
+	require.Empty(t, updatedPromo.Status.PullRequests)
+	require.Empty(t, updatedPromo.Status.Steps)
+
+	// The second attempt opens a new pull request, which is merged
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	err = r.kargoClient.Get(ctx, req.NamespacedName, &updatedPromo)
+	require.NoError(t, err)
+	require.Equal(t, []string{"attempt-1", "attempt-2"}, openedBranches)
+	require.Equal(t, kargoapi.PromotionPhaseSucceeded, updatedPromo.Status.Phase)
+	require.Len(t, updatedPromo.Status.PullRequests, 1)
+	require.Equal(t, "attempt-2", updatedPromo.Status.PullRequests[0].SourceBranch)
+	require.Len(t, updatedPromo.Status.Attempts, 2)
+	require.Equal(t, kargoapi.PromotionPhaseSucceeded, updatedPromo.Status.Attempts[1].Phase)
+}
+
+func TestResetForRetry(t *testing.T) {
+	finishedAt := &metav1.Time{Time: time.Now()}
+	status := &kargoapi.PromotionStatus{
+		PullRequests: []kargoapi.PullRequestInfo{
+			{Number: 1, State: kargoapi.PullRequestStateMerged},
+			{Number: 2, State: kargoapi.PullRequestStateClosed},
+			{Number: 3, State: kargoapi.PullRequestStateOpen},
+		},
+		ArgoCDAppSyncs: []kargoapi.ArgoCDAppSyncInfo{
+			{Name: "succeeded", Phase: "Succeeded"},
+			{Name: "failed", Phase: "Failed"},
+			{Name: "not-started"},
+		},
+		HTTPWebhookResults: []kargoapi.HTTPWebhookResult{
+			{Name: "succeeded", Succeeded: true},
+			{Name: "failed", Attempts: 3},
+		},
+		Steps: []kargoapi.PromotionStepResult{
+			{Target: "succeeded", FinishedAt: finishedAt},
+			{Target: "failed", FinishedAt: finishedAt, Error: "something went wrong"},
+			{Target: "running"},
+		},
+		SigningKeyID: "fake-key-id",
+	}
+	resetForRetry(status)
+	require.Equal(
+		t,
+		[]kargoapi.PullRequestInfo{
+			{Number: 1, State: kargoapi.PullRequestStateMerged},
+		},
+		status.PullRequests,
+	)
+	require.Equal(
+		t,
+		[]kargoapi.ArgoCDAppSyncInfo{{Name: "succeeded", Phase: "Succeeded"}},
+		status.ArgoCDAppSyncs,
+	)
+	require.Equal(
+		t,
+		[]kargoapi.HTTPWebhookResult{{Name: "succeeded", Succeeded: true}},
+		status.HTTPWebhookResults,
+	)
+	require.Equal(
+		t,
+		[]kargoapi.PromotionStepResult{{Target: "succeeded", FinishedAt: finishedAt}},
+		status.Steps,
+	)
+	require.Equal(t, "fake-key-id", status.SigningKeyID)
+}
+
+func TestReconcileApproval(t *testing.T) {
+	requirement := &kargoapi.ApprovalRequirement{
+		RequiredApprovals: 2,
+		Groups:            []string{"release-managers"},
+	}
+	testCases := []struct {
+		name                     string
+		requirement              *kargoapi.ApprovalRequirement
+		approvals                []kargoapi.PromotionApproval
+		expectPromoteFnCalled    bool
+		expectedPhase            kargoapi.PromotionPhase
+		expectedAwaitingApproval bool
+	}{
+		{
+			name:                  "no approval required",
+			expectPromoteFnCalled: true,
+			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
+		},
+		{
+			name:                     "not enough approvals",
+			requirement:              requirement,
+			approvals:                []kargoapi.PromotionApproval{{Username: "alice"}},
+			expectedPhase:            kargoapi.PromotionPhasePending,
+			expectedAwaitingApproval: true,
+		},
+		{
+			name:        "enough approvals",
+			requirement: requirement,
+			approvals: []kargoapi.PromotionApproval{
+				{Username: "alice"},
+				{Username: "bob"},
+			},
+			expectPromoteFnCalled: true,
+			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.TODO()
+			promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now)
+			promo.Status.AwaitingApproval = testCase.requirement != nil
+			promo.Status.Approvals = testCase.approvals
+			r := newFakeReconciler(t, promo)
+			r.getApprovalRequirementFn = func(
+				context.Context,
+				v1alpha1.Promotion,
+			) (*kargoapi.ApprovalRequirement, error) {
+				return testCase.requirement, nil
+			}
+			promoteWasCalled := false
+			r.promoteFn = func(
+				_ context.Context,
+				p v1alpha1.Promotion,
+			) (*kargoapi.PromotionStatus, error) {
+				promoteWasCalled = true
+				return p.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), nil
+			}
+			req := ctrl.Request{NamespacedName: types.NamespacedName{
+				Namespace: promo.Namespace,
+				Name:      promo.Name,
+			}}
+
+			result, err := r.Reconcile(ctx, req)
+			require.NoError(t, err)
+			require.Zero(t, result.RequeueAfter)
+			require.Equal(t, testCase.expectPromoteFnCalled, promoteWasCalled)
+
+			var updatedPromo kargoapi.Promotion
+			err = r.kargoClient.Get(ctx, req.NamespacedName, &updatedPromo)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedPhase, updatedPromo.Status.Phase)
+			require.Equal(
+				t,
+				testCase.expectedAwaitingApproval,
+				updatedPromo.Status.AwaitingApproval,
+			)
+		})
+	}
+}
+
+func TestReconcileSupersede(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		supersede             bool
+		reconcile             string
+		expectPromoteFnCalled bool
+		expectedOlderPhase    kargoapi.PromotionPhase
+		expectedNewerPhase    kargoapi.PromotionPhase
+	}{
+		{
+			name:                  "superseding disabled",
+			reconcile:             "older",
+			expectPromoteFnCalled: true,
+			expectedOlderPhase:    kargoapi.PromotionPhaseSucceeded,
+			expectedNewerPhase:    kargoapi.PromotionPhasePending,
+		},
+		{
+			name:               "older Promotion reconciled",
+			supersede:          true,
+			reconcile:          "older",
+			expectedOlderPhase: kargoapi.PromotionPhaseSuperseded,
+			expectedNewerPhase: kargoapi.PromotionPhasePending,
+		},
+		{
+			name:                  "newer Promotion reconciled",
+			supersede:             true,
+			reconcile:             "newer",
+			expectPromoteFnCalled: true,
+			expectedOlderPhase:    kargoapi.PromotionPhaseSuperseded,
+			expectedNewerPhase:    kargoapi.PromotionPhaseSucceeded,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.TODO()
+			r := newFakeReconciler(
+				t,
+				newPromo("fake-namespace", "older", "fake-stage", kargoapi.PromotionPhasePending, before),
+				newPromo("fake-namespace", "newer", "fake-stage", kargoapi.PromotionPhasePending, now),
+			)
+			r.supersedesStalePromotionsFn = func(
+				context.Context,
+				v1alpha1.Promotion,
+			) (bool, error) {
+				return testCase.supersede, nil
+			}
+			promoteWasCalled := false
+			r.promoteFn = func(
+				_ context.Context,
+				p v1alpha1.Promotion,
+			) (*kargoapi.PromotionStatus, error) {
+				promoteWasCalled = true
+				return p.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), nil
+			}
+
+			_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{
+				Namespace: "fake-namespace",
+				Name:      testCase.reconcile,
+			}})
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectPromoteFnCalled, promoteWasCalled)
+
+			var older, newer kargoapi.Promotion
+			err = r.kargoClient.Get(
+				ctx,
+				types.NamespacedName{Namespace: "fake-namespace", Name: "older"},
+				&older,
+			)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedOlderPhase, older.Status.Phase)
+			if testCase.expectedOlderPhase == kargoapi.PromotionPhaseSuperseded {
+				require.Equal(t, "newer", older.Status.SupersededBy)
+			}
+			err = r.kargoClient.Get(
+				ctx,
+				types.NamespacedName{Namespace: "fake-namespace", Name: "newer"},
+				&newer,
+			)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedNewerPhase, newer.Status.Phase)
+		})
+	}
+}
+
 // Tests that initalizeQueues is called properly
 func TestReconcileInitializeQueues(t *testing.T) {
 	ctx := context.TODO()
@@ -185,3 +776,94 @@ func TestReconcileInitializeQueues(t *testing.T) {
 	stageKey := types.NamespacedName{Namespace: "fake-namespace", Name: "fake-stage"}
 	require.Equal(t, 2, r.pqs.pendingPromoQueuesByStage[stageKey].Depth())
 }
+
+func TestGetTargetFreight(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-stage",
+			Namespace: "fake-namespace",
+		},
+		Spec: &kargoapi.StageSpec{
+			Subscriptions: &kargoapi.Subscriptions{
+				UpstreamStages: []kargoapi.StageSubscription{
+					{
+						Name: "fake-upstream-stage",
+					},
+				},
+			},
+		},
+		Status: kargoapi.StageStatus{
+			History: kargoapi.SimpleFreightStack{
+				{ID: "current-freight"},
+				{ID: "old-freight"},
+			},
+		},
+	}
+	unqualifiedFreight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "old-freight",
+			Namespace: "fake-namespace",
+		},
+		ID: "old-freight",
+	}
+	testCases := []struct {
+		name       string
+		promoSpec  kargoapi.PromotionSpec
+		assertions func(*kargoapi.SimpleFreight, error)
+	}{
+		{
+			name: "unqualified Freight",
+			promoSpec: kargoapi.PromotionSpec{
+				Stage:   "fake-stage",
+				Freight: "old-freight",
+			},
+			assertions: func(_ *kargoapi.SimpleFreight, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "no qualified Freight")
+			},
+		},
+		{
+			name: "rollback to Freight not in history",
+			promoSpec: kargoapi.PromotionSpec{
+				Stage:    "fake-stage",
+				Freight:  "unknown-freight",
+				Rollback: true,
+			},
+			assertions: func(_ *kargoapi.SimpleFreight, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "not found in the history")
+			},
+		},
+		{
+			name: "rollback to unqualified Freight in history",
+			promoSpec: kargoapi.PromotionSpec{
+				Stage:    "fake-stage",
+				Freight:  "old-freight",
+				Rollback: true,
+			},
+			assertions: func(freight *kargoapi.SimpleFreight, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "old-freight", freight.ID)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := newFakeReconciler(t, unqualifiedFreight)
+			promoSpec := testCase.promoSpec
+			testCase.assertions(
+				r.getTargetFreight(
+					context.Background(),
+					testStage,
+					kargoapi.Promotion{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-promotion",
+							Namespace: "fake-namespace",
+						},
+						Spec: &promoSpec,
+					},
+				),
+			)
+		})
+	}
+}
@@ -8,11 +8,30 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 )
 
+// promoApprovalsChanged is a predicate that returns true when an update
+// changes the approvals recorded in a Promotion's status. Approvals are the
+// only change to a Promotion's status, which is otherwise written only by the
+// Promotion reconciler itself, that the reconciler needs to react to.
+var promoApprovalsChanged = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldPromo, ok := e.ObjectOld.(*kargoapi.Promotion)
+		if !ok {
+			return false
+		}
+		newPromo, ok := e.ObjectNew.(*kargoapi.Promotion)
+		if !ok {
+			return false
+		}
+		return len(newPromo.Status.Approvals) != len(oldPromo.Status.Approvals)
+	},
+}
+
 // EnqueueHighestPriorityPromotionHandler is an event handler that enqueues the next
 // highest priority Promotion for reconciliation when an active Promotion becomes terminal
 type EnqueueHighestPriorityPromotionHandler struct {
@@ -137,3 +156,64 @@ func (e *EnqueueHighestPriorityPromotionHandler) enqueueNext(
 		return
 	}
 }
+
+// AbortPromotionHandler is an event handler that cancels the in-progress
+// execution, if any, of a Promotion that a user has asked to abort. It does
+// not enqueue anything for reconciliation. The Promotion's reconciler is
+// responsible for moving the Promotion to the Aborted phase.
+type AbortPromotionHandler struct {
+	logger  *log.Entry
+	abortFn func(types.NamespacedName)
+}
+
+// Create implements EventHandler.
+func (a *AbortPromotionHandler) Create(
+	event.CreateEvent,
+	workqueue.RateLimitingInterface,
+) {
+	// No-op
+}
+
+// Delete implements EventHandler.
+func (a *AbortPromotionHandler) Delete(
+	event.DeleteEvent,
+	workqueue.RateLimitingInterface,
+) {
+	// No-op
+}
+
+// Generic implements EventHandler.
+func (a *AbortPromotionHandler) Generic(
+	event.GenericEvent,
+	workqueue.RateLimitingInterface,
+) {
+	// No-op
+}
+
+// Update implements EventHandler. It cancels the in-progress execution of a
+// non-terminal Promotion that has just had an abort requested.
+func (a *AbortPromotionHandler) Update(
+	evt event.UpdateEvent,
+	_ workqueue.RateLimitingInterface,
+) {
+	oldPromo, ok := evt.ObjectOld.(*kargoapi.Promotion)
+	if !ok {
+		return
+	}
+	newPromo, ok := evt.ObjectNew.(*kargoapi.Promotion)
+	if !ok {
+		return
+	}
+	if oldPromo.IsAbortRequested() || !newPromo.IsAbortRequested() ||
+		newPromo.Status.Phase.IsTerminal() {
+		return
+	}
+	a.logger.WithFields(log.Fields{
+		"namespace": newPromo.Namespace,
+		"promotion": newPromo.Name,
+	}).Debug("abort requested; cancelling in-progress execution of Promotion")
+	a.abortFn(types.NamespacedName{
+		Namespace: newPromo.Namespace,
+		Name:      newPromo.Name,
+	})
+}
@@ -0,0 +1,144 @@
+package stages
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/flux"
+)
+
+func (r *reconciler) checkFluxHealth(
+	ctx context.Context,
+	fluxUpdates []kargoapi.FluxUpdate,
+) *kargoapi.Health {
+	if len(fluxUpdates) == 0 {
+		return nil
+	}
+
+	h := kargoapi.Health{
+		// We'll start healthy and degrade as we find issues
+		Status:        kargoapi.HealthStateHealthy,
+		FluxResources: make([]kargoapi.FluxResourceStatus, len(fluxUpdates)),
+		Issues:        []string{},
+	}
+
+	for i, update := range fluxUpdates {
+		h.FluxResources[i] = kargoapi.FluxResourceStatus{
+			Kind:      update.Kind,
+			Namespace: update.NamespaceOrDefault(),
+			Name:      update.Name,
+		}
+
+		obj, err := r.getFluxResourceFn(
+			ctx,
+			r.fluxClient,
+			update.Kind,
+			update.NamespaceOrDefault(),
+			update.Name,
+		)
+
+		if err != nil {
+			h.FluxResources[i].Ready = string(metav1.ConditionUnknown)
+			h.Status = h.Status.Merge(kargoapi.HealthStateUnknown)
+			h.Issues = append(
+				h.Issues,
+				fmt.Sprintf(
+					"error finding Flux %s %q in namespace %q: %s",
+					update.Kind,
+					update.Name,
+					update.NamespaceOrDefault(),
+					err,
+				),
+			)
+			continue
+		}
+
+		if obj == nil {
+			h.FluxResources[i].Ready = string(metav1.ConditionUnknown)
+			h.Status = h.Status.Merge(kargoapi.HealthStateUnknown)
+			h.Issues = append(
+				h.Issues,
+				fmt.Sprintf(
+					"unable to find Flux %s %q in namespace %q",
+					update.Kind,
+					update.Name,
+					update.NamespaceOrDefault(),
+				),
+			)
+			continue
+		}
+
+		condition := flux.GetCondition(obj, flux.ConditionTypeReady)
+		if condition != nil {
+			h.FluxResources[i].Ready = condition.Status
+			h.FluxResources[i].Reason = condition.Reason
+			h.FluxResources[i].Message = condition.Message
+		}
+
+		// A Ready condition describing a previous generation of the resource says
+		// nothing about whether the most recent changes have been applied.
+		if !flux.IsReconciled(obj) {
+			h.Status = h.Status.Merge(kargoapi.HealthStateProgressing)
+			h.Issues = append(
+				h.Issues,
+				fmt.Sprintf(
+					"Flux %s %q in namespace %q is being reconciled",
+					update.Kind,
+					update.Name,
+					update.NamespaceOrDefault(),
+				),
+			)
+			continue
+		}
+
+		switch {
+		case condition != nil && condition.Status == string(metav1.ConditionTrue):
+		case condition != nil && condition.Status == string(metav1.ConditionFalse):
+			h.Status = h.Status.Merge(kargoapi.HealthStateUnhealthy)
+			h.Issues = append(
+				h.Issues,
+				fmt.Sprintf(
+					"Flux %s %q in namespace %q is not ready: %s",
+					update.Kind,
+					update.Name,
+					update.NamespaceOrDefault(),
+					condition.Message,
+				),
+			)
+		default:
+			h.Status = h.Status.Merge(kargoapi.HealthStateProgressing)
+			h.Issues = append(
+				h.Issues,
+				fmt.Sprintf(
+					"Flux %s %q in namespace %q is progressing",
+					update.Kind,
+					update.Name,
+					update.NamespaceOrDefault(),
+				),
+			)
+		}
+	}
+
+	return &h
+}
+
+// mergeHealth combines two assessments of a Stage's health into one. Either
+// argument may be nil, indicating that the corresponding assessment was not
+// applicable.
+func mergeHealth(a, b *kargoapi.Health) *kargoapi.Health {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	return &kargoapi.Health{
+		Status:        a.Status.Merge(b.Status),
+		Issues:        append(append([]string{}, a.Issues...), b.Issues...),
+		ArgoCDApps:    append(append([]kargoapi.ArgoCDAppStatus{}, a.ArgoCDApps...), b.ArgoCDApps...),
+		FluxResources: append(append([]kargoapi.FluxResourceStatus{}, a.FluxResources...), b.FluxResources...),
+	}
+}
@@ -0,0 +1,231 @@
+package stages
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestCheckFluxHealth(t *testing.T) {
+	testUpdates := []kargoapi.FluxUpdate{
+		{
+			Kind:      kargoapi.FluxResourceKindHelmRelease,
+			Name:      "fake-name",
+			Namespace: "fake-namespace",
+		},
+	}
+	newObj := func(generation, observedGeneration int64, conditions ...any) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{
+			Object: map[string]any{
+				"status": map[string]any{
+					"observedGeneration": observedGeneration,
+					"conditions":         conditions,
+				},
+			},
+		}
+		obj.SetGeneration(generation)
+		return obj
+	}
+	testCases := []struct {
+		name              string
+		fluxUpdates       []kargoapi.FluxUpdate
+		getFluxResourceFn func(
+			context.Context,
+			client.Client,
+			kargoapi.FluxResourceKind,
+			string,
+			string,
+		) (*unstructured.Unstructured, error)
+		assertions func(*kargoapi.Health)
+	}{
+		{
+			name: "no fluxUpdates are defined",
+			assertions: func(health *kargoapi.Health) {
+				require.Nil(t, health)
+			},
+		},
+		{
+			name:        "error finding Flux resource",
+			fluxUpdates: testUpdates,
+			getFluxResourceFn: func(
+				context.Context,
+				client.Client,
+				kargoapi.FluxResourceKind,
+				string,
+				string,
+			) (*unstructured.Unstructured, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnknown, health.Status)
+				require.Equal(
+					t,
+					[]kargoapi.FluxResourceStatus{
+						{
+							Kind:      kargoapi.FluxResourceKindHelmRelease,
+							Namespace: "fake-namespace",
+							Name:      "fake-name",
+							Ready:     "Unknown",
+						},
+					},
+					health.FluxResources,
+				)
+				require.Len(t, health.Issues, 1)
+				require.Contains(t, health.Issues[0], "error finding Flux HelmRelease")
+				require.Contains(t, health.Issues[0], "something went wrong")
+			},
+		},
+		{
+			name:        "Flux resource not found",
+			fluxUpdates: testUpdates,
+			getFluxResourceFn: func(
+				context.Context,
+				client.Client,
+				kargoapi.FluxResourceKind,
+				string,
+				string,
+			) (*unstructured.Unstructured, error) {
+				return nil, nil
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnknown, health.Status)
+				require.Len(t, health.Issues, 1)
+				require.Contains(t, health.Issues[0], "unable to find Flux HelmRelease")
+			},
+		},
+		{
+			name:        "Flux resource not yet reconciled",
+			fluxUpdates: testUpdates,
+			getFluxResourceFn: func(
+				context.Context,
+				client.Client,
+				kargoapi.FluxResourceKind,
+				string,
+				string,
+			) (*unstructured.Unstructured, error) {
+				return newObj(2, 1, map[string]any{
+					"type":   "Ready",
+					"status": "True",
+				}), nil
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateProgressing, health.Status)
+				require.Len(t, health.Issues, 1)
+				require.Contains(t, health.Issues[0], "is being reconciled")
+			},
+		},
+		{
+			name:        "Flux resource has no Ready condition",
+			fluxUpdates: testUpdates,
+			getFluxResourceFn: func(
+				context.Context,
+				client.Client,
+				kargoapi.FluxResourceKind,
+				string,
+				string,
+			) (*unstructured.Unstructured, error) {
+				return newObj(1, 1), nil
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateProgressing, health.Status)
+				require.Len(t, health.Issues, 1)
+				require.Contains(t, health.Issues[0], "is progressing")
+			},
+		},
+		{
+			name:        "Flux resource not ready",
+			fluxUpdates: testUpdates,
+			getFluxResourceFn: func(
+				context.Context,
+				client.Client,
+				kargoapi.FluxResourceKind,
+				string,
+				string,
+			) (*unstructured.Unstructured, error) {
+				return newObj(1, 1, map[string]any{
+					"type":    "Ready",
+					"status":  "False",
+					"reason":  "InstallFailed",
+					"message": "something went wrong",
+				}), nil
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, health.Status)
+				require.Equal(
+					t,
+					[]kargoapi.FluxResourceStatus{
+						{
+							Kind:      kargoapi.FluxResourceKindHelmRelease,
+							Namespace: "fake-namespace",
+							Name:      "fake-name",
+							Ready:     "False",
+							Reason:    "InstallFailed",
+							Message:   "something went wrong",
+						},
+					},
+					health.FluxResources,
+				)
+				require.Len(t, health.Issues, 1)
+				require.Contains(t, health.Issues[0], "is not ready")
+				require.Contains(t, health.Issues[0], "something went wrong")
+			},
+		},
+		{
+			name:        "Flux resource ready",
+			fluxUpdates: testUpdates,
+			getFluxResourceFn: func(
+				context.Context,
+				client.Client,
+				kargoapi.FluxResourceKind,
+				string,
+				string,
+			) (*unstructured.Unstructured, error) {
+				return newObj(1, 1, map[string]any{
+					"type":   "Ready",
+					"status": "True",
+				}), nil
+			},
+			assertions: func(health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateHealthy, health.Status)
+				require.Empty(t, health.Issues)
+				require.Equal(t, "True", health.FluxResources[0].Ready)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := reconciler{
+				getFluxResourceFn: testCase.getFluxResourceFn,
+			}
+			testCase.assertions(
+				r.checkFluxHealth(context.Background(), testCase.fluxUpdates),
+			)
+		})
+	}
+}
+
+func TestMergeHealth(t *testing.T) {
+	argoHealth := &kargoapi.Health{
+		Status:     kargoapi.HealthStateHealthy,
+		ArgoCDApps: []kargoapi.ArgoCDAppStatus{{Name: "fake-app"}},
+	}
+	fluxHealth := &kargoapi.Health{
+		Status:        kargoapi.HealthStateProgressing,
+		Issues:        []string{"fake-issue"},
+		FluxResources: []kargoapi.FluxResourceStatus{{Name: "fake-name"}},
+	}
+	require.Nil(t, mergeHealth(nil, nil))
+	require.Same(t, argoHealth, mergeHealth(argoHealth, nil))
+	require.Same(t, fluxHealth, mergeHealth(nil, fluxHealth))
+	merged := mergeHealth(argoHealth, fluxHealth)
+	require.Equal(t, kargoapi.HealthStateProgressing, merged.Status)
+	require.Equal(t, []string{"fake-issue"}, merged.Issues)
+	require.Equal(t, argoHealth.ArgoCDApps, merged.ArgoCDApps)
+	require.Equal(t, fluxHealth.FluxResources, merged.FluxResources)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -21,6 +22,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/flux"
 	"github.com/akuity/kargo/internal/kargo"
 	"github.com/akuity/kargo/internal/kubeclient"
 	"github.com/akuity/kargo/internal/logging"
@@ -30,6 +32,7 @@ import (
 type reconciler struct {
 	kargoClient client.Client
 	argoClient  client.Client
+	fluxClient  client.Client
 
 	// The following behaviors are overridable for testing purposes:
 
@@ -62,6 +65,19 @@ type reconciler struct {
 		name string,
 	) (*argocd.Application, error)
 
+	checkFluxHealthFn func(
+		context.Context,
+		[]kargoapi.FluxUpdate,
+	) *kargoapi.Health
+
+	getFluxResourceFn func(
+		ctx context.Context,
+		client client.Client,
+		kind kargoapi.FluxResourceKind,
+		namespace string,
+		name string,
+	) (*unstructured.Unstructured, error)
+
 	// Freight qualification:
 
 	getFreightFn func(
@@ -148,6 +164,7 @@ func SetupReconcilerWithManager(
 	ctx context.Context,
 	kargoMgr manager.Manager,
 	argoMgr manager.Manager,
+	fluxClient client.Client,
 	shardName string,
 ) error {
 	// Index Promotions in non-terminal states by Stage
@@ -200,7 +217,7 @@ func SetupReconcilerWithManager(
 		).
 		WithEventFilter(shardPredicate).
 		WithOptions(controller.CommonOptions()).
-		Build(newReconciler(kargoMgr.GetClient(), argoMgr.GetClient()))
+		Build(newReconciler(kargoMgr.GetClient(), argoMgr.GetClient(), fluxClient))
 	if err != nil {
 		return errors.Wrap(err, "error building Stage reconciler")
 	}
@@ -224,10 +241,11 @@ func SetupReconcilerWithManager(
 	return nil
 }
 
-func newReconciler(kargoClient, argoClient client.Client) *reconciler {
+func newReconciler(kargoClient, argoClient, fluxClient client.Client) *reconciler {
 	r := &reconciler{
 		kargoClient: kargoClient,
 		argoClient:  argoClient,
+		fluxClient:  fluxClient,
 	}
 	// The following default behaviors are overridable for testing purposes:
 	// Loop guard:
@@ -236,6 +254,8 @@ func newReconciler(kargoClient, argoClient client.Client) *reconciler {
 	// Health checks:
 	r.checkHealthFn = r.checkHealth
 	r.getArgoCDAppFn = argocd.GetApplication
+	r.checkFluxHealthFn = r.checkFluxHealth
+	r.getFluxResourceFn = flux.GetResource
 	// Freight qualification:
 	r.getFreightFn = kargoapi.GetFreight
 	r.qualifyFreightFn = r.qualifyFreight
@@ -442,6 +462,12 @@ func (r *reconciler) syncNormalStage(
 			*status.CurrentFreight,
 			stage.Spec.PromotionMechanisms.ArgoCDAppUpdates,
 		)
+		if len(stage.Spec.PromotionMechanisms.FluxUpdates) > 0 {
+			status.Health = mergeHealth(
+				status.Health,
+				r.checkFluxHealthFn(ctx, stage.Spec.PromotionMechanisms.FluxUpdates),
+			)
+		}
 		if status.Health != nil {
 			freightLogger.WithField("health", status.Health.Status).
 				Debug("Stage health assessed")
@@ -547,11 +573,51 @@ func (r *reconciler) syncNormalStage(
 	return status, nil
 }
 
+// hasNonTerminalPromotions returns true if any Promotion to the specified
+// Stage is in a non-terminal phase. Promotions that are awaiting approval are
+// disregarded, since they will not be executed, and therefore will not update
+// the Stage's status, until they have been approved.
 func (r *reconciler) hasNonTerminalPromotions(
 	ctx context.Context,
 	stageNamespace string,
 	stageName string,
 ) (bool, error) {
+	promos, err := r.listNonTerminalPromotions(ctx, stageNamespace, stageName)
+	if err != nil {
+		return false, err
+	}
+	for _, promo := range promos {
+		if !promo.Status.AwaitingApproval {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// hasPromotionsAwaitingApproval returns true if any Promotion to the specified
+// Stage is awaiting approval.
+func (r *reconciler) hasPromotionsAwaitingApproval(
+	ctx context.Context,
+	stageNamespace string,
+	stageName string,
+) (bool, error) {
+	promos, err := r.listNonTerminalPromotions(ctx, stageNamespace, stageName)
+	if err != nil {
+		return false, err
+	}
+	for _, promo := range promos {
+		if promo.Status.AwaitingApproval {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *reconciler) listNonTerminalPromotions(
+	ctx context.Context,
+	stageNamespace string,
+	stageName string,
+) ([]kargoapi.Promotion, error) {
 	promos := kargoapi.PromotionList{}
 	if err := r.listPromosFn(
 		ctx,
@@ -563,7 +629,7 @@ func (r *reconciler) hasNonTerminalPromotions(
 			}).AsSelector(),
 		},
 	); err != nil {
-		return false, errors.Wrapf(
+		return nil, errors.Wrapf(
 			err,
 			"error listing Promotions in non-terminal phases for Stage %q in "+
 				"namespace %q",
@@ -571,7 +637,7 @@ func (r *reconciler) hasNonTerminalPromotions(
 			stageName,
 		)
 	}
-	return len(promos.Items) > 0, nil
+	return promos.Items, nil
 }
 
 func (r *reconciler) qualifyFreight(
@@ -690,6 +756,47 @@ func (r *reconciler) isAutoPromotionPermitted(
 		)
 		return false, nil
 	}
+	policy := policies.Items[0]
+	now := time.Now()
+	frozen, err := policy.IsFrozen(now)
+	if err != nil {
+		return false, errors.Wrapf(
+			err,
+			"error evaluating freeze windows of PromotionPolicy %q in namespace %q",
+			policy.Name,
+			namespace,
+		)
+	}
+	if frozen {
+		logger.Debug("PromotionPolicy has a freeze window in effect")
+		return false, nil
+	}
+	windowOpen, err := policy.IsAutoPromotionWindowOpen(now)
+	if err != nil {
+		return false, errors.Wrapf(
+			err,
+			"error evaluating auto-promotion windows of PromotionPolicy %q in "+
+				"namespace %q",
+			policy.Name,
+			namespace,
+		)
+	}
+	if !windowOpen {
+		logger.Debug("PromotionPolicy has no auto-promotion window open")
+		return false, nil
+	}
+	// Auto-promotion must not circumvent a Stage's approval requirement by
+	// piling up further Promotions behind one that has yet to be approved. The
+	// approvers decide what happens to that Promotion first.
+	awaitingApproval, err :=
+		r.hasPromotionsAwaitingApproval(ctx, namespace, stageName)
+	if err != nil {
+		return false, err
+	}
+	if awaitingApproval {
+		logger.Debug("a Promotion to the Stage is awaiting approval")
+		return false, nil
+	}
 	return true, nil
 }
 
@@ -22,9 +22,11 @@ func TestNewReconciler(t *testing.T) {
 	e := newReconciler(
 		kubeClient,
 		kubeClient,
+		kubeClient,
 	)
 	require.NotNil(t, e.kargoClient)
 	require.NotNil(t, e.argoClient)
+	require.NotNil(t, e.fluxClient)
 	// Assert that all overridable behaviors were initialized to a default:
 	// Loop guard:
 	require.NotNil(t, e.hasNonTerminalPromotionsFn)
@@ -32,6 +34,8 @@ func TestNewReconciler(t *testing.T) {
 	// Health checks:
 	require.NotNil(t, e.checkHealthFn)
 	require.NotNil(t, e.getArgoCDAppFn)
+	require.NotNil(t, e.checkFluxHealthFn)
+	require.NotNil(t, e.getFluxResourceFn)
 	// Freight qualification:
 	require.NotNil(t, e.getFreightFn)
 	require.NotNil(t, e.qualifyFreightFn)
@@ -870,6 +874,31 @@ func TestHasNonTerminalPromotions(t *testing.T) {
 				require.True(t, result)
 			},
 		},
+		{
+			name: "only has Promotions awaiting approval",
+			reconciler: &reconciler{
+				listPromosFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos, ok := objList.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					promos.Items = []kargoapi.Promotion{
+						{
+							Status: kargoapi.PromotionStatus{
+								AwaitingApproval: true,
+							},
+						},
+					}
+					return nil
+				},
+			},
+			assertions: func(result bool, err error) {
+				require.NoError(t, err)
+				require.False(t, result)
+			},
+		},
 		{
 			name: "does not have non-terminal Promotions",
 			reconciler: &reconciler{
@@ -1106,6 +1135,133 @@ func TestIsAutoPromotionPermitted(t *testing.T) {
 				require.False(t, result)
 			},
 		},
+		{
+			name: "freeze window in effect",
+			reconciler: &reconciler{
+				listPromoPoliciesFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					policies, ok := objList.(*kargoapi.PromotionPolicyList)
+					require.True(t, ok)
+					policies.Items = []kargoapi.PromotionPolicy{
+						{
+							EnableAutoPromotion: true,
+							FreezeWindows: []kargoapi.PromotionWindow{
+								{
+									Schedule: "* * * * *",
+									Duration: metav1.Duration{Duration: time.Hour},
+								},
+							},
+						},
+					}
+					return nil
+				},
+			},
+			assertions: func(result bool, err error) {
+				require.NoError(t, err)
+				require.False(t, result)
+			},
+		},
+		{
+			name: "no auto-promotion window open",
+			reconciler: &reconciler{
+				listPromoPoliciesFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					policies, ok := objList.(*kargoapi.PromotionPolicyList)
+					require.True(t, ok)
+					policies.Items = []kargoapi.PromotionPolicy{
+						{
+							EnableAutoPromotion: true,
+							AutoPromotionWindows: []kargoapi.PromotionWindow{
+								{
+									Schedule: "0 0 30 2 *",
+									Duration: metav1.Duration{Duration: time.Hour},
+								},
+							},
+						},
+					}
+					return nil
+				},
+			},
+			assertions: func(result bool, err error) {
+				require.NoError(t, err)
+				require.False(t, result)
+			},
+		},
+		{
+			name: "error listing Promotions",
+			reconciler: &reconciler{
+				listPromoPoliciesFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					policies, ok := objList.(*kargoapi.PromotionPolicyList)
+					require.True(t, ok)
+					policies.Items = []kargoapi.PromotionPolicy{
+						{
+							EnableAutoPromotion: true,
+						},
+					}
+					return nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(_ bool, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "Promotion awaiting approval",
+			reconciler: &reconciler{
+				listPromoPoliciesFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					policies, ok := objList.(*kargoapi.PromotionPolicyList)
+					require.True(t, ok)
+					policies.Items = []kargoapi.PromotionPolicy{
+						{
+							EnableAutoPromotion: true,
+						},
+					}
+					return nil
+				},
+				listPromosFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos, ok := objList.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					promos.Items = []kargoapi.Promotion{
+						{
+							Status: kargoapi.PromotionStatus{
+								AwaitingApproval: true,
+							},
+						},
+					}
+					return nil
+				},
+			},
+			assertions: func(result bool, err error) {
+				require.NoError(t, err)
+				require.False(t, result)
+			},
+		},
 		{
 			name: "permitted",
 			reconciler: &reconciler{
@@ -1123,6 +1279,13 @@ func TestIsAutoPromotionPermitted(t *testing.T) {
 					}
 					return nil
 				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
 			},
 			assertions: func(result bool, err error) {
 				require.NoError(t, err)
@@ -2,9 +2,13 @@ package warehouses
 
 import (
 	"context"
+	"regexp"
 	"strings"
 
+	"github.com/Masterminds/semver"
+	"github.com/gobwas/glob"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/git"
@@ -14,14 +18,20 @@ import (
 
 type gitMeta struct {
 	Commit  string
+	Tag     string
 	Message string
 	Author  string
 }
 
+// getLatestCommits returns the latest commit of interest from each of the
+// provided subscriptions' repositories. The provided previous commits, which
+// are those discovered by the most recent poll, allow searches through a
+// branch's history to stop where the last search ended.
 func (r *reconciler) getLatestCommits(
 	ctx context.Context,
 	namespace string,
 	subs []kargoapi.RepoSubscription,
+	previousCommits []kargoapi.GitCommit,
 ) ([]kargoapi.GitCommit, error) {
 	latestCommits := make([]kargoapi.GitCommit, 0, len(subs))
 	for _, s := range subs {
@@ -51,7 +61,15 @@ func (r *reconciler) getLatestCommits(
 			logger.Debug("found no credentials for git repo")
 		}
 
-		gm, err := r.getLatestCommitMetaFn(ctx, sub.RepoURL, sub.Branch, repoCreds)
+		var previousCommit string
+		for _, commit := range previousCommits {
+			if commit.RepoURL == sub.RepoURL && commit.Branch == sub.Branch {
+				previousCommit = commit.ID
+				break
+			}
+		}
+
+		gm, err := r.getLatestCommitMetaFn(ctx, *sub, repoCreds, previousCommit)
 		if err != nil {
 			return nil, errors.Wrapf(
 				err,
@@ -59,14 +77,17 @@ func (r *reconciler) getLatestCommits(
 				sub.RepoURL,
 			)
 		}
-		logger.WithField("commit", gm.Commit).
-			Debug("found latest commit from repo")
+		logger.WithFields(log.Fields{
+			"commit": gm.Commit,
+			"tag":    gm.Tag,
+		}).Debug("found latest commit from repo")
 		latestCommits = append(
 			latestCommits,
 			kargoapi.GitCommit{
 				RepoURL: sub.RepoURL,
 				ID:      gm.Commit,
 				Branch:  sub.Branch,
+				Tag:     gm.Tag,
 				Message: gm.Message,
 			},
 		)
@@ -76,37 +97,79 @@ func (r *reconciler) getLatestCommits(
 
 func getLatestCommitMeta(
 	ctx context.Context,
-	repoURL string,
-	branch string,
+	sub kargoapi.GitSubscription,
 	creds *git.RepoCredentials,
+	previousCommit string,
 ) (*gitMeta, error) {
+	repoURL := sub.RepoURL
+	branch := sub.Branch
 	logger := logging.LoggerFromContext(ctx).WithField("repo", repoURL)
 	if creds == nil {
 		creds = &git.RepoCredentials{}
 	}
-	repo, err := git.Clone(repoURL, *creds)
+	repo, err := git.Clone(repoURL, *creds, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error cloning git repo %q", repoURL)
 
 	}
-	if branch != "" {
-		if err = repo.Checkout(branch); err != nil {
+	var gm gitMeta
+	switch sub.CommitSelectionStrategy {
+	case kargoapi.CommitSelectionStrategySemVer,
+		kargoapi.CommitSelectionStrategyNewestTag:
+		tags, err := repo.ListTags()
+		if err != nil {
+			return nil, errors.Wrapf(err, "error listing tags from git repo %q", repoURL)
+		}
+		if gm.Tag, err = selectTag(sub, tags); err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error selecting tag from git repo %q",
+				repoURL,
+			)
+		}
+		if err = repo.Checkout(gm.Tag); err != nil {
 			return nil, errors.Wrapf(
 				err,
-				"error checking out branch %q from git repo",
+				"error checking out tag %q from git repo %q",
+				gm.Tag,
 				repoURL,
 			)
 		}
+	default:
+		if branch != "" {
+			if err = repo.Checkout(branch); err != nil {
+				return nil, errors.Wrapf(
+					err,
+					"error checking out branch %q from git repo",
+					repoURL,
+				)
+			}
+		}
+		if len(sub.IncludePaths) > 0 || len(sub.ExcludePaths) > 0 {
+			if gm.Commit, err = selectCommitByPaths(
+				repo,
+				sub.IncludePaths,
+				sub.ExcludePaths,
+				previousCommit,
+			); err != nil {
+				return nil, errors.Wrapf(
+					err,
+					"error selecting commit from git repo %q (branch: %q)",
+					repoURL,
+					branch,
+				)
+			}
+		}
 	}
-	var gm gitMeta
-	gm.Commit, err = repo.LastCommitID()
-	if err != nil {
-		return nil, errors.Wrapf(
-			err,
-			"error determining last commit ID from git repo %q (branch: %q)",
-			repoURL,
-			branch,
-		)
+	if gm.Commit == "" {
+		if gm.Commit, err = repo.LastCommitID(); err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"error determining last commit ID from git repo %q (branch: %q)",
+				repoURL,
+				branch,
+			)
+		}
 	}
 	msg, err := repo.CommitMessage(gm.Commit)
 	// Since we currently store commit messages in Stage status, we only capture
@@ -121,3 +184,164 @@ func getLatestCommitMeta(
 
 	return &gm, nil
 }
+
+// selectTag selects, from the provided tags, the one that best satisfies the
+// provided subscription's CommitSelectionStrategy, SemverConstraint, AllowTags,
+// and IgnoreTags fields. The provided tags are expected to be ordered from most
+// to least recently created.
+func selectTag(sub kargoapi.GitSubscription, tags []string) (string, error) {
+	var allowRegex *regexp.Regexp
+	if sub.AllowTags != "" {
+		var err error
+		if allowRegex, err = regexp.Compile(sub.AllowTags); err != nil {
+			return "", errors.Wrapf(
+				err,
+				"error compiling regular expression %q",
+				sub.AllowTags,
+			)
+		}
+	}
+	ignoredTags := make(map[string]struct{}, len(sub.IgnoreTags))
+	for _, tag := range sub.IgnoreTags {
+		ignoredTags[tag] = struct{}{}
+	}
+	candidates := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if _, ignored := ignoredTags[tag]; ignored {
+			continue
+		}
+		if allowRegex != nil && !allowRegex.MatchString(tag) {
+			continue
+		}
+		candidates = append(candidates, tag)
+	}
+
+	if sub.CommitSelectionStrategy == kargoapi.CommitSelectionStrategyNewestTag {
+		if len(candidates) == 0 {
+			return "", errors.New("found no suitable tag")
+		}
+		return candidates[0], nil
+	}
+
+	var constraint *semver.Constraints
+	if sub.SemverConstraint != "" {
+		var err error
+		if constraint, err = semver.NewConstraint(sub.SemverConstraint); err != nil {
+			return "", errors.Wrapf(
+				err,
+				"error parsing semver constraint %q",
+				sub.SemverConstraint,
+			)
+		}
+	}
+	var selectedTag string
+	var selectedVersion *semver.Version
+	for _, tag := range candidates {
+		version, err := semver.NewVersion(tag)
+		if err != nil {
+			// Tags that aren't semantic versions are simply not candidates.
+			continue
+		}
+		if constraint != nil && !constraint.Check(version) {
+			continue
+		}
+		if selectedVersion == nil || version.GreaterThan(selectedVersion) {
+			selectedTag = tag
+			selectedVersion = version
+		}
+	}
+	if selectedTag == "" {
+		return "", errors.New("found no suitable semantically versioned tag")
+	}
+	return selectedTag, nil
+}
+
+// commitsPerPage is the number of commits that selectCommitByPaths examines
+// at a time while walking backwards through a branch's history.
+const commitsPerPage = 100
+
+// selectCommitByPaths walks backwards through the first-parent history of the
+// current branch of the provided repository and returns the ID of the newest
+// commit that changed at least one path that is matched by the provided
+// include patterns and not matched by the provided exclude patterns. Since that
+// commit remains the same until a subsequent commit touches a path of interest,
+// commits that touch no paths of interest never result in new Freight.
+//
+// If a previous commit is provided, the walk stops upon reaching it, since no
+// newer commit touched a path of interest. For the same reason, the previous
+// commit is also returned if no commit touching a path of interest is found.
+func selectCommitByPaths(
+	repo git.Repo,
+	includePaths []string,
+	excludePaths []string,
+	previousCommit string,
+) (string, error) {
+	includeGlobs, err := compileGlobs(includePaths)
+	if err != nil {
+		return "", errors.Wrap(err, "error compiling include paths")
+	}
+	excludeGlobs, err := compileGlobs(excludePaths)
+	if err != nil {
+		return "", errors.Wrap(err, "error compiling exclude paths")
+	}
+	var skip uint
+	for {
+		commits, err := repo.ListCommitPaths(commitsPerPage, skip)
+		if err != nil {
+			return "", errors.Wrap(err, "error listing commits")
+		}
+		if len(commits) == 0 {
+			if previousCommit != "" {
+				return previousCommit, nil
+			}
+			return "", errors.New("found no commit touching any included path")
+		}
+		for _, commit := range commits {
+			if commit.ID == previousCommit ||
+				matchesPathFilters(includeGlobs, excludeGlobs, commit.Paths) {
+				return commit.ID, nil
+			}
+		}
+		skip += uint(len(commits))
+	}
+}
+
+// compileGlobs compiles the provided path patterns into globs in which "*"
+// does not match path separators.
+func compileGlobs(patterns []string) ([]glob.Glob, error) {
+	globs := make([]glob.Glob, len(patterns))
+	for i, pattern := range patterns {
+		var err error
+		if globs[i], err = glob.Compile(pattern, '/'); err != nil {
+			return nil, errors.Wrapf(err, "error compiling glob %q", pattern)
+		}
+	}
+	return globs, nil
+}
+
+// matchesPathFilters returns true if at least one of the provided paths is
+// matched by the provided include globs (or if there are no include globs) and
+// is not matched by any of the provided exclude globs.
+func matchesPathFilters(
+	includeGlobs []glob.Glob,
+	excludeGlobs []glob.Glob,
+	paths []string,
+) bool {
+pathLoop:
+	for _, path := range paths {
+		for _, g := range excludeGlobs {
+			if g.Match(path) {
+				continue pathLoop
+			}
+		}
+		if len(includeGlobs) == 0 {
+			return true
+		}
+		for _, g := range includeGlobs {
+			if g.Match(path) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package warehouses
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -19,9 +20,9 @@ func TestGetLatestCommits(t *testing.T) {
 		credentialsDB         credentials.Database
 		getLatestCommitMetaFn func(
 			context.Context,
-			string,
-			string,
+			kargoapi.GitSubscription,
 			*git.RepoCredentials,
+			string,
 		) (*gitMeta, error)
 		assertions func(commits []kargoapi.GitCommit, err error)
 	}{
@@ -64,9 +65,9 @@ func TestGetLatestCommits(t *testing.T) {
 			},
 			getLatestCommitMetaFn: func(
 				context.Context,
-				string,
-				string,
+				kargoapi.GitSubscription,
 				*git.RepoCredentials,
+				string,
 			) (*gitMeta, error) {
 				return nil, errors.New("something went wrong")
 			},
@@ -95,12 +96,18 @@ func TestGetLatestCommits(t *testing.T) {
 				},
 			},
 			getLatestCommitMetaFn: func(
-				context.Context,
-				string,
-				string,
-				*git.RepoCredentials,
+				_ context.Context,
+				_ kargoapi.GitSubscription,
+				_ *git.RepoCredentials,
+				previousCommit string,
 			) (*gitMeta, error) {
-				return &gitMeta{Commit: "fake-commit", Message: "message"}, nil
+				// Only the previous commit from the same repo and branch is used
+				require.Equal(t, "fake-previous-commit", previousCommit)
+				return &gitMeta{
+					Commit:  "fake-commit",
+					Tag:     "fake-tag",
+					Message: "message",
+				}, nil
 			},
 			assertions: func(commits []kargoapi.GitCommit, err error) {
 				require.NoError(t, err)
@@ -110,6 +117,7 @@ func TestGetLatestCommits(t *testing.T) {
 					kargoapi.GitCommit{
 						RepoURL: "fake-url",
 						ID:      "fake-commit",
+						Tag:     "fake-tag",
 						Message: "message",
 					},
 					commits[0],
@@ -134,6 +142,17 @@ func TestGetLatestCommits(t *testing.T) {
 							},
 						},
 					},
+					[]kargoapi.GitCommit{
+						{
+							RepoURL: "fake-url",
+							Branch:  "another-branch",
+							ID:      "another-fake-commit",
+						},
+						{
+							RepoURL: "fake-url",
+							ID:      "fake-previous-commit",
+						},
+					},
 				),
 			)
 		})
@@ -180,7 +199,340 @@ func TestGetLatestCommitID(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
-				getLatestCommitMeta(context.TODO(), testCase.repoURL, testCase.branch, nil),
+				getLatestCommitMeta(
+					context.TODO(),
+					kargoapi.GitSubscription{
+						RepoURL: testCase.repoURL,
+						Branch:  testCase.branch,
+					},
+					nil,
+					"",
+				),
+			)
+		})
+	}
+}
+
+func TestSelectTag(t *testing.T) {
+	testTags := []string{
+		"v2.0.0-rc.1",
+		"latest",
+		"v1.2.0",
+		"v1.10.0",
+		"v1.9.0",
+	}
+	testCases := []struct {
+		name       string
+		sub        kargoapi.GitSubscription
+		tags       []string
+		assertions func(string, error)
+	}{
+		{
+			name: "invalid allowTags regex",
+			sub: kargoapi.GitSubscription{
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategyNewestTag,
+				AllowTags:               "(",
+			},
+			tags: testTags,
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error compiling regular expression")
+			},
+		},
+		{
+			name: "invalid semver constraint",
+			sub: kargoapi.GitSubscription{
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategySemVer,
+				SemverConstraint:        "bogus",
+			},
+			tags: testTags,
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing semver constraint")
+			},
+		},
+		{
+			name: "newest tag",
+			sub: kargoapi.GitSubscription{
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategyNewestTag,
+			},
+			tags: testTags,
+			assertions: func(tag string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "v2.0.0-rc.1", tag)
+			},
+		},
+		{
+			name: "newest tag with allow and ignore lists",
+			sub: kargoapi.GitSubscription{
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategyNewestTag,
+				AllowTags:               `^v\d+\.\d+\.\d+$`,
+				IgnoreTags:              []string{"v1.2.0"},
+			},
+			tags: testTags,
+			assertions: func(tag string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "v1.10.0", tag)
+			},
+		},
+		{
+			name: "no suitable newest tag",
+			sub: kargoapi.GitSubscription{
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategyNewestTag,
+			},
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "found no suitable tag")
+			},
+		},
+		{
+			name: "semver without constraint",
+			sub: kargoapi.GitSubscription{
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategySemVer,
+			},
+			tags: testTags,
+			assertions: func(tag string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "v2.0.0-rc.1", tag)
+			},
+		},
+		{
+			name: "semver with constraint",
+			sub: kargoapi.GitSubscription{
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategySemVer,
+				SemverConstraint:        "^1.0.0",
+			},
+			tags: testTags,
+			assertions: func(tag string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "v1.10.0", tag)
+			},
+		},
+		{
+			name: "no suitable semver tag",
+			sub: kargoapi.GitSubscription{
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategySemVer,
+				SemverConstraint:        "^3.0.0",
+			},
+			tags: testTags,
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					"found no suitable semantically versioned tag",
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(selectTag(testCase.sub, testCase.tags))
+		})
+	}
+}
+
+// fakeRepo is a partial implementation of git.Repo that serves a fixed
+// history of commits.
+type fakeRepo struct {
+	git.Repo
+	commitIDs     []string
+	pathsByCommit map[string][]string
+}
+
+func (f *fakeRepo) ListCommitPaths(limit uint, skip uint) ([]git.CommitPaths, error) {
+	if skip >= uint(len(f.commitIDs)) {
+		return nil, nil
+	}
+	end := skip + limit
+	if end > uint(len(f.commitIDs)) {
+		end = uint(len(f.commitIDs))
+	}
+	commits := make([]git.CommitPaths, 0, end-skip)
+	for _, id := range f.commitIDs[skip:end] {
+		commits = append(
+			commits,
+			git.CommitPaths{ID: id, Paths: f.pathsByCommit[id]},
+		)
+	}
+	return commits, nil
+}
+
+func TestSelectCommitByPaths(t *testing.T) {
+	// Enough commits to require more than one page of history
+	commitIDs := make([]string, commitsPerPage+10)
+	pathsByCommit := make(map[string][]string, len(commitIDs))
+	for i := range commitIDs {
+		commitIDs[i] = fmt.Sprintf("commit-%d", i)
+		pathsByCommit[commitIDs[i]] = []string{"docs/README.md"}
+	}
+	pathsByCommit["commit-3"] = []string{"apps/foo/values.yaml"}
+	pathsByCommit["commit-105"] = []string{"apps/bar/values.yaml"}
+	repo := &fakeRepo{
+		commitIDs:     commitIDs,
+		pathsByCommit: pathsByCommit,
+	}
+	testCases := []struct {
+		name           string
+		includePaths   []string
+		excludePaths   []string
+		previousCommit string
+		assertions     func(string, error)
+	}{
+		{
+			name:         "invalid include path",
+			includePaths: []string{"["},
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error compiling include paths")
+			},
+		},
+		{
+			name:         "invalid exclude path",
+			excludePaths: []string{"["},
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error compiling exclude paths")
+			},
+		},
+		{
+			name:         "newest matching commit",
+			includePaths: []string{"apps/**"},
+			assertions: func(id string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "commit-3", id)
+			},
+		},
+		{
+			name:         "matching commit beyond first page",
+			includePaths: []string{"apps/bar/**"},
+			assertions: func(id string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "commit-105", id)
+			},
+		},
+		{
+			name:         "exclusions only",
+			excludePaths: []string{"docs/**"},
+			assertions: func(id string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "commit-3", id)
+			},
+		},
+		{
+			name:           "search stops at previous commit",
+			includePaths:   []string{"apps/bar/**"},
+			previousCommit: "commit-50",
+			assertions: func(id string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "commit-50", id)
+			},
+		},
+		{
+			name:           "newer matching commit than previous commit",
+			includePaths:   []string{"apps/**"},
+			previousCommit: "commit-50",
+			assertions: func(id string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "commit-3", id)
+			},
+		},
+		{
+			name:           "no matching commit; previous commit returned",
+			includePaths:   []string{"charts/**"},
+			previousCommit: "commit-from-another-branch",
+			assertions: func(id string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "commit-from-another-branch", id)
+			},
+		},
+		{
+			name:         "no matching commit and no previous commit",
+			includePaths: []string{"charts/**"},
+			assertions: func(_ string, err error) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					"found no commit touching any included path",
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				selectCommitByPaths(
+					repo,
+					testCase.includePaths,
+					testCase.excludePaths,
+					testCase.previousCommit,
+				),
+			)
+		})
+	}
+}
+
+func TestMatchesPathFilters(t *testing.T) {
+	testCases := []struct {
+		name         string
+		includePaths []string
+		excludePaths []string
+		paths        []string
+		matches      bool
+	}{
+		{
+			name:    "no filters",
+			paths:   []string{"apps/foo/values.yaml"},
+			matches: true,
+		},
+		{
+			name:         "no paths",
+			includePaths: []string{"**"},
+			matches:      false,
+		},
+		{
+			name:         "included",
+			includePaths: []string{"apps/foo/**"},
+			paths:        []string{"docs/README.md", "apps/foo/values.yaml"},
+			matches:      true,
+		},
+		{
+			name:         "single star does not cross directories",
+			includePaths: []string{"apps/*"},
+			paths:        []string{"apps/foo/values.yaml"},
+			matches:      false,
+		},
+		{
+			name:         "not included",
+			includePaths: []string{"apps/foo/**"},
+			paths:        []string{"apps/bar/values.yaml"},
+			matches:      false,
+		},
+		{
+			name:         "exclusion takes precedence",
+			includePaths: []string{"apps/foo/**"},
+			excludePaths: []string{"**/*.md"},
+			paths:        []string{"apps/foo/README.md"},
+			matches:      false,
+		},
+		{
+			name:         "some paths excluded",
+			excludePaths: []string{"**/*.md"},
+			paths:        []string{"apps/foo/README.md", "apps/foo/values.yaml"},
+			matches:      true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			includeGlobs, err := compileGlobs(testCase.includePaths)
+			require.NoError(t, err)
+			excludeGlobs, err := compileGlobs(testCase.excludePaths)
+			require.NoError(t, err)
+			require.Equal(
+				t,
+				testCase.matches,
+				matchesPathFilters(includeGlobs, excludeGlobs, testCase.paths),
 			)
 		})
 	}
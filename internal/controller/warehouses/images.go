@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
@@ -18,8 +19,9 @@ func (r *reconciler) getLatestImages(
 	ctx context.Context,
 	namespace string,
 	subs []kargoapi.RepoSubscription,
-) ([]kargoapi.Image, error) {
+) ([]kargoapi.Image, []kargoapi.ImageVerificationFailure, error) {
 	imgs := make([]kargoapi.Image, 0, len(subs))
+	var failures []kargoapi.ImageVerificationFailure
 	for _, s := range subs {
 		if s.Image == nil {
 			continue
@@ -32,7 +34,7 @@ func (r *reconciler) getLatestImages(
 		creds, ok, err :=
 			r.credentialsDB.Get(ctx, namespace, credentials.TypeImage, sub.RepoURL)
 		if err != nil {
-			return nil, errors.Wrapf(
+			return nil, failures, errors.Wrapf(
 				err,
 				"error obtaining credentials for image repo %q",
 				sub.RepoURL,
@@ -49,17 +51,26 @@ func (r *reconciler) getLatestImages(
 			logger.Debug("found no credentials for image repo")
 		}
 
-		tag, err := r.getLatestTagFn(
+		tag, digest, subFailures, err := r.getLatestTagFn(
+			ctx,
 			sub.RepoURL,
 			sub.UpdateStrategy,
 			sub.SemverConstraint,
 			sub.AllowTags,
 			sub.IgnoreTags,
 			sub.Platform,
+			sub.Verification,
 			regCreds,
 		)
+		failures = append(failures, subFailures...)
+		for _, f := range subFailures {
+			logger.WithFields(log.Fields{
+				"tag":    f.Tag,
+				"digest": f.Digest,
+			}).Warnf("disregarding image tag: %s", f.Message)
+		}
 		if err != nil {
-			return nil, errors.Wrapf(
+			return nil, failures, errors.Wrapf(
 				err,
 				"error getting latest suitable tag for image %q",
 				sub.RepoURL,
@@ -71,12 +82,15 @@ func (r *reconciler) getLatestImages(
 				RepoURL:    sub.RepoURL,
 				GitRepoURL: r.getImageSourceURL(sub.GitRepoURL, tag),
 				Tag:        tag,
+				Digest:     digest,
 			},
 		)
-		logger.WithField("tag", tag).
-			Debug("found latest suitable image tag")
+		logger.WithFields(log.Fields{
+			"tag":    tag,
+			"digest": digest,
+		}).Debug("found latest suitable image tag")
 	}
-	return imgs, nil
+	return imgs, failures, nil
 }
 
 const (
@@ -18,15 +18,17 @@ func TestGetLatestImages(t *testing.T) {
 		name           string
 		credentialsDB  credentials.Database
 		getLatestTagFn func(
+			context.Context,
 			string,
 			kargoapi.ImageUpdateStrategy,
 			string,
 			string,
 			[]string,
 			string,
+			*kargoapi.ImageVerification,
 			*images.Credentials,
-		) (string, error)
-		assertions func([]kargoapi.Image, error)
+		) (string, string, []kargoapi.ImageVerificationFailure, error)
+		assertions func([]kargoapi.Image, []kargoapi.ImageVerificationFailure, error)
 	}{
 		{
 			name: "error getting latest version of an image",
@@ -41,17 +43,23 @@ func TestGetLatestImages(t *testing.T) {
 				},
 			},
 			getLatestTagFn: func(
+				_ context.Context,
 				repoURL string,
 				updateStrategy kargoapi.ImageUpdateStrategy,
 				semverConstraint string,
 				allowTags string,
 				ignoreTags []string,
 				platform string,
+				verification *kargoapi.ImageVerification,
 				creds *images.Credentials,
-			) (string, error) {
-				return "", errors.New("something went wrong")
+			) (string, string, []kargoapi.ImageVerificationFailure, error) {
+				return "", "", nil, errors.New("something went wrong")
 			},
-			assertions: func(_ []kargoapi.Image, err error) {
+			assertions: func(
+				_ []kargoapi.Image,
+				_ []kargoapi.ImageVerificationFailure,
+				err error,
+			) {
 				require.Error(t, err)
 				require.Contains(
 					t,
@@ -62,6 +70,60 @@ func TestGetLatestImages(t *testing.T) {
 			},
 		},
 
+		{
+			name: "no verified version of an image",
+			credentialsDB: &credentials.FakeDB{
+				GetFn: func(
+					context.Context,
+					string,
+					credentials.Type,
+					string,
+				) (credentials.Credentials, bool, error) {
+					return credentials.Credentials{}, false, nil
+				},
+			},
+			getLatestTagFn: func(
+				_ context.Context,
+				repoURL string,
+				updateStrategy kargoapi.ImageUpdateStrategy,
+				semverConstraint string,
+				allowTags string,
+				ignoreTags []string,
+				platform string,
+				verification *kargoapi.ImageVerification,
+				creds *images.Credentials,
+			) (string, string, []kargoapi.ImageVerificationFailure, error) {
+				return "",
+					"",
+					[]kargoapi.ImageVerificationFailure{
+						{
+							RepoURL: repoURL,
+							Tag:     "fake-tag",
+							Message: "image is not signed",
+						},
+					},
+					errors.New("something went wrong")
+			},
+			assertions: func(
+				_ []kargoapi.Image,
+				failures []kargoapi.ImageVerificationFailure,
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(
+					t,
+					[]kargoapi.ImageVerificationFailure{
+						{
+							RepoURL: "fake-url",
+							Tag:     "fake-tag",
+							Message: "image is not signed",
+						},
+					},
+					failures,
+				)
+			},
+		},
+
 		{
 			name: "success",
 			credentialsDB: &credentials.FakeDB{
@@ -75,24 +137,32 @@ func TestGetLatestImages(t *testing.T) {
 				},
 			},
 			getLatestTagFn: func(
+				_ context.Context,
 				repoURL string,
 				updateStrategy kargoapi.ImageUpdateStrategy,
 				semverConstraint string,
 				allowTags string,
 				ignoreTags []string,
 				platform string,
+				verification *kargoapi.ImageVerification,
 				creds *images.Credentials,
-			) (string, error) {
-				return "fake-tag", nil
+			) (string, string, []kargoapi.ImageVerificationFailure, error) {
+				return "fake-tag", "fake-digest", nil, nil
 			},
-			assertions: func(images []kargoapi.Image, err error) {
+			assertions: func(
+				images []kargoapi.Image,
+				failures []kargoapi.ImageVerificationFailure,
+				err error,
+			) {
 				require.NoError(t, err)
+				require.Empty(t, failures)
 				require.Len(t, images, 1)
 				require.Equal(
 					t,
 					kargoapi.Image{
 						RepoURL: "fake-url",
 						Tag:     "fake-tag",
+						Digest:  "fake-digest",
 					},
 					images[0],
 				)
@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -23,6 +24,21 @@ import (
 	"github.com/akuity/kargo/internal/logging"
 )
 
+// discovery describes the outcome of polling all of a Warehouse's
+// subscriptions.
+type discovery struct {
+	// freight is assembled from the latest artifact of every subscription. It
+	// is nil if the latest artifact of any subscription could not be
+	// discovered.
+	freight *kargoapi.Freight
+	// subscriptions describes the outcome of polling each subscription, in the
+	// order the subscriptions are specified.
+	subscriptions []kargoapi.SubscriptionStatus
+	// imageVerificationFailures describes image tags that were disregarded
+	// because their signatures could not be verified.
+	imageVerificationFailures []kargoapi.ImageVerificationFailure
+}
+
 // reconciler reconciles Warehouse resources.
 type reconciler struct {
 	client                     client.Client
@@ -34,29 +50,32 @@ type reconciler struct {
 	getLatestFreightFromReposFn func(
 		context.Context,
 		*kargoapi.Warehouse,
-	) (*kargoapi.Freight, error)
+	) (discovery, error)
 
 	getLatestCommitsFn func(
 		ctx context.Context,
 		namespace string,
 		subs []kargoapi.RepoSubscription,
+		previousCommits []kargoapi.GitCommit,
 	) ([]kargoapi.GitCommit, error)
 
 	getLatestImagesFn func(
 		ctx context.Context,
 		namespace string,
 		subs []kargoapi.RepoSubscription,
-	) ([]kargoapi.Image, error)
+	) ([]kargoapi.Image, []kargoapi.ImageVerificationFailure, error)
 
 	getLatestTagFn func(
+		ctx context.Context,
 		repoURL string,
 		updateStrategy kargoapi.ImageUpdateStrategy,
 		semverConstraint string,
 		allowTags string,
 		ignoreTags []string,
 		platform string,
+		verification *kargoapi.ImageVerification,
 		creds *images.Credentials,
-	) (string, error)
+	) (string, string, []kargoapi.ImageVerificationFailure, error)
 
 	getLatestChartsFn func(
 		ctx context.Context,
@@ -74,9 +93,9 @@ type reconciler struct {
 
 	getLatestCommitMetaFn func(
 		ctx context.Context,
-		repoURL string,
-		branch string,
+		sub kargoapi.GitSubscription,
 		creds *git.RepoCredentials,
+		previousCommit string,
 	) (*gitMeta, error)
 
 	createFreightFn func(
@@ -143,13 +162,7 @@ func (r *reconciler) Reconcile(
 	ctx context.Context,
 	req ctrl.Request,
 ) (ctrl.Result, error) {
-	result := ctrl.Result{
-		// TODO: Make this configurable
-		// Note: If there is a failure, controller runtime ignores this and uses
-		// progressive backoff instead. So this value only affects when we will
-		// reconcile next if THIS reconciliation succeeds.
-		RequeueAfter: 0,
-	}
+	result := ctrl.Result{}
 
 	logger := logging.LoggerFromContext(ctx)
 
@@ -171,6 +184,11 @@ func (r *reconciler) Reconcile(
 		return result, nil
 	}
 
+	// Note: If there is a failure, controller runtime ignores this and uses
+	// progressive backoff instead. So this value only affects when we will
+	// reconcile next if THIS reconciliation succeeds.
+	result.RequeueAfter = warehouse.Spec.GetPollInterval()
+
 	newStatus, err := r.syncWarehouse(ctx, warehouse)
 	if err != nil {
 		newStatus.Error = err.Error()
@@ -209,16 +227,38 @@ func (r *reconciler) syncWarehouse(
 	status := *warehouse.Status.DeepCopy()
 	status.ObservedGeneration = warehouse.Generation
 	status.Error = "" // Clear any previous error
+	now := metav1.Now()
+	status.LastPolledAt = &now
 
 	logger := logging.LoggerFromContext(ctx)
 
-	freight, err := r.getLatestFreightFromReposFn(ctx, warehouse)
+	d, err := r.getLatestFreightFromReposFn(ctx, warehouse)
+	// Verification failures are reported even if no Freight could be
+	// discovered, since they may be the reason why.
+	status.ImageVerificationFailures = d.imageVerificationFailures
+	status.Subscriptions = mergeSubscriptionStatuses(
+		warehouse.Status.Subscriptions,
+		d.subscriptions,
+	)
 	if err != nil {
+		setReadyCondition(
+			&status,
+			warehouse.Generation,
+			kargoapi.WarehouseConditionReasonDiscoveryFailed,
+			err,
+		)
 		return status,
 			errors.Wrap(err, "error getting latest Freight from repositories")
 	}
+	freight := d.freight
 	if freight == nil {
 		logger.Debug("found no Freight from repositories")
+		setReadyCondition(
+			&status,
+			warehouse.Generation,
+			kargoapi.WarehouseConditionReasonDiscoverySucceeded,
+			nil,
+		)
 		return status, nil
 	}
 	logger.Debug("got latest Freight from repositories")
@@ -230,59 +270,190 @@ func (r *reconciler) syncWarehouse(
 				freight.Name,
 				freight.Namespace,
 			)
+			setReadyCondition(
+				&status,
+				warehouse.Generation,
+				kargoapi.WarehouseConditionReasonDiscoverySucceeded,
+				nil,
+			)
 			return status, nil
 		}
-		return status, errors.Wrapf(
+		err = errors.Wrapf(
 			err,
 			"error creating Freight %q in namespace %q",
 			freight.Name,
 			freight.Namespace,
 		)
+		setReadyCondition(
+			&status,
+			warehouse.Generation,
+			kargoapi.WarehouseConditionReasonFreightCreationFailed,
+			err,
+		)
+		return status, err
 	}
 	log.Debugf(
 		"created Freight %q in namespace %q",
 		freight.Name,
 		freight.Namespace,
 	)
+	status.LastFreight = &kargoapi.FreightReference{
+		Name:      freight.Name,
+		CreatedAt: &now,
+	}
+	setReadyCondition(
+		&status,
+		warehouse.Generation,
+		kargoapi.WarehouseConditionReasonDiscoverySucceeded,
+		nil,
+	)
 
 	return status, nil
 }
 
+// setReadyCondition sets the Ready condition of the provided status. The
+// condition is True if the provided error is nil and False otherwise.
+func setReadyCondition(
+	status *kargoapi.WarehouseStatus,
+	generation int64,
+	reason string,
+	err error,
+) {
+	condition := metav1.Condition{
+		Type:               kargoapi.WarehouseConditionTypeReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            "Discovered the latest artifacts of all subscriptions",
+	}
+	if err != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Message = err.Error()
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+}
+
+// mergeSubscriptionStatuses returns the provided current subscription
+// statuses, in which any subscription whose latest artifact could not be
+// discovered retains the artifact that was last discovered for it, as recorded
+// in the provided previous subscription statuses.
+func mergeSubscriptionStatuses(
+	previous []kargoapi.SubscriptionStatus,
+	current []kargoapi.SubscriptionStatus,
+) []kargoapi.SubscriptionStatus {
+	for i, cur := range current {
+		if cur.Error == "" {
+			continue
+		}
+		for _, prev := range previous {
+			if prev.RepoURL == cur.RepoURL && prev.ChartName == cur.ChartName {
+				current[i].LastCommit = prev.LastCommit
+				current[i].LastImage = prev.LastImage
+				current[i].LastChart = prev.LastChart
+				current[i].LastDiscoveredAt = prev.LastDiscoveredAt
+				break
+			}
+		}
+	}
+	return current
+}
+
+// getLatestFreightFromRepos polls each of the provided Warehouse's
+// subscriptions for its latest artifact. All subscriptions are polled even if
+// some fail, so that the outcome for each can be reported. Freight is only
+// assembled if every subscription was polled successfully.
 func (r *reconciler) getLatestFreightFromRepos(
 	ctx context.Context,
 	warehouse *kargoapi.Warehouse,
-) (*kargoapi.Freight, error) {
+) (discovery, error) {
 	logger := logging.LoggerFromContext(ctx)
 
-	latestCommits, err := r.getLatestCommitsFn(
-		ctx,
-		warehouse.Namespace,
-		warehouse.Spec.Subscriptions,
-	)
-	if err != nil {
-		return nil, errors.Wrap(err, "error syncing git repo subscriptions")
+	d := discovery{
+		subscriptions: make(
+			[]kargoapi.SubscriptionStatus,
+			len(warehouse.Spec.Subscriptions),
+		),
 	}
-	logger.Debug("synced git repo subscriptions")
-
-	latestImages, err := r.getLatestImagesFn(
-		ctx,
-		warehouse.Namespace,
-		warehouse.Spec.Subscriptions,
-	)
-	if err != nil {
-		return nil, errors.Wrap(err, "error syncing image repo subscriptions")
+	var previousCommits []kargoapi.GitCommit
+	for _, subStatus := range warehouse.Status.Subscriptions {
+		if subStatus.LastCommit != nil {
+			previousCommits = append(previousCommits, *subStatus.LastCommit)
+		}
 	}
-	logger.Debug("synced image repo subscriptions")
-
-	latestCharts, err := r.getLatestChartsFn(
-		ctx,
-		warehouse.Namespace,
-		warehouse.Spec.Subscriptions,
-	)
-	if err != nil {
-		return nil, errors.Wrap(err, "error syncing chart repo subscriptions")
+	var latestCommits []kargoapi.GitCommit
+	var latestImages []kargoapi.Image
+	var latestCharts []kargoapi.Chart
+	var firstErr error
+	for i, sub := range warehouse.Spec.Subscriptions {
+		subs := []kargoapi.RepoSubscription{sub}
+		subStatus := &d.subscriptions[i]
+		var err error
+		switch {
+		case sub.Git != nil:
+			subStatus.RepoURL = sub.Git.RepoURL
+			var commits []kargoapi.GitCommit
+			if commits, err = r.getLatestCommitsFn(
+				ctx,
+				warehouse.Namespace,
+				subs,
+				previousCommits,
+			); err != nil {
+				subStatus.Error = err.Error()
+				err = errors.Wrap(err, "error syncing git repo subscriptions")
+				break
+			}
+			if len(commits) > 0 {
+				subStatus.LastCommit = &commits[0]
+			}
+			latestCommits = append(latestCommits, commits...)
+			logger.WithField("repo", sub.Git.RepoURL).
+				Debug("synced git repo subscription")
+		case sub.Image != nil:
+			subStatus.RepoURL = sub.Image.RepoURL
+			var imgs []kargoapi.Image
+			var failures []kargoapi.ImageVerificationFailure
+			imgs, failures, err = r.getLatestImagesFn(ctx, warehouse.Namespace, subs)
+			d.imageVerificationFailures =
+				append(d.imageVerificationFailures, failures...)
+			if err != nil {
+				subStatus.Error = err.Error()
+				err = errors.Wrap(err, "error syncing image repo subscriptions")
+				break
+			}
+			if len(imgs) > 0 {
+				subStatus.LastImage = &imgs[0]
+			}
+			latestImages = append(latestImages, imgs...)
+			logger.WithField("repo", sub.Image.RepoURL).
+				Debug("synced image repo subscription")
+		case sub.Chart != nil:
+			subStatus.RepoURL = sub.Chart.RegistryURL
+			subStatus.ChartName = sub.Chart.Name
+			var charts []kargoapi.Chart
+			if charts, err = r.getLatestChartsFn(ctx, warehouse.Namespace, subs); err != nil {
+				subStatus.Error = err.Error()
+				err = errors.Wrap(err, "error syncing chart repo subscriptions")
+				break
+			}
+			if len(charts) > 0 {
+				subStatus.LastChart = &charts[0]
+			}
+			latestCharts = append(latestCharts, charts...)
+			logger.WithField("registry", sub.Chart.RegistryURL).
+				Debug("synced chart repo subscription")
+		}
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		now := metav1.Now()
+		subStatus.LastDiscoveredAt = &now
+	}
+	if firstErr != nil {
+		return d, firstErr
 	}
-	logger.Debug("synced chart repo subscriptions")
 
 	ownerRef := metav1.NewControllerRef(
 		warehouse,
@@ -299,5 +470,6 @@ func (r *reconciler) getLatestFreightFromRepos(
 	}
 	freight.UpdateID()
 	freight.ObjectMeta.Name = freight.ID
-	return freight, nil
+	d.freight = freight
+	return d, nil
 }
@@ -42,12 +42,44 @@ func TestSyncWarehouse(t *testing.T) {
 	scheme := k8sruntime.NewScheme()
 	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
 	testWarehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Generation: 2,
+		},
 		Spec: &kargoapi.WarehouseSpec{},
+		Status: kargoapi.WarehouseStatus{
+			ImageVerificationFailures: []kargoapi.ImageVerificationFailure{
+				{
+					RepoURL: "stale-url",
+					Tag:     "stale-tag",
+				},
+			},
+			Subscriptions: []kargoapi.SubscriptionStatus{
+				{
+					RepoURL: "fake-url",
+					LastImage: &kargoapi.Image{
+						RepoURL: "fake-url",
+						Tag:     "previous-tag",
+					},
+				},
+			},
+		},
+	}
+	requireReadyCondition := func(
+		status kargoapi.WarehouseStatus,
+		expectedStatus metav1.ConditionStatus,
+		expectedReason string,
+	) {
+		require.Len(t, status.Conditions, 1)
+		condition := status.Conditions[0]
+		require.Equal(t, kargoapi.WarehouseConditionTypeReady, condition.Type)
+		require.Equal(t, expectedStatus, condition.Status)
+		require.Equal(t, expectedReason, condition.Reason)
+		require.Equal(t, int64(2), condition.ObservedGeneration)
 	}
 	testCases := []struct {
 		name       string
 		reconciler *reconciler
-		assertions func(error)
+		assertions func(kargoapi.WarehouseStatus, error)
 	}{
 		{
 			name: "error getting latest Freight from repos",
@@ -55,11 +87,11 @@ func TestSyncWarehouse(t *testing.T) {
 				getLatestFreightFromReposFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
-				) (*kargoapi.Freight, error) {
-					return nil, errors.New("something went wrong")
+				) (discovery, error) {
+					return discovery{}, errors.New("something went wrong")
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(status kargoapi.WarehouseStatus, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "something went wrong")
 				require.Contains(
@@ -67,6 +99,67 @@ func TestSyncWarehouse(t *testing.T) {
 					err.Error(),
 					"error getting latest Freight from repos",
 				)
+				require.NotNil(t, status.LastPolledAt)
+				requireReadyCondition(
+					status,
+					metav1.ConditionFalse,
+					kargoapi.WarehouseConditionReasonDiscoveryFailed,
+				)
+			},
+		},
+
+		{
+			name: "image verification failures and subscription errors",
+			reconciler: &reconciler{
+				getLatestFreightFromReposFn: func(
+					context.Context,
+					*kargoapi.Warehouse,
+				) (discovery, error) {
+					return discovery{
+						subscriptions: []kargoapi.SubscriptionStatus{
+							{
+								RepoURL: "fake-url",
+								Error:   "something went wrong",
+							},
+						},
+						imageVerificationFailures: []kargoapi.ImageVerificationFailure{
+							{
+								RepoURL: "fake-url",
+								Tag:     "fake-tag",
+								Message: "image is not signed",
+							},
+						},
+					}, errors.New("something went wrong")
+				},
+			},
+			assertions: func(status kargoapi.WarehouseStatus, err error) {
+				require.Error(t, err)
+				require.Equal(
+					t,
+					[]kargoapi.ImageVerificationFailure{
+						{
+							RepoURL: "fake-url",
+							Tag:     "fake-tag",
+							Message: "image is not signed",
+						},
+					},
+					status.ImageVerificationFailures,
+				)
+				// The previously discovered image should have been retained
+				require.Equal(
+					t,
+					[]kargoapi.SubscriptionStatus{
+						{
+							RepoURL: "fake-url",
+							LastImage: &kargoapi.Image{
+								RepoURL: "fake-url",
+								Tag:     "previous-tag",
+							},
+							Error: "something went wrong",
+						},
+					},
+					status.Subscriptions,
+				)
 			},
 		},
 
@@ -76,12 +169,19 @@ func TestSyncWarehouse(t *testing.T) {
 				getLatestFreightFromReposFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
-				) (*kargoapi.Freight, error) {
-					return nil, nil
+				) (discovery, error) {
+					return discovery{}, nil
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(status kargoapi.WarehouseStatus, err error) {
 				require.NoError(t, err)
+				require.Empty(t, status.ImageVerificationFailures)
+				require.Nil(t, status.LastFreight)
+				requireReadyCondition(
+					status,
+					metav1.ConditionTrue,
+					kargoapi.WarehouseConditionReasonDiscoverySucceeded,
+				)
 			},
 		},
 
@@ -91,8 +191,8 @@ func TestSyncWarehouse(t *testing.T) {
 				getLatestFreightFromReposFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
-				) (*kargoapi.Freight, error) {
-					return &kargoapi.Freight{}, nil
+				) (discovery, error) {
+					return discovery{freight: &kargoapi.Freight{}}, nil
 				},
 				createFreightFn: func(
 					context.Context,
@@ -108,8 +208,14 @@ func TestSyncWarehouse(t *testing.T) {
 					)
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(status kargoapi.WarehouseStatus, err error) {
 				require.NoError(t, err)
+				require.Nil(t, status.LastFreight)
+				requireReadyCondition(
+					status,
+					metav1.ConditionTrue,
+					kargoapi.WarehouseConditionReasonDiscoverySucceeded,
+				)
 			},
 		},
 
@@ -119,8 +225,8 @@ func TestSyncWarehouse(t *testing.T) {
 				getLatestFreightFromReposFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
-				) (*kargoapi.Freight, error) {
-					return &kargoapi.Freight{}, nil
+				) (discovery, error) {
+					return discovery{freight: &kargoapi.Freight{}}, nil
 				},
 				createFreightFn: func(
 					context.Context,
@@ -130,10 +236,15 @@ func TestSyncWarehouse(t *testing.T) {
 					return errors.New("something went wrong")
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(status kargoapi.WarehouseStatus, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "something went wrong")
 				require.Contains(t, err.Error(), "error creating Freight")
+				requireReadyCondition(
+					status,
+					metav1.ConditionFalse,
+					kargoapi.WarehouseConditionReasonFreightCreationFailed,
+				)
 			},
 		},
 
@@ -143,11 +254,13 @@ func TestSyncWarehouse(t *testing.T) {
 				getLatestFreightFromReposFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
-				) (*kargoapi.Freight, error) {
-					return &kargoapi.Freight{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "fake-freight",
-							Namespace: "fake-namespace",
+				) (discovery, error) {
+					return discovery{
+						freight: &kargoapi.Freight{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "fake-freight",
+								Namespace: "fake-namespace",
+							},
 						},
 					}, nil
 				},
@@ -159,25 +272,129 @@ func TestSyncWarehouse(t *testing.T) {
 					return nil
 				},
 			},
-			assertions: func(err error) {
+			assertions: func(status kargoapi.WarehouseStatus, err error) {
 				require.NoError(t, err)
+				require.NotNil(t, status.LastPolledAt)
+				require.NotNil(t, status.LastFreight)
+				require.Equal(t, "fake-freight", status.LastFreight.Name)
+				require.Equal(t, status.LastPolledAt, status.LastFreight.CreatedAt)
+				requireReadyCondition(
+					status,
+					metav1.ConditionTrue,
+					kargoapi.WarehouseConditionReasonDiscoverySucceeded,
+				)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			_, err :=
-				testCase.reconciler.syncWarehouse(context.Background(), testWarehouse)
-			testCase.assertions(err)
+			testCase.assertions(
+				testCase.reconciler.syncWarehouse(context.Background(), testWarehouse),
+			)
 		})
 	}
 }
 
 func TestGetLatestFreightFromRepos(t *testing.T) {
+	testWarehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+		},
+		Spec: &kargoapi.WarehouseSpec{
+			Subscriptions: []kargoapi.RepoSubscription{
+				{
+					Git: &kargoapi.GitSubscription{
+						RepoURL: "fake-git-url",
+					},
+				},
+				{
+					Image: &kargoapi.ImageSubscription{
+						RepoURL: "fake-image-url",
+					},
+				},
+				{
+					Chart: &kargoapi.ChartSubscription{
+						RegistryURL: "fake-registry",
+						Name:        "fake-chart",
+					},
+				},
+			},
+		},
+		Status: kargoapi.WarehouseStatus{
+			Subscriptions: []kargoapi.SubscriptionStatus{
+				{
+					RepoURL: "fake-git-url",
+					LastCommit: &kargoapi.GitCommit{
+						RepoURL: "fake-git-url",
+						ID:      "fake-previous-commit",
+					},
+				},
+				{
+					RepoURL: "fake-image-url",
+				},
+			},
+		},
+	}
+	getLatestCommits := func(
+		_ context.Context,
+		_ string,
+		_ []kargoapi.RepoSubscription,
+		previousCommits []kargoapi.GitCommit,
+	) ([]kargoapi.GitCommit, error) {
+		// Commits discovered by the previous poll are passed along
+		require.Equal(
+			t,
+			[]kargoapi.GitCommit{
+				{
+					RepoURL: "fake-git-url",
+					ID:      "fake-previous-commit",
+				},
+			},
+			previousCommits,
+		)
+		return []kargoapi.GitCommit{
+			{
+				RepoURL: "fake-git-url",
+				ID:      "fake-commit",
+			},
+		}, nil
+	}
+	getLatestImages := func(
+		context.Context,
+		string,
+		[]kargoapi.RepoSubscription,
+	) ([]kargoapi.Image, []kargoapi.ImageVerificationFailure, error) {
+		return []kargoapi.Image{
+				{
+					RepoURL: "fake-image-url",
+					Tag:     "fake-tag",
+				},
+			},
+			[]kargoapi.ImageVerificationFailure{
+				{
+					RepoURL: "fake-image-url",
+					Tag:     "unsigned-tag",
+				},
+			},
+			nil
+	}
+	getLatestCharts := func(
+		context.Context,
+		string,
+		[]kargoapi.RepoSubscription,
+	) ([]kargoapi.Chart, error) {
+		return []kargoapi.Chart{
+			{
+				RegistryURL: "fake-registry",
+				Name:        "fake-chart",
+				Version:     "fake-version",
+			},
+		}, nil
+	}
 	testCases := []struct {
 		name       string
 		reconciler *reconciler
-		assertions func(*kargoapi.Freight, error)
+		assertions func(discovery, error)
 	}{
 		{
 			name: "error getting latest git commits",
@@ -186,36 +403,48 @@ func TestGetLatestFreightFromRepos(t *testing.T) {
 					context.Context,
 					string,
 					[]kargoapi.RepoSubscription,
+					[]kargoapi.GitCommit,
 				) ([]kargoapi.GitCommit, error) {
 					return nil, errors.New("something went wrong")
 				},
+				getLatestImagesFn: getLatestImages,
+				getLatestChartsFn: getLatestCharts,
 			},
-			assertions: func(freight *kargoapi.Freight, err error) {
+			assertions: func(d discovery, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "error syncing git repo subscription")
 				require.Contains(t, err.Error(), "something went wrong")
+				require.Nil(t, d.freight)
+				require.Len(t, d.subscriptions, 3)
+				require.Equal(t, "fake-git-url", d.subscriptions[0].RepoURL)
+				require.Equal(t, "something went wrong", d.subscriptions[0].Error)
+				require.Nil(t, d.subscriptions[0].LastCommit)
+				require.Nil(t, d.subscriptions[0].LastDiscoveredAt)
+				// Other subscriptions should still have been polled
+				require.Empty(t, d.subscriptions[1].Error)
+				require.NotNil(t, d.subscriptions[1].LastImage)
+				require.Empty(t, d.subscriptions[2].Error)
+				require.NotNil(t, d.subscriptions[2].LastChart)
 			},
 		},
 
 		{
 			name: "error getting latest images",
 			reconciler: &reconciler{
-				getLatestCommitsFn: func(
-					context.Context,
-					string,
-					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.GitCommit, error) {
-					return nil, nil
-				},
+				getLatestCommitsFn: getLatestCommits,
 				getLatestImagesFn: func(
 					context.Context,
 					string,
 					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.Image, error) {
-					return nil, errors.New("something went wrong")
+				) ([]kargoapi.Image, []kargoapi.ImageVerificationFailure, error) {
+					return nil,
+						[]kargoapi.ImageVerificationFailure{{Tag: "fake-tag"}},
+						errors.New("something went wrong")
 				},
+				getLatestChartsFn: getLatestCharts,
 			},
-			assertions: func(freight *kargoapi.Freight, err error) {
+			assertions: func(d discovery, err error) {
+				require.Len(t, d.imageVerificationFailures, 1)
 				require.Error(t, err)
 				require.Contains(
 					t,
@@ -223,26 +452,19 @@ func TestGetLatestFreightFromRepos(t *testing.T) {
 					"error syncing image repo subscriptions",
 				)
 				require.Contains(t, err.Error(), "something went wrong")
+				require.Nil(t, d.freight)
+				require.Len(t, d.subscriptions, 3)
+				require.Equal(t, "fake-image-url", d.subscriptions[1].RepoURL)
+				require.Equal(t, "something went wrong", d.subscriptions[1].Error)
+				require.Nil(t, d.subscriptions[1].LastImage)
 			},
 		},
 
 		{
 			name: "error getting latest charts",
 			reconciler: &reconciler{
-				getLatestCommitsFn: func(
-					context.Context,
-					string,
-					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.GitCommit, error) {
-					return nil, nil
-				},
-				getLatestImagesFn: func(
-					context.Context,
-					string,
-					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.Image, error) {
-					return nil, nil
-				},
+				getLatestCommitsFn: getLatestCommits,
+				getLatestImagesFn:  getLatestImages,
 				getLatestChartsFn: func(
 					context.Context,
 					string,
@@ -251,7 +473,7 @@ func TestGetLatestFreightFromRepos(t *testing.T) {
 					return nil, errors.New("something went wrong")
 				},
 			},
-			assertions: func(freight *kargoapi.Freight, err error) {
+			assertions: func(d discovery, err error) {
 				require.Error(t, err)
 				require.Contains(
 					t,
@@ -259,52 +481,42 @@ func TestGetLatestFreightFromRepos(t *testing.T) {
 					"error syncing chart repo subscriptions",
 				)
 				require.Contains(t, err.Error(), "something went wrong")
+				require.Nil(t, d.freight)
+				require.Len(t, d.subscriptions, 3)
+				require.Equal(t, "fake-registry", d.subscriptions[2].RepoURL)
+				require.Equal(t, "fake-chart", d.subscriptions[2].ChartName)
+				require.Equal(t, "something went wrong", d.subscriptions[2].Error)
 			},
 		},
 
 		{
 			name: "success",
 			reconciler: &reconciler{
-				getLatestCommitsFn: func(
-					context.Context,
-					string,
-					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.GitCommit, error) {
-					return []kargoapi.GitCommit{
-						{
-							RepoURL: "fake-url",
-							ID:      "fake-commit",
-						},
-					}, nil
-				},
-				getLatestImagesFn: func(
-					context.Context,
-					string,
-					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.Image, error) {
-					return []kargoapi.Image{
-						{
-							RepoURL: "fake-url",
-							Tag:     "fake-tag",
-						},
-					}, nil
-				},
-				getLatestChartsFn: func(
-					context.Context,
-					string,
-					[]kargoapi.RepoSubscription,
-				) ([]kargoapi.Chart, error) {
-					return []kargoapi.Chart{
-						{
-							RegistryURL: "fake-registry",
-							Name:        "fake-chart",
-							Version:     "fake-version",
-						},
-					}, nil
-				},
+				getLatestCommitsFn: getLatestCommits,
+				getLatestImagesFn:  getLatestImages,
+				getLatestChartsFn:  getLatestCharts,
 			},
-			assertions: func(freight *kargoapi.Freight, err error) {
+			assertions: func(d discovery, err error) {
 				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]kargoapi.ImageVerificationFailure{
+						{
+							RepoURL: "fake-image-url",
+							Tag:     "unsigned-tag",
+						},
+					},
+					d.imageVerificationFailures,
+				)
+				require.Len(t, d.subscriptions, 3)
+				for _, sub := range d.subscriptions {
+					require.Empty(t, sub.Error)
+					require.NotNil(t, sub.LastDiscoveredAt)
+				}
+				require.Equal(t, "fake-commit", d.subscriptions[0].LastCommit.ID)
+				require.Equal(t, "fake-tag", d.subscriptions[1].LastImage.Tag)
+				require.Equal(t, "fake-version", d.subscriptions[2].LastChart.Version)
+				freight := d.freight
 				require.NotNil(t, freight)
 				require.NotEmpty(t, freight.Name)
 				require.NotEmpty(t, freight.ID)
@@ -321,13 +533,13 @@ func TestGetLatestFreightFromRepos(t *testing.T) {
 						},
 						Commits: []kargoapi.GitCommit{
 							{
-								RepoURL: "fake-url",
+								RepoURL: "fake-git-url",
 								ID:      "fake-commit",
 							},
 						},
 						Images: []kargoapi.Image{
 							{
-								RepoURL: "fake-url",
+								RepoURL: "fake-image-url",
 								Tag:     "fake-tag",
 							},
 						},
@@ -349,12 +561,7 @@ func TestGetLatestFreightFromRepos(t *testing.T) {
 			testCase.assertions(
 				testCase.reconciler.getLatestFreightFromRepos(
 					context.Background(),
-					&kargoapi.Warehouse{
-						ObjectMeta: metav1.ObjectMeta{
-							Namespace: "fake-namespace",
-						},
-						Spec: &kargoapi.WarehouseSpec{},
-					},
+					testWarehouse,
 				),
 			)
 		})
@@ -2,14 +2,21 @@ package credentials
 
 import (
 	"context"
+	"sort"
 	"strings"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
 	"github.com/argoproj/argo-cd/v2/applicationset/utils"
 	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/git"
 )
 
@@ -26,7 +33,14 @@ const (
 	// TypeImage represents credentials for an image repository.
 	TypeImage Type = "image"
 
-	kargoSecretTypeLabel = "kargo.akuity.io/secret-type" // nolint: gosec
+	kargoSecretTypeLabel = kargoapi.LabelSecretTypeKey
+	// kargoSecretTypeGitUser is the value of the kargoSecretTypeLabel label that
+	// identifies a Secret as describing the identity with which Kargo authors
+	// and signs Git commits.
+	kargoSecretTypeGitUser = "git-user" // nolint: gosec
+
+	signingKeyTypeGPG = "gpg"
+	signingKeyTypeSSH = "ssh"
 )
 
 // Credentials generically represents any type of repository credential.
@@ -42,6 +56,21 @@ type Credentials struct {
 	SSHPrivateKey string
 }
 
+// GitUser represents the identity with which Kargo authors and, optionally,
+// signs Git commits.
+type GitUser struct {
+	// Name is the name of the commit author.
+	Name string
+	// Email is the email address of the commit author.
+	Email string
+	// SigningKeyType indicates the type of the key specified by the SigningKey
+	// field. Valid values are "gpg" and "ssh".
+	SigningKeyType string
+	// SigningKey is a private key with which commits should be signed. If
+	// empty, commits are not signed.
+	SigningKey string
+}
+
 // Database is an interface for a Credentials store.
 type Database interface {
 	Get(
@@ -50,6 +79,10 @@ type Database interface {
 		credType Type,
 		repo string,
 	) (Credentials, bool, error)
+	// GetGitUser returns the identity with which Kargo should author and sign
+	// Git commits on behalf of the specified namespace (project), if one has
+	// been configured.
+	GetGitUser(ctx context.Context, namespace string) (GitUser, bool, error)
 }
 
 // kubernetesDatabase is an implementation of the Database interface that
@@ -183,6 +216,125 @@ func (k *kubernetesDatabase) Get(
 	return creds, false, nil
 }
 
+func (k *kubernetesDatabase) GetGitUser(
+	ctx context.Context,
+	namespace string,
+) (GitUser, bool, error) {
+	secrets := corev1.SecretList{}
+	if err := k.kargoClient.List(
+		ctx,
+		&secrets,
+		&client.ListOptions{
+			Namespace: namespace,
+			LabelSelector: labels.Set(map[string]string{
+				kargoSecretTypeLabel: kargoSecretTypeGitUser,
+			}).AsSelector(),
+		},
+	); err != nil {
+		return GitUser{}, false, err
+	}
+	if len(secrets.Items) == 0 {
+		return GitUser{}, false, nil
+	}
+	// Sort for determinism in the unlikely event there is more than one
+	sort.Slice(secrets.Items, func(i, j int) bool {
+		return secrets.Items[i].Name < secrets.Items[j].Name
+	})
+	user := secretToGitUser(&secrets.Items[0])
+	if err := validateSigningKey(user.SigningKeyType, user.SigningKey); err != nil {
+		return GitUser{}, false, errors.Wrapf(
+			err,
+			"error validating signing key in Secret %q in namespace %q",
+			secrets.Items[0].Name,
+			namespace,
+		)
+	}
+	return user, true, nil
+}
+
+// validateSigningKey returns an error if the provided signing key cannot be
+// used by the git CLI to sign commits non-interactively. Keys protected by a
+// passphrase are rejected here because signing with them would otherwise only
+// fail, with a far less helpful error, when the first commit is made.
+func validateSigningKey(keyType, key string) error {
+	if key == "" {
+		return nil
+	}
+	switch keyType {
+	case signingKeyTypeGPG:
+		entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(key))
+		if err != nil {
+			return errors.Wrap(err, "error reading GPG signing key")
+		}
+		if len(entities) == 0 || entities[0].PrivateKey == nil {
+			return errors.New("GPG signing key does not contain a private key")
+		}
+		privateKeys := []*packet.PrivateKey{entities[0].PrivateKey}
+		for _, subkey := range entities[0].Subkeys {
+			if subkey.PrivateKey != nil {
+				privateKeys = append(privateKeys, subkey.PrivateKey)
+			}
+		}
+		for _, privateKey := range privateKeys {
+			if privateKey.Dummy() {
+				return errors.New(
+					"GPG signing key is a stub that does not contain key material",
+				)
+			}
+			if privateKey.Encrypted {
+				return errors.New(
+					"GPG signing key is protected by a passphrase, which is not supported",
+				)
+			}
+		}
+	case signingKeyTypeSSH:
+		if _, err := ssh.ParseRawPrivateKey([]byte(key)); err != nil {
+			if _, ok := err.(*ssh.PassphraseMissingError); ok {
+				return errors.New(
+					"SSH signing key is protected by a passphrase, which is not supported",
+				)
+			}
+			return errors.Wrap(err, "error parsing SSH signing key")
+		}
+	default:
+		return errors.Errorf(
+			"unsupported signing key type %q; valid types are %q and %q",
+			keyType,
+			signingKeyTypeGPG,
+			signingKeyTypeSSH,
+		)
+	}
+	return nil
+}
+
+// ValidateWebhookHeadersSecret returns an error unless the provided Secret has
+// been labeled as holding headers for HTTP webhooks. Without this opt-in, anyone
+// able to edit a Stage could have the contents of any Secret in the project,
+// including repository credentials and commit signing keys, sent to a URL of
+// their choosing.
+func ValidateWebhookHeadersSecret(secret metav1.Object) error {
+	secretType := secret.GetLabels()[kargoSecretTypeLabel]
+	switch {
+	case secretType == common.LabelValueSecretTypeRepository,
+		secretType == common.LabelValueSecretTypeRepoCreds,
+		secretType == kargoSecretTypeGitUser,
+		secret.GetLabels()[utils.ArgoCDSecretTypeLabel] != "":
+		return errors.Errorf(
+			"Secret %q holds credentials and may not be used to supply webhook headers",
+			secret.GetName(),
+		)
+	case secretType != kargoapi.LabelSecretTypeWebhookHeaders:
+		return errors.Errorf(
+			"Secret %q may not be used to supply webhook headers unless it is "+
+				"labeled %s=%s",
+			secret.GetName(),
+			kargoSecretTypeLabel,
+			kargoapi.LabelSecretTypeWebhookHeaders,
+		)
+	}
+	return nil
+}
+
 func getCredentialsSecret(
 	ctx context.Context,
 	kubeClient client.Client,
@@ -239,3 +391,12 @@ func secretToCreds(secret *corev1.Secret) Credentials {
 		SSHPrivateKey: string(secret.Data["sshPrivateKey"]),
 	}
 }
+
+func secretToGitUser(secret *corev1.Secret) GitUser {
+	return GitUser{
+		Name:           string(secret.Data["name"]),
+		Email:          string(secret.Data["email"]),
+		SigningKeyType: string(secret.Data["signingKeyType"]),
+		SigningKey:     string(secret.Data["signingKey"]),
+	}
+}
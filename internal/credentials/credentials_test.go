@@ -1,14 +1,26 @@
 package credentials
 
 import (
+	"bytes"
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
 	"testing"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/argoproj/argo-cd/v2/applicationset/utils"
+	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 )
 
 func TestNewKubernetesDatabase(t *testing.T) {
@@ -148,3 +160,278 @@ func TestSecretToCreds(t *testing.T) {
 	require.Equal(t, string(secret.Data["password"]), creds.Password)
 	require.Equal(t, string(secret.Data["sshPrivateKey"]), creds.SSHPrivateKey)
 }
+
+func TestGetGitUser(t *testing.T) {
+	const testNamespace = "fake-namespace"
+
+	// Generate a GPG key and a copy of it that is protected by a passphrase
+	gpgKey := serializeGPGKey(t, nil)
+	protectedGPGKey := serializeGPGKey(t, []byte("fake-passphrase"))
+
+	// Generate an SSH key and a copy of it that is protected by a passphrase
+	_, sshPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	sshKeyBlock, err := ssh.MarshalPrivateKey(sshPrivateKey, "")
+	require.NoError(t, err)
+	sshKey := string(pem.EncodeToMemory(sshKeyBlock))
+	protectedSSHKeyBlock, err := ssh.MarshalPrivateKeyWithPassphrase(
+		sshPrivateKey,
+		"",
+		[]byte("fake-passphrase"),
+	)
+	require.NoError(t, err)
+	protectedSSHKey := string(pem.EncodeToMemory(protectedSSHKeyBlock))
+
+	gitUserSecret := func(keyType, key string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      "git-user",
+				Namespace: testNamespace,
+				Labels: map[string]string{
+					kargoSecretTypeLabel: kargoSecretTypeGitUser,
+				},
+			},
+			Data: map[string][]byte{
+				"name":           []byte("fake-name"),
+				"signingKeyType": []byte(keyType),
+				"signingKey":     []byte(key),
+			},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		assertions func(GitUser, bool, error)
+	}{
+		{
+			name: "no git user found",
+			objects: []client.Object{
+				&corev1.Secret{ // Should never match because it isn't labeled
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "git-user",
+						Namespace: testNamespace,
+					},
+					Data: map[string][]byte{
+						"name": []byte("fake-name"),
+					},
+				},
+				&corev1.Secret{ // Should never match because it's in another namespace
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "git-user",
+						Namespace: "another-namespace",
+						Labels: map[string]string{
+							kargoSecretTypeLabel: kargoSecretTypeGitUser,
+						},
+					},
+					Data: map[string][]byte{
+						"name": []byte("fake-name"),
+					},
+				},
+			},
+			assertions: func(_ GitUser, ok bool, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+			},
+		},
+		{
+			name: "git user found",
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "git-user-b",
+						Namespace: testNamespace,
+						Labels: map[string]string{
+							kargoSecretTypeLabel: kargoSecretTypeGitUser,
+						},
+					},
+					Data: map[string][]byte{
+						"name": []byte("another-fake-name"),
+					},
+				},
+				&corev1.Secret{ // Should win because its name sorts first
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "git-user-a",
+						Namespace: testNamespace,
+						Labels: map[string]string{
+							kargoSecretTypeLabel: kargoSecretTypeGitUser,
+						},
+					},
+					Data: map[string][]byte{
+						"name":           []byte("fake-name"),
+						"email":          []byte("fake-email"),
+						"signingKeyType": []byte("ssh"),
+						"signingKey":     []byte(sshKey),
+					},
+				},
+			},
+			assertions: func(user GitUser, ok bool, err error) {
+				require.NoError(t, err)
+				require.True(t, ok)
+				require.Equal(
+					t,
+					GitUser{
+						Name:           "fake-name",
+						Email:          "fake-email",
+						SigningKeyType: "ssh",
+						SigningKey:     sshKey,
+					},
+					user,
+				)
+			},
+		},
+		{
+			name:    "git user with GPG signing key",
+			objects: []client.Object{gitUserSecret("gpg", gpgKey)},
+			assertions: func(user GitUser, ok bool, err error) {
+				require.NoError(t, err)
+				require.True(t, ok)
+				require.Equal(t, gpgKey, user.SigningKey)
+			},
+		},
+		{
+			name:    "GPG signing key protected by passphrase",
+			objects: []client.Object{gitUserSecret("gpg", protectedGPGKey)},
+			assertions: func(_ GitUser, ok bool, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "protected by a passphrase")
+				require.False(t, ok)
+			},
+		},
+		{
+			name:    "SSH signing key protected by passphrase",
+			objects: []client.Object{gitUserSecret("ssh", protectedSSHKey)},
+			assertions: func(_ GitUser, ok bool, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "protected by a passphrase")
+				require.False(t, ok)
+			},
+		},
+		{
+			name:    "invalid SSH signing key",
+			objects: []client.Object{gitUserSecret("ssh", "fake-signing-key")},
+			assertions: func(_ GitUser, ok bool, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing SSH signing key")
+				require.False(t, ok)
+			},
+		},
+		{
+			name:    "unsupported signing key type",
+			objects: []client.Object{gitUserSecret("x509", sshKey)},
+			assertions: func(_ GitUser, ok bool, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "unsupported signing key type")
+				require.False(t, ok)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			db := NewKubernetesDatabase(
+				"",
+				fake.NewClientBuilder().WithObjects(testCase.objects...).Build(),
+				nil,
+			)
+			testCase.assertions(db.GetGitUser(context.Background(), testNamespace))
+		})
+	}
+}
+
+// serializeGPGKey generates a GPG key and returns it ASCII-armored. If a
+// passphrase is provided, the private keys are encrypted using it.
+func serializeGPGKey(t *testing.T, passphrase []byte) string {
+	entity, err := openpgp.NewEntity("fake-name", "", "fake-email", nil)
+	require.NoError(t, err)
+	buf := &bytes.Buffer{}
+	armorWriter, err := armor.Encode(buf, openpgp.PrivateKeyType, nil)
+	require.NoError(t, err)
+	if passphrase != nil {
+		require.NoError(t, entity.EncryptPrivateKeys(passphrase, nil))
+		require.NoError(t, entity.SerializePrivateWithoutSigning(armorWriter, nil))
+	} else {
+		require.NoError(t, entity.SerializePrivate(armorWriter, nil))
+	}
+	require.NoError(t, armorWriter.Close())
+	return buf.String()
+}
+
+func TestValidateWebhookHeadersSecret(t *testing.T) {
+	testCases := []struct {
+		name       string
+		labels     map[string]string
+		assertions func(error)
+	}{
+		{
+			name: "unlabeled",
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "unless it is labeled")
+			},
+		},
+		{
+			name: "repository credentials",
+			labels: map[string]string{
+				kargoSecretTypeLabel: common.LabelValueSecretTypeRepository,
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "holds credentials")
+			},
+		},
+		{
+			name: "repository credentials template",
+			labels: map[string]string{
+				kargoSecretTypeLabel: common.LabelValueSecretTypeRepoCreds,
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "holds credentials")
+			},
+		},
+		{
+			name: "git user",
+			labels: map[string]string{
+				kargoSecretTypeLabel: kargoSecretTypeGitUser,
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "holds credentials")
+			},
+		},
+		{
+			name: "Argo CD credentials",
+			labels: map[string]string{
+				kargoSecretTypeLabel:        kargoapi.LabelSecretTypeWebhookHeaders,
+				utils.ArgoCDSecretTypeLabel: common.LabelValueSecretTypeRepository,
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "holds credentials")
+			},
+		},
+		{
+			name: "webhook headers",
+			labels: map[string]string{
+				kargoSecretTypeLabel: kargoapi.LabelSecretTypeWebhookHeaders,
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				ValidateWebhookHeadersSecret(
+					&corev1.Secret{
+						ObjectMeta: v1.ObjectMeta{
+							Name:   "fake-secret",
+							Labels: testCase.labels,
+						},
+					},
+				),
+			)
+		})
+	}
+}
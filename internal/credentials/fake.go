@@ -11,6 +11,10 @@ type FakeDB struct {
 		credType Type,
 		repo string,
 	) (Credentials, bool, error)
+	GetGitUserFn func(
+		ctx context.Context,
+		namespace string,
+	) (GitUser, bool, error)
 }
 
 func (f *FakeDB) Get(
@@ -24,3 +28,13 @@ func (f *FakeDB) Get(
 	}
 	return f.GetFn(ctx, namespace, credType, repo)
 }
+
+func (f *FakeDB) GetGitUser(
+	ctx context.Context,
+	namespace string,
+) (GitUser, bool, error) {
+	if f.GetGitUserFn == nil {
+		return GitUser{}, false, nil
+	}
+	return f.GetGitUserFn(ctx, namespace)
+}
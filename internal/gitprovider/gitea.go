@@ -0,0 +1,78 @@
+package gitprovider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// giteaService is an implementation of the Service interface for Gitea.
+type giteaService struct {
+	*client
+	repoPath string
+}
+
+type giteaPullRequest struct {
+	Number         int64  `json:"number"`
+	HTMLURL        string `json:"html_url"`
+	State          string `json:"state"`
+	Merged         bool   `json:"merged"`
+	MergeCommitSHA string `json:"merge_commit_sha"`
+}
+
+// CreatePullRequest implements the Service interface.
+func (g *giteaService) CreatePullRequest(
+	ctx context.Context,
+	opts CreatePullRequestOpts,
+) (*PullRequest, error) {
+	pr := giteaPullRequest{}
+	if err := g.do(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("/repos/%s/pulls", g.repoPath),
+		map[string]string{
+			"head":  opts.Head,
+			"base":  opts.Base,
+			"title": opts.Title,
+			"body":  opts.Description,
+		},
+		&pr,
+	); err != nil {
+		return nil, err
+	}
+	return pr.toPullRequest(), nil
+}
+
+// GetPullRequest implements the Service interface.
+func (g *giteaService) GetPullRequest(
+	ctx context.Context,
+	number int64,
+) (*PullRequest, error) {
+	pr := giteaPullRequest{}
+	if err := g.do(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("/repos/%s/pulls/%d", g.repoPath, number),
+		nil,
+		&pr,
+	); err != nil {
+		return nil, err
+	}
+	return pr.toPullRequest(), nil
+}
+
+func (g *giteaPullRequest) toPullRequest() *PullRequest {
+	pr := &PullRequest{
+		Number: g.Number,
+		URL:    g.HTMLURL,
+		State:  PullRequestStateOpen,
+	}
+	switch {
+	case g.Merged:
+		pr.State = PullRequestStateMerged
+		pr.MergeCommit = g.MergeCommitSHA
+	case g.State == "closed":
+		pr.State = PullRequestStateClosed
+	}
+	return pr
+}
@@ -0,0 +1,79 @@
+package gitprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGiteaService(t *testing.T) {
+	srv := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "token fake-token", r.Header.Get("Authorization"))
+			switch {
+			case r.Method == http.MethodPost && r.URL.Path == "/repos/owner/repo/pulls":
+				body := map[string]string{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				require.Equal(t, "kargo/promotion/fake-promo", body["head"])
+				require.Equal(t, "main", body["base"])
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{
+					"number": 3,
+					"html_url": "https://gitea.example.com/owner/repo/pulls/3",
+					"state": "open",
+					"merged": false
+				}`))
+			case r.Method == http.MethodGet && r.URL.Path == "/repos/owner/repo/pulls/3":
+				_, _ = w.Write([]byte(`{
+					"number": 3,
+					"html_url": "https://gitea.example.com/owner/repo/pulls/3",
+					"state": "closed",
+					"merged": true,
+					"merge_commit_sha": "fake-merge-commit"
+				}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}),
+	)
+	defer srv.Close()
+
+	svc, err := NewService(
+		"https://gitea.example.com/owner/repo",
+		Options{
+			Name:       Gitea,
+			APIURL:     srv.URL,
+			Token:      "fake-token",
+			HTTPClient: srv.Client(),
+		},
+	)
+	require.NoError(t, err)
+
+	pr, err := svc.CreatePullRequest(
+		context.Background(),
+		CreatePullRequestOpts{
+			Head:  "kargo/promotion/fake-promo",
+			Base:  "main",
+			Title: "fake title",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&PullRequest{
+			Number: 3,
+			URL:    "https://gitea.example.com/owner/repo/pulls/3",
+			State:  PullRequestStateOpen,
+		},
+		pr,
+	)
+
+	pr, err = svc.GetPullRequest(context.Background(), 3)
+	require.NoError(t, err)
+	require.Equal(t, PullRequestStateMerged, pr.State)
+	require.Equal(t, "fake-merge-commit", pr.MergeCommit)
+}
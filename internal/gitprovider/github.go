@@ -0,0 +1,81 @@
+package gitprovider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// gitHubService is an implementation of the Service interface for GitHub and
+// GitHub Enterprise.
+type gitHubService struct {
+	*client
+	repoPath string
+}
+
+type gitHubPullRequest struct {
+	Number         int64  `json:"number"`
+	HTMLURL        string `json:"html_url"`
+	State          string `json:"state"`
+	Merged         bool   `json:"merged"`
+	MergeCommitSHA string `json:"merge_commit_sha"`
+}
+
+// CreatePullRequest implements the Service interface.
+func (g *gitHubService) CreatePullRequest(
+	ctx context.Context,
+	opts CreatePullRequestOpts,
+) (*PullRequest, error) {
+	pr := gitHubPullRequest{}
+	if err := g.do(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("/repos/%s/pulls", g.repoPath),
+		map[string]string{
+			"head":  opts.Head,
+			"base":  opts.Base,
+			"title": opts.Title,
+			"body":  opts.Description,
+		},
+		&pr,
+	); err != nil {
+		return nil, err
+	}
+	return pr.toPullRequest(), nil
+}
+
+// GetPullRequest implements the Service interface.
+func (g *gitHubService) GetPullRequest(
+	ctx context.Context,
+	number int64,
+) (*PullRequest, error) {
+	pr := gitHubPullRequest{}
+	if err := g.do(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("/repos/%s/pulls/%d", g.repoPath, number),
+		nil,
+		&pr,
+	); err != nil {
+		return nil, err
+	}
+	return pr.toPullRequest(), nil
+}
+
+func (g *gitHubPullRequest) toPullRequest() *PullRequest {
+	pr := &PullRequest{
+		Number: g.Number,
+		URL:    g.HTMLURL,
+		State:  PullRequestStateOpen,
+	}
+	switch {
+	case g.Merged:
+		// Note: GitHub populates merge_commit_sha with a test merge commit even
+		// before a pull request is merged, so it's only meaningful here.
+		pr.State = PullRequestStateMerged
+		pr.MergeCommit = g.MergeCommitSHA
+	case g.State == "closed":
+		pr.State = PullRequestStateClosed
+	}
+	return pr
+}
@@ -0,0 +1,96 @@
+package gitprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitHubService(t *testing.T) {
+	srv := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "Bearer fake-token", r.Header.Get("Authorization"))
+			switch {
+			case r.Method == http.MethodPost && r.URL.Path == "/repos/owner/repo/pulls":
+				body := map[string]string{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				require.Equal(t, "kargo/promotion/fake-promo", body["head"])
+				require.Equal(t, "main", body["base"])
+				require.Equal(t, "fake title", body["title"])
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{
+					"number": 42,
+					"html_url": "https://github.com/owner/repo/pull/42",
+					"state": "open",
+					"merged": false,
+					"merge_commit_sha": "test-merge-commit"
+				}`))
+			case r.Method == http.MethodGet && r.URL.Path == "/repos/owner/repo/pulls/42":
+				_, _ = w.Write([]byte(`{
+					"number": 42,
+					"html_url": "https://github.com/owner/repo/pull/42",
+					"state": "closed",
+					"merged": true,
+					"merge_commit_sha": "fake-merge-commit"
+				}`))
+			case r.Method == http.MethodGet && r.URL.Path == "/repos/owner/repo/pulls/43":
+				_, _ = w.Write([]byte(`{
+					"number": 43,
+					"html_url": "https://github.com/owner/repo/pull/43",
+					"state": "closed",
+					"merged": false
+				}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}),
+	)
+	defer srv.Close()
+
+	svc, err := NewService(
+		"https://github.com/owner/repo",
+		Options{
+			APIURL:     srv.URL,
+			Token:      "fake-token",
+			HTTPClient: srv.Client(),
+		},
+	)
+	require.NoError(t, err)
+
+	pr, err := svc.CreatePullRequest(
+		context.Background(),
+		CreatePullRequestOpts{
+			Head:  "kargo/promotion/fake-promo",
+			Base:  "main",
+			Title: "fake title",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&PullRequest{
+			Number: 42,
+			URL:    "https://github.com/owner/repo/pull/42",
+			State:  PullRequestStateOpen,
+		},
+		pr,
+	)
+
+	pr, err = svc.GetPullRequest(context.Background(), 42)
+	require.NoError(t, err)
+	require.Equal(t, PullRequestStateMerged, pr.State)
+	require.Equal(t, "fake-merge-commit", pr.MergeCommit)
+
+	pr, err = svc.GetPullRequest(context.Background(), 43)
+	require.NoError(t, err)
+	require.Equal(t, PullRequestStateClosed, pr.State)
+	require.Empty(t, pr.MergeCommit)
+
+	_, err = svc.GetPullRequest(context.Background(), 44)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unexpected status code 404")
+}
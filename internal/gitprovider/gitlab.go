@@ -0,0 +1,89 @@
+package gitprovider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// gitLabService is an implementation of the Service interface for GitLab. In
+// GitLab, pull requests are called merge requests.
+type gitLabService struct {
+	*client
+	repoPath string
+}
+
+type gitLabMergeRequest struct {
+	IID             int64  `json:"iid"`
+	WebURL          string `json:"web_url"`
+	State           string `json:"state"`
+	MergeCommitSHA  string `json:"merge_commit_sha"`
+	SquashCommitSHA string `json:"squash_commit_sha"`
+}
+
+// CreatePullRequest implements the Service interface.
+func (g *gitLabService) CreatePullRequest(
+	ctx context.Context,
+	opts CreatePullRequestOpts,
+) (*PullRequest, error) {
+	mr := gitLabMergeRequest{}
+	if err := g.do(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("/projects/%s/merge_requests", url.PathEscape(g.repoPath)),
+		map[string]string{
+			"source_branch": opts.Head,
+			"target_branch": opts.Base,
+			"title":         opts.Title,
+			"description":   opts.Description,
+		},
+		&mr,
+	); err != nil {
+		return nil, err
+	}
+	return mr.toPullRequest(), nil
+}
+
+// GetPullRequest implements the Service interface.
+func (g *gitLabService) GetPullRequest(
+	ctx context.Context,
+	number int64,
+) (*PullRequest, error) {
+	mr := gitLabMergeRequest{}
+	if err := g.do(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf(
+			"/projects/%s/merge_requests/%d",
+			url.PathEscape(g.repoPath),
+			number,
+		),
+		nil,
+		&mr,
+	); err != nil {
+		return nil, err
+	}
+	return mr.toPullRequest(), nil
+}
+
+func (g *gitLabMergeRequest) toPullRequest() *PullRequest {
+	pr := &PullRequest{
+		Number: g.IID,
+		URL:    g.WebURL,
+		State:  PullRequestStateOpen,
+	}
+	switch g.State {
+	case "merged":
+		pr.State = PullRequestStateMerged
+		// When a merge request is squashed and fast-forwarded, no merge commit is
+		// created.
+		pr.MergeCommit = g.MergeCommitSHA
+		if pr.MergeCommit == "" {
+			pr.MergeCommit = g.SquashCommitSHA
+		}
+	case "closed":
+		pr.State = PullRequestStateClosed
+	}
+	return pr
+}
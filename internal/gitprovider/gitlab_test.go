@@ -0,0 +1,90 @@
+package gitprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitLabService(t *testing.T) {
+	srv := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "fake-token", r.Header.Get("PRIVATE-TOKEN"))
+			switch {
+			case r.Method == http.MethodPost &&
+				r.URL.EscapedPath() == "/projects/group%2Frepo/merge_requests":
+				body := map[string]string{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				require.Equal(t, "kargo/promotion/fake-promo", body["source_branch"])
+				require.Equal(t, "main", body["target_branch"])
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{
+					"iid": 7,
+					"web_url": "https://gitlab.com/group/repo/-/merge_requests/7",
+					"state": "opened"
+				}`))
+			case r.Method == http.MethodGet &&
+				r.URL.EscapedPath() == "/projects/group%2Frepo/merge_requests/7":
+				_, _ = w.Write([]byte(`{
+					"iid": 7,
+					"web_url": "https://gitlab.com/group/repo/-/merge_requests/7",
+					"state": "merged",
+					"merge_commit_sha": null,
+					"squash_commit_sha": "fake-squash-commit"
+				}`))
+			case r.Method == http.MethodGet &&
+				r.URL.EscapedPath() == "/projects/group%2Frepo/merge_requests/8":
+				_, _ = w.Write([]byte(`{
+					"iid": 8,
+					"web_url": "https://gitlab.com/group/repo/-/merge_requests/8",
+					"state": "closed"
+				}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}),
+	)
+	defer srv.Close()
+
+	svc, err := NewService(
+		"https://gitlab.com/group/repo.git",
+		Options{
+			APIURL:     srv.URL,
+			Token:      "fake-token",
+			HTTPClient: srv.Client(),
+		},
+	)
+	require.NoError(t, err)
+
+	pr, err := svc.CreatePullRequest(
+		context.Background(),
+		CreatePullRequestOpts{
+			Head:  "kargo/promotion/fake-promo",
+			Base:  "main",
+			Title: "fake title",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		&PullRequest{
+			Number: 7,
+			URL:    "https://gitlab.com/group/repo/-/merge_requests/7",
+			State:  PullRequestStateOpen,
+		},
+		pr,
+	)
+
+	pr, err = svc.GetPullRequest(context.Background(), 7)
+	require.NoError(t, err)
+	require.Equal(t, PullRequestStateMerged, pr.State)
+	require.Equal(t, "fake-squash-commit", pr.MergeCommit)
+
+	pr, err = svc.GetPullRequest(context.Background(), 8)
+	require.NoError(t, err)
+	require.Equal(t, PullRequestStateClosed, pr.State)
+}
@@ -0,0 +1,257 @@
+package gitprovider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Name identifies a Git hosting provider.
+type Name string
+
+const (
+	// GitHub identifies GitHub and GitHub Enterprise.
+	GitHub Name = "github"
+	// GitLab identifies GitLab.com and self-hosted GitLab.
+	GitLab Name = "gitlab"
+	// Gitea identifies Gitea.
+	Gitea Name = "gitea"
+)
+
+// PullRequestState represents the state of a pull request.
+type PullRequestState string
+
+const (
+	// PullRequestStateOpen denotes a pull request that has not been merged or
+	// closed yet.
+	PullRequestStateOpen PullRequestState = "Open"
+	// PullRequestStateMerged denotes a pull request that has been merged.
+	PullRequestStateMerged PullRequestState = "Merged"
+	// PullRequestStateClosed denotes a pull request that has been closed
+	// without being merged.
+	PullRequestStateClosed PullRequestState = "Closed"
+)
+
+// PullRequest is a provider-agnostic representation of a pull request (or, in
+// GitLab parlance, a merge request).
+type PullRequest struct {
+	// Number is the provider-assigned number of the pull request. It is unique
+	// only within the repository.
+	Number int64
+	// URL is the URL of the pull request's web page.
+	URL string
+	// State is the current state of the pull request.
+	State PullRequestState
+	// MergeCommit is the ID of the commit that resulted from merging the pull
+	// request. It is only set if State is PullRequestStateMerged.
+	MergeCommit string
+}
+
+// CreatePullRequestOpts encapsulates the options used when creating a pull
+// request.
+type CreatePullRequestOpts struct {
+	// Head is the name of the branch containing the proposed changes.
+	Head string
+	// Base is the name of the branch the changes should be merged into.
+	Base string
+	// Title is the title of the pull request.
+	Title string
+	// Description is the body of the pull request.
+	Description string
+}
+
+// Service is an interface for managing pull requests in a single repository
+// hosted by a Git hosting provider.
+type Service interface {
+	// CreatePullRequest opens a new pull request.
+	CreatePullRequest(context.Context, CreatePullRequestOpts) (*PullRequest, error)
+	// GetPullRequest returns the pull request with the specified number.
+	GetPullRequest(context.Context, int64) (*PullRequest, error)
+}
+
+// Options encapsulates the options used when constructing a Service.
+type Options struct {
+	// Name explicitly identifies the provider. When empty, an attempt is made to
+	// infer the provider from the repository URL.
+	Name Name
+	// APIURL is the base URL of the provider's API. When empty, it is derived
+	// from the repository URL.
+	APIURL string
+	// Token is used to authenticate to the provider's API. It is required.
+	Token string
+	// HTTPClient is the client used to make requests to the provider's API. When
+	// nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+}
+
+// NewService returns an implementation of the Service interface for the
+// repository at the specified URL.
+func NewService(repoURL string, opts Options) (Service, error) {
+	// Every provider requires authentication to open a pull request, so fail
+	// fast instead of sending requests with an empty token.
+	if opts.Token == "" {
+		return nil, errors.New(
+			"a username/password or token credential is required to open pull " +
+				"requests",
+		)
+	}
+	host, repoPath, err := parseRepoURL(repoURL)
+	if err != nil {
+		return nil, err
+	}
+	name := opts.Name
+	if name == "" {
+		if name, err = inferName(host); err != nil {
+			return nil, err
+		}
+	}
+	c := &client{
+		apiURL:     strings.TrimSuffix(opts.APIURL, "/"),
+		httpClient: opts.HTTPClient,
+	}
+	if c.httpClient == nil {
+		c.httpClient = http.DefaultClient
+	}
+	switch name {
+	case GitHub:
+		if c.apiURL == "" {
+			if host == "github.com" {
+				c.apiURL = "https://api.github.com"
+			} else {
+				c.apiURL = fmt.Sprintf("https://%s/api/v3", host)
+			}
+		}
+		c.authHeader, c.authValue = "Authorization", "Bearer "+opts.Token
+		return &gitHubService{client: c, repoPath: repoPath}, nil
+	case GitLab:
+		if c.apiURL == "" {
+			c.apiURL = fmt.Sprintf("https://%s/api/v4", host)
+		}
+		c.authHeader, c.authValue = "PRIVATE-TOKEN", opts.Token
+		return &gitLabService{client: c, repoPath: repoPath}, nil
+	case Gitea:
+		if c.apiURL == "" {
+			c.apiURL = fmt.Sprintf("https://%s/api/v1", host)
+		}
+		c.authHeader, c.authValue = "Authorization", "token "+opts.Token
+		return &giteaService{client: c, repoPath: repoPath}, nil
+	default:
+		return nil, errors.Errorf("unsupported Git provider %q", name)
+	}
+}
+
+// inferName attempts to infer the name of a Git hosting provider from the
+// host portion of a repository URL. This only works for well-known, publicly
+// hosted providers.
+func inferName(host string) (Name, error) {
+	switch host {
+	case "github.com":
+		return GitHub, nil
+	case "gitlab.com":
+		return GitLab, nil
+	case "gitea.com":
+		return Gitea, nil
+	}
+	return "", errors.Errorf(
+		"unable to infer Git provider from host %q; provider must be "+
+			"specified explicitly",
+		host,
+	)
+}
+
+// parseRepoURL extracts the host and the repository path (e.g. "owner/repo")
+// from a repository URL. Both HTTP(S) and SSH URLs are supported.
+func parseRepoURL(repoURL string) (string, string, error) {
+	var host, repoPath string
+	if !strings.Contains(repoURL, "://") && strings.Contains(repoURL, "@") {
+		// SCP-style SSH URL, e.g. git@github.com:owner/repo.git
+		parts := strings.SplitN(repoURL[strings.Index(repoURL, "@")+1:], ":", 2)
+		if len(parts) != 2 {
+			return "", "", errors.Errorf("error parsing repository URL %q", repoURL)
+		}
+		host, repoPath = parts[0], parts[1]
+	} else {
+		u, err := url.Parse(repoURL)
+		if err != nil {
+			return "", "", errors.Wrapf(err, "error parsing repository URL %q", repoURL)
+		}
+		host, repoPath = u.Hostname(), u.Path
+	}
+	repoPath = strings.TrimSuffix(strings.Trim(repoPath, "/"), ".git")
+	if host == "" || !strings.Contains(repoPath, "/") {
+		return "", "", errors.Errorf(
+			"repository URL %q does not identify a repository",
+			repoURL,
+		)
+	}
+	return host, repoPath, nil
+}
+
+// client is a minimal JSON-over-HTTP client that is shared by all provider
+// implementations.
+type client struct {
+	apiURL     string
+	authHeader string
+	authValue  string
+	httpClient *http.Client
+}
+
+// do sends a request with the specified method to the specified path, relative
+// to the API's base URL, and unmarshals the JSON response into resBody. If
+// reqBody is non-nil, it is marshaled to JSON and sent as the request body.
+func (c *client) do(
+	ctx context.Context,
+	method string,
+	path string,
+	reqBody any,
+	resBody any,
+) error {
+	var body io.Reader
+	if reqBody != nil {
+		reqBytes, err := json.Marshal(reqBody)
+		if err != nil {
+			return errors.Wrap(err, "error marshaling request body")
+		}
+		body = bytes.NewReader(reqBytes)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.apiURL+path, body)
+	if err != nil {
+		return errors.Wrap(err, "error creating request")
+	}
+	req.Header.Set("Accept", "application/json")
+	if reqBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.authValue != "" {
+		req.Header.Set(c.authHeader, c.authValue)
+	}
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "error sending %s request to %q", method, req.URL)
+	}
+	defer res.Body.Close()
+	resBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.Wrap(err, "error reading response body")
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return errors.Errorf(
+			"%s request to %q returned unexpected status code %d: %s",
+			method,
+			req.URL,
+			res.StatusCode,
+			strings.TrimSpace(string(resBytes)),
+		)
+	}
+	return errors.Wrap(
+		json.Unmarshal(resBytes, resBody),
+		"error unmarshaling response body",
+	)
+}
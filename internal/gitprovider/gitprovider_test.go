@@ -0,0 +1,146 @@
+package gitprovider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewService(t *testing.T) {
+	testCases := []struct {
+		name       string
+		repoURL    string
+		opts       Options
+		assertions func(Service, error)
+	}{
+		{
+			name:    "no token",
+			repoURL: "https://github.com/owner/repo.git",
+			assertions: func(_ Service, err error) {
+				require.Error(t, err)
+				require.Contains(
+					t,
+					err.Error(),
+					"a username/password or token credential is required",
+				)
+			},
+		},
+		{
+			name:    "invalid repo URL",
+			repoURL: "https://github.com",
+			opts:    Options{Token: "fake-token"},
+			assertions: func(_ Service, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "does not identify a repository")
+			},
+		},
+		{
+			name:    "provider cannot be inferred",
+			repoURL: "https://git.example.com/owner/repo",
+			opts:    Options{Token: "fake-token"},
+			assertions: func(_ Service, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "unable to infer Git provider")
+			},
+		},
+		{
+			name:    "unsupported provider",
+			repoURL: "https://git.example.com/owner/repo",
+			opts:    Options{Name: "bogus", Token: "fake-token"},
+			assertions: func(_ Service, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "unsupported Git provider")
+			},
+		},
+		{
+			name:    "GitHub inferred",
+			repoURL: "https://github.com/owner/repo.git",
+			opts:    Options{Token: "fake-token"},
+			assertions: func(svc Service, err error) {
+				require.NoError(t, err)
+				gh, ok := svc.(*gitHubService)
+				require.True(t, ok)
+				require.Equal(t, "https://api.github.com", gh.apiURL)
+				require.Equal(t, "owner/repo", gh.repoPath)
+				require.Equal(t, "Bearer fake-token", gh.authValue)
+			},
+		},
+		{
+			name:    "GitHub Enterprise",
+			repoURL: "git@github.example.com:owner/repo.git",
+			opts:    Options{Name: GitHub, Token: "fake-token"},
+			assertions: func(svc Service, err error) {
+				require.NoError(t, err)
+				gh, ok := svc.(*gitHubService)
+				require.True(t, ok)
+				require.Equal(t, "https://github.example.com/api/v3", gh.apiURL)
+				require.Equal(t, "owner/repo", gh.repoPath)
+			},
+		},
+		{
+			name:    "self-hosted GitLab with nested groups",
+			repoURL: "https://gitlab.example.com/group/subgroup/repo",
+			opts:    Options{Name: GitLab, Token: "fake-token"},
+			assertions: func(svc Service, err error) {
+				require.NoError(t, err)
+				gl, ok := svc.(*gitLabService)
+				require.True(t, ok)
+				require.Equal(t, "https://gitlab.example.com/api/v4", gl.apiURL)
+				require.Equal(t, "group/subgroup/repo", gl.repoPath)
+			},
+		},
+		{
+			name:    "Gitea with explicit API URL",
+			repoURL: "https://gitea.example.com/owner/repo",
+			opts: Options{
+				Name:   Gitea,
+				APIURL: "https://gitea-api.example.com/api/v1/",
+				Token:  "fake-token",
+			},
+			assertions: func(svc Service, err error) {
+				require.NoError(t, err)
+				gt, ok := svc.(*giteaService)
+				require.True(t, ok)
+				require.Equal(t, "https://gitea-api.example.com/api/v1", gt.apiURL)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(NewService(testCase.repoURL, testCase.opts))
+		})
+	}
+}
+
+func TestClientDo(t *testing.T) {
+	srv := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-Token") != "fake-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"message":"bad credentials"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"foo":"bar"}`))
+		}),
+	)
+	defer srv.Close()
+
+	c := &client{
+		apiURL:     srv.URL,
+		authHeader: "X-Token",
+		authValue:  "fake-token",
+		httpClient: srv.Client(),
+	}
+	res := map[string]string{}
+	require.NoError(t, c.do(context.Background(), http.MethodGet, "/", nil, &res))
+	require.Equal(t, "bar", res["foo"])
+
+	c.authValue = "wrong-token"
+	err := c.do(context.Background(), http.MethodGet, "/", nil, &res)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unexpected status code 401")
+	require.Contains(t, err.Error(), "bad credentials")
+}
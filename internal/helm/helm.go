@@ -1,6 +1,7 @@
 package helm
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -209,3 +210,70 @@ func UpdateChartDependencies(homePath, chartPath string) error {
 		chartPath,
 	)
 }
+
+// TemplateOptions represents options for rendering a Helm chart using
+// `helm template`.
+type TemplateOptions struct {
+	// ReleaseName is the release name to use when rendering the chart.
+	ReleaseName string
+	// Namespace is the namespace to use when rendering the chart. If empty, the
+	// namespace is left to Helm's discretion.
+	Namespace string
+	// ValuesFiles are paths to values files that should be used when rendering
+	// the chart. They are applied in order.
+	ValuesFiles []string
+	// SetValues maps keys to string values that should be set when rendering the
+	// chart. These take precedence over values from ValuesFiles.
+	SetValues map[string]string
+}
+
+// Template renders the chart at the specified path using `helm template` and
+// returns the rendered manifests. Any of the chart's dependencies are updated
+// prior to rendering.
+func Template(homePath, chartPath string, opts TemplateOptions) ([]byte, error) {
+	cmd := buildTemplateCmd(chartPath, opts)
+	cmd.Env = append(cmd.Env, fmt.Sprintf("HOME=%s", homePath))
+	// Only stdout contains manifests, so stderr is captured separately for use
+	// in error messages.
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	res, err := cmd.Output()
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error running `helm template` for chart at %q: %s",
+			chartPath,
+			stderr.String(),
+		)
+	}
+	return res, nil
+}
+
+func buildTemplateCmd(chartPath string, opts TemplateOptions) *exec.Cmd {
+	args := []string{
+		"template",
+		opts.ReleaseName,
+		chartPath,
+		"--dependency-update",
+	}
+	if opts.Namespace != "" {
+		args = append(args, "--namespace", opts.Namespace)
+	}
+	for _, valuesFile := range opts.ValuesFiles {
+		args = append(args, "--values", valuesFile)
+	}
+	// Sort keys so the command is deterministic
+	keys := make([]string, 0, len(opts.SetValues))
+	for key := range opts.SetValues {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		args = append(
+			args,
+			"--set-string",
+			fmt.Sprintf("%s=%s", key, opts.SetValues[key]),
+		)
+	}
+	return exec.Command("helm", args...) // nolint: gosec
+}
@@ -169,3 +169,40 @@ func TestGetLatestVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildTemplateCmd(t *testing.T) {
+	cmd := buildTemplateCmd(
+		"/fake-chart",
+		TemplateOptions{
+			ReleaseName: "fake-release",
+			Namespace:   "fake-namespace",
+			ValuesFiles: []string{"/fake-values.yaml", "/more-fake-values.yaml"},
+			SetValues: map[string]string{
+				"image.tag":        "fake-tag",
+				"image.repository": "fake-image",
+			},
+		},
+	)
+	require.NotNil(t, cmd)
+	require.Equal(
+		t,
+		[]string{
+			"helm",
+			"template",
+			"fake-release",
+			"/fake-chart",
+			"--dependency-update",
+			"--namespace",
+			"fake-namespace",
+			"--values",
+			"/fake-values.yaml",
+			"--values",
+			"/more-fake-values.yaml",
+			"--set-string",
+			"image.repository=fake-image",
+			"--set-string",
+			"image.tag=fake-tag",
+		},
+		cmd.Args,
+	)
+}
@@ -1,13 +1,18 @@
 package images
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/argoproj-labs/argocd-image-updater/pkg/image"
 	argoLog "github.com/argoproj-labs/argocd-image-updater/pkg/log"
 	"github.com/argoproj-labs/argocd-image-updater/pkg/options"
 	"github.com/argoproj-labs/argocd-image-updater/pkg/registry"
+	"github.com/argoproj-labs/argocd-image-updater/pkg/tag"
+	"github.com/distribution/distribution/v3"
+	"github.com/opencontainers/go-digest"
 	"github.com/pkg/errors"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -20,15 +25,26 @@ func init() {
 	}
 }
 
+// maxVerificationCandidates is the maximum number of tags whose signatures
+// GetLatestTag will attempt to verify before giving up.
+const maxVerificationCandidates = 10
+
+// GetLatestTag returns the newest tag of the specified image repository that
+// satisfies the provided constraints, along with the digest of the manifest
+// that tag currently references. If a verification policy is provided, tags
+// whose signatures cannot be verified are disregarded and described by the
+// returned failures.
 func GetLatestTag(
+	ctx context.Context,
 	repoURL string,
 	updateStrategy kargoapi.ImageUpdateStrategy,
 	semverConstraint string,
 	allowTags string,
 	ignoreTags []string,
 	platform string,
+	verification *kargoapi.ImageVerification,
 	creds *Credentials,
-) (string, error) {
+) (string, string, []kargoapi.ImageVerificationFailure, error) {
 	img := image.NewFromIdentifier(repoURL)
 	vc := &image.VersionConstraint{
 		Constraint: semverConstraint,
@@ -44,7 +60,7 @@ func GetLatestTag(
 	if platform != "" {
 		os, arch, variant, err := image.ParsePlatform(platform)
 		if err != nil {
-			return "", errors.Wrapf(
+			return "", "", nil, errors.Wrapf(
 				err,
 				"error parsing platform %q for image %q",
 				platform,
@@ -57,7 +73,7 @@ func GetLatestTag(
 
 	rep, err := registry.GetRegistryEndpoint(img.RegistryURL)
 	if err != nil {
-		return "", errors.Wrapf(
+		return "", "", nil, errors.Wrapf(
 			err,
 			"error getting container registry endpoint for image %q",
 			repoURL,
@@ -69,7 +85,7 @@ func GetLatestTag(
 	}
 	regClient, err := registry.NewClient(rep, creds.Username, creds.Password)
 	if err != nil {
-		return "", errors.Wrapf(
+		return "", "", nil, errors.Wrapf(
 			err,
 			"error creating registry client for image %q",
 			repoURL,
@@ -78,27 +94,140 @@ func GetLatestTag(
 
 	tags, err := rep.GetTags(img, regClient, vc)
 	if err != nil {
-		return "", errors.Wrapf(
+		return "", "", nil, errors.Wrapf(
 			err,
 			"error fetching tags for image %q",
 			repoURL,
 		)
 	}
 
-	upImg, err := img.GetNewestVersionFromTags(vc, tags)
-	if err != nil {
-		return "", errors.Wrapf(
-			err,
-			"error finding newest tag for %q",
-			repoURL,
-		)
+	var verifier *signatureVerifier
+	var repo distribution.Repository
+	if verification != nil {
+		if verifier, err = newSignatureVerifier(verification); err != nil {
+			return "", "", nil, errors.Wrapf(
+				err,
+				"error loading verification policy for image %q",
+				repoURL,
+			)
+		}
+		if repo, err = newRepository(
+			rep.RegistryAPI,
+			getNameInRegistry(img, rep),
+			rep.GetTransport(),
+			creds,
+		); err != nil {
+			return "", "", nil, errors.Wrapf(
+				err,
+				"error creating repository client for image %q",
+				repoURL,
+			)
+		}
 	}
-	if upImg == nil {
-		return "", errors.Errorf(
-			"found no suitable version of image %q",
-			repoURL,
+
+	var failures []kargoapi.ImageVerificationFailure
+	for {
+		upImg, err := img.GetNewestVersionFromTags(vc, tags)
+		if err != nil {
+			return "", "", failures, errors.Wrapf(
+				err,
+				"error finding newest tag for %q",
+				repoURL,
+			)
+		}
+		if upImg == nil {
+			if len(failures) > 0 {
+				return "", "", failures, errors.Errorf(
+					"found no suitable version of image %q with a verified signature",
+					repoURL,
+				)
+			}
+			return "", "", nil, errors.Errorf(
+				"found no suitable version of image %q",
+				repoURL,
+			)
+		}
+		dgst, err := getDigest(regClient, upImg)
+		if err != nil {
+			return "", "", failures, errors.Wrapf(
+				err,
+				"error getting digest of image %s:%s",
+				repoURL,
+				upImg.TagName,
+			)
+		}
+		if verifier == nil {
+			return upImg.TagName, dgst, nil, nil
+		}
+		err = verifier.verify(ctx, repo, dgst)
+		if err == nil {
+			return upImg.TagName, dgst, failures, nil
+		}
+		failures = append(
+			failures,
+			kargoapi.ImageVerificationFailure{
+				RepoURL: repoURL,
+				Tag:     upImg.TagName,
+				Digest:  dgst,
+				Message: err.Error(),
+			},
 		)
+		if len(failures) == maxVerificationCandidates {
+			return "", "", failures, errors.Errorf(
+				"found no version of image %q with a verified signature among "+
+					"the %d newest suitable versions",
+				repoURL,
+				maxVerificationCandidates,
+			)
+		}
+		tags = withoutTag(tags, upImg.TagName)
+	}
+}
+
+// getDigest returns the digest of the manifest referenced by the provided tag.
+func getDigest(
+	regClient registry.RegistryClient,
+	imgTag *tag.ImageTag,
+) (string, error) {
+	// The Digest update strategy already resolved the digest of each tag it
+	// considered. Otherwise, the tag's manifest must be retrieved. Note that
+	// GetTags() has already pointed the client at the correct repository.
+	if imgTag.TagDigest != "" {
+		return imgTag.TagDigest, nil
+	}
+	manifest, err := regClient.ManifestForTag(imgTag.TagName)
+	if err != nil {
+		return "", errors.Wrap(err, "error fetching manifest")
+	}
+	_, payload, err := manifest.Payload()
+	if err != nil {
+		return "", errors.Wrap(err, "error reading manifest")
 	}
+	return digest.FromBytes(payload).String(), nil
+}
+
+// getNameInRegistry returns the name of the provided image's repository within
+// the provided registry. Some registries have a default namespace that is used
+// when the image name doesn't specify one. For example, at Docker Hub, this is
+// "library".
+func getNameInRegistry(
+	img *image.ContainerImage,
+	rep *registry.RegistryEndpoint,
+) string {
+	if len(strings.Split(img.ImageName, "/")) == 1 && rep.DefaultNS != "" {
+		return rep.DefaultNS + "/" + img.ImageName
+	}
+	return img.ImageName
+}
 
-	return upImg.TagName, nil
+// withoutTag returns a copy of the provided list of tags that excludes the tag
+// with the provided name.
+func withoutTag(tags *tag.ImageTagList, name string) *tag.ImageTagList {
+	remaining := tag.NewImageTagList()
+	for _, t := range tags.SortAlphabetically() {
+		if t.TagName != name {
+			remaining.Add(t)
+		}
+	}
+	return remaining
 }
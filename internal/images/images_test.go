@@ -1,6 +1,8 @@
 package images
 
 import (
+	"context"
+	"strings"
 	"testing"
 
 	"github.com/Masterminds/semver"
@@ -15,13 +17,13 @@ func TestGetLatestTag(t *testing.T) {
 		repoURL          string
 		platform         string
 		semverConstraint string
-		assertions       func(string, error)
+		assertions       func(string, string, []kargoapi.ImageVerificationFailure, error)
 	}{
 		{
 			name:     "error parsing platform",
 			repoURL:  "nginx",
 			platform: "bogus",
-			assertions: func(s string, err error) {
+			assertions: func(_ string, _ string, _ []kargoapi.ImageVerificationFailure, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "error parsing platform")
 			},
@@ -31,7 +33,7 @@ func TestGetLatestTag(t *testing.T) {
 			name: "error getting tags",
 			// This will force a failure because this repo doesn't exist
 			repoURL: "bogus",
-			assertions: func(s string, err error) {
+			assertions: func(_ string, _ string, _ []kargoapi.ImageVerificationFailure, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "error fetching tags for image")
 			},
@@ -41,7 +43,7 @@ func TestGetLatestTag(t *testing.T) {
 			name:             "no suitable version found",
 			repoURL:          "nginx",
 			semverConstraint: "^15.0.0", // Doesn't exist
-			assertions: func(tag string, err error) {
+			assertions: func(_ string, _ string, _ []kargoapi.ImageVerificationFailure, err error) {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), "found no suitable version of image")
 			},
@@ -52,11 +54,13 @@ func TestGetLatestTag(t *testing.T) {
 			repoURL:          "nginx",
 			platform:         "linux/amd64",
 			semverConstraint: "^1.0.0",
-			assertions: func(tag string, err error) {
+			assertions: func(tag string, digest string, failures []kargoapi.ImageVerificationFailure, err error) {
 				require.NoError(t, err)
+				require.Empty(t, failures)
 				ver, err := semver.NewVersion(tag)
 				require.NoError(t, err)
 				require.Equal(t, int64(1), ver.Major())
+				require.True(t, strings.HasPrefix(digest, "sha256:"))
 			},
 		},
 	}
@@ -64,12 +68,14 @@ func TestGetLatestTag(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
 				GetLatestTag(
+					context.Background(),
 					testCase.repoURL,
 					kargoapi.ImageUpdateStrategySemVer,
 					testCase.semverConstraint,
 					"",
 					nil,
 					testCase.platform,
+					nil,
 					&Credentials{},
 				),
 			)
@@ -0,0 +1,581 @@
+package images
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/distribution/distribution/v3"
+	"github.com/distribution/distribution/v3/manifest/ocischema"
+	"github.com/distribution/distribution/v3/reference"
+	"github.com/distribution/distribution/v3/registry/api/errcode"
+	v2 "github.com/distribution/distribution/v3/registry/api/v2"
+	"github.com/distribution/distribution/v3/registry/client"
+	"github.com/distribution/distribution/v3/registry/client/auth"
+	"github.com/distribution/distribution/v3/registry/client/auth/challenge"
+	"github.com/distribution/distribution/v3/registry/client/transport"
+	"github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const (
+	// cosignSignatureMediaType is the media type of the layers of a cosign
+	// signature manifest. Each such layer's content is the payload that was
+	// signed.
+	cosignSignatureMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// cosignSignatureAnnotation is the annotation on a signature layer that
+	// holds the base64-encoded signature of the layer's payload.
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	// cosignCertificateAnnotation is the annotation on a signature layer that
+	// holds the PEM-encoded certificate used to create a keyless signature.
+	cosignCertificateAnnotation = "dev.sigstore.cosign/certificate"
+	// cosignChainAnnotation is the annotation on a signature layer that holds
+	// the PEM-encoded intermediate certificates that chain the certificate in
+	// the cosignCertificateAnnotation to a root.
+	cosignChainAnnotation = "dev.sigstore.cosign/chain"
+	// cosignBundleAnnotation is the annotation on a signature layer that holds
+	// the transparency log entry that records a keyless signature, along with
+	// the log's signed entry timestamp for that entry.
+	cosignBundleAnnotation = "dev.sigstore.cosign/bundle"
+)
+
+var (
+	// oidIssuerV1 is the OID of the certificate extension in which Fulcio
+	// records the OIDC issuer as a raw string.
+	oidIssuerV1 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	// oidIssuerV2 is the OID of the certificate extension in which Fulcio
+	// records the OIDC issuer as a DER-encoded UTF8String.
+	oidIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+// simpleSigningPayload is the subset of a cosign "simple signing" payload that
+// is relevant to verification.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// rekorBundle is the transparency log entry that cosign attaches to a keyless
+// signature using the cosignBundleAnnotation.
+type rekorBundle struct {
+	SignedEntryTimestamp []byte             `json:"SignedEntryTimestamp"`
+	Payload              rekorBundlePayload `json:"Payload"`
+}
+
+// rekorBundlePayload is the part of a rekorBundle that is signed by the
+// transparency log. The order of its fields matches that of the canonical JSON
+// encoding that is signed.
+type rekorBundlePayload struct {
+	Body           string `json:"body"`
+	IntegratedTime int64  `json:"integratedTime"`
+	LogID          string `json:"logID"`
+	LogIndex       int64  `json:"logIndex"`
+}
+
+// hashedRekordEntry is the subset of a "hashedrekord" transparency log entry
+// that is relevant to verification.
+type hashedRekordEntry struct {
+	Kind string `json:"kind"`
+	Spec struct {
+		Data struct {
+			Hash struct {
+				Algorithm string `json:"algorithm"`
+				Value     string `json:"value"`
+			} `json:"hash"`
+		} `json:"data"`
+		Signature struct {
+			Content   []byte `json:"content"`
+			PublicKey struct {
+				Content []byte `json:"content"`
+			} `json:"publicKey"`
+		} `json:"signature"`
+	} `json:"spec"`
+}
+
+// signatureVerifier verifies the cosign signatures of images according to a
+// kargoapi.ImageVerification.
+type signatureVerifier struct {
+	publicKey crypto.PublicKey
+	keyless   *kargoapi.KeylessVerification
+	roots     *x509.CertPool
+	// intermediates holds any intermediate certificates that were included in
+	// the CertificateAuthorities bundle.
+	intermediates *x509.CertPool
+	tlogKey       crypto.PublicKey
+	// tlogID is the ID of the transparency log, which is the hex-encoded
+	// SHA-256 digest of its DER-encoded public key.
+	tlogID string
+}
+
+// newSignatureVerifier returns a signatureVerifier for the provided
+// verification policy.
+func newSignatureVerifier(
+	verification *kargoapi.ImageVerification,
+) (*signatureVerifier, error) {
+	if verification.PublicKey != "" {
+		key, err := ParsePublicKey(verification.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		return &signatureVerifier{publicKey: key}, nil
+	}
+	if verification.Keyless == nil {
+		return nil, errors.New(
+			"verification policy specifies neither a public key nor keyless constraints",
+		)
+	}
+	certs, err := ParseCertificates(verification.Keyless.CertificateAuthorities)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing certificate authorities")
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("found no certificate authorities")
+	}
+	tlogKey, err := ParsePublicKey(verification.Keyless.TransparencyLogPublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing transparency log public key")
+	}
+	tlogKeyDER, err := x509.MarshalPKIXPublicKey(tlogKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "error encoding transparency log public key")
+	}
+	tlogID := sha256.Sum256(tlogKeyDER)
+	v := &signatureVerifier{
+		keyless:       verification.Keyless,
+		roots:         x509.NewCertPool(),
+		intermediates: x509.NewCertPool(),
+		tlogKey:       tlogKey,
+		tlogID:        hex.EncodeToString(tlogID[:]),
+	}
+	for _, cert := range certs {
+		if bytes.Equal(cert.RawIssuer, cert.RawSubject) {
+			v.roots.AddCert(cert)
+		} else {
+			v.intermediates.AddCert(cert)
+		}
+	}
+	return v, nil
+}
+
+// verify returns nil if the image with the provided manifest digest has at
+// least one cosign signature, stored in the provided repository, that
+// satisfies the verifier's policy. Otherwise, an error explaining why
+// verification failed is returned.
+func (v *signatureVerifier) verify(
+	ctx context.Context,
+	repo distribution.Repository,
+	imageDigest string,
+) error {
+	dgst, err := digest.Parse(imageDigest)
+	if err != nil {
+		return errors.Wrapf(err, "error parsing digest %q", imageDigest)
+	}
+	manifests, err := repo.Manifests(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error getting manifest service")
+	}
+	// By convention, cosign stores the signatures of an image in the same
+	// repository, using a tag derived from the image's digest.
+	sigTag := strings.Replace(dgst.String(), ":", "-", 1) + ".sig"
+	manifest, err := manifests.Get(
+		ctx,
+		"",
+		distribution.WithTag(sigTag),
+		distribution.WithManifestMediaTypes(
+			[]string{ocischema.SchemaVersion.MediaType},
+		),
+	)
+	if err != nil {
+		if isNotFound(err) {
+			return errors.New("image is not signed")
+		}
+		return errors.Wrapf(err, "error fetching signature manifest %q", sigTag)
+	}
+	var errs []string
+	for _, layer := range manifest.References() {
+		if layer.MediaType != cosignSignatureMediaType {
+			continue
+		}
+		if err = v.verifyLayer(ctx, repo, dgst, layer); err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		return nil
+	}
+	if len(errs) == 0 {
+		return errors.New("image is not signed")
+	}
+	return errors.Errorf(
+		"found no valid signature: %s",
+		strings.Join(errs, "; "),
+	)
+}
+
+// verifyLayer verifies a single signature layer of a cosign signature
+// manifest.
+func (v *signatureVerifier) verifyLayer(
+	ctx context.Context,
+	repo distribution.Repository,
+	imageDigest digest.Digest,
+	layer distribution.Descriptor,
+) error {
+	payload, err := repo.Blobs(ctx).Get(ctx, layer.Digest)
+	if err != nil {
+		return errors.Wrapf(err, "error fetching signature payload %q", layer.Digest)
+	}
+	if err = layer.Digest.Validate(); err != nil ||
+		layer.Digest.Algorithm().FromBytes(payload) != layer.Digest {
+		return errors.Errorf(
+			"signature payload does not match digest %q",
+			layer.Digest,
+		)
+	}
+	var p simpleSigningPayload
+	if err = json.Unmarshal(payload, &p); err != nil {
+		return errors.Wrap(err, "error parsing signature payload")
+	}
+	if p.Critical.Image.DockerManifestDigest != imageDigest.String() {
+		return errors.Errorf(
+			"signature is for digest %q",
+			p.Critical.Image.DockerManifestDigest,
+		)
+	}
+	sig, err := base64.StdEncoding.DecodeString(
+		layer.Annotations[cosignSignatureAnnotation],
+	)
+	if err != nil || len(sig) == 0 {
+		return errors.New("signature is missing or malformed")
+	}
+	key := v.publicKey
+	if v.keyless != nil {
+		if key, err = v.verifyCertificate(layer.Annotations, payload, sig); err != nil {
+			return err
+		}
+	}
+	return verifySignature(key, payload, sig)
+}
+
+// verifyCertificate verifies that the signing certificate found in the
+// provided annotations was issued by one of the verifier's certificate
+// authorities to the expected identity and issuer, and that the verifier's
+// transparency log recorded the provided signature of the provided payload
+// while the certificate was valid. If so, the certificate's public key is
+// returned.
+func (v *signatureVerifier) verifyCertificate(
+	annotations map[string]string,
+	payload []byte,
+	sig []byte,
+) (crypto.PublicKey, error) {
+	certs, err := ParseCertificates(annotations[cosignCertificateAnnotation])
+	if err != nil || len(certs) == 0 {
+		return nil, errors.New("signing certificate is missing or malformed")
+	}
+	cert := certs[0]
+	signedAt, err := v.verifyTransparencyLogEntry(
+		annotations[cosignBundleAnnotation],
+		cert,
+		payload,
+		sig,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if signedAt.Before(cert.NotBefore) || signedAt.After(cert.NotAfter) {
+		return nil, errors.Errorf(
+			"signature was recorded at %s, when the signing certificate was not valid",
+			signedAt.UTC().Format(time.RFC3339),
+		)
+	}
+	intermediates := v.intermediates.Clone()
+	chain, err := ParseCertificates(annotations[cosignChainAnnotation])
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing certificate chain")
+	}
+	for _, c := range chain {
+		intermediates.AddCert(c)
+	}
+	if _, err = cert.Verify(x509.VerifyOptions{
+		Roots:         v.roots,
+		Intermediates: intermediates,
+		// Signing certificates are short-lived, so the chain is verified as of
+		// the time the transparency log recorded the signature.
+		CurrentTime: signedAt,
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return nil, errors.Wrap(err, "error verifying signing certificate")
+	}
+	if !certificateHasIdentity(cert, v.keyless.Identity) {
+		return nil, errors.Errorf(
+			"signing certificate was not issued to %q",
+			v.keyless.Identity,
+		)
+	}
+	if issuer := certificateIssuer(cert); issuer != v.keyless.Issuer {
+		return nil, errors.Errorf(
+			"signing certificate identity was issued by %q, not %q",
+			issuer,
+			v.keyless.Issuer,
+		)
+	}
+	return cert.PublicKey, nil
+}
+
+// verifyTransparencyLogEntry verifies that the provided bundle is an entry,
+// signed by the verifier's transparency log, that records the provided
+// signature of the provided payload, made using the provided certificate. If
+// it is, the time at which the log recorded the entry is returned.
+func (v *signatureVerifier) verifyTransparencyLogEntry(
+	bundleJSON string,
+	cert *x509.Certificate,
+	payload []byte,
+	sig []byte,
+) (time.Time, error) {
+	if bundleJSON == "" {
+		return time.Time{},
+			errors.New("signature was not recorded in a transparency log")
+	}
+	var bundle rekorBundle
+	if err := json.Unmarshal([]byte(bundleJSON), &bundle); err != nil {
+		return time.Time{},
+			errors.Wrap(err, "error parsing transparency log entry")
+	}
+	if bundle.Payload.LogID != v.tlogID {
+		return time.Time{}, errors.Errorf(
+			"signature was recorded in transparency log %q, not %q",
+			bundle.Payload.LogID,
+			v.tlogID,
+		)
+	}
+	// The transparency log signed the canonical JSON encoding of the payload,
+	// which is exactly how the payload's fields marshal.
+	signed, err := json.Marshal(bundle.Payload)
+	if err != nil {
+		return time.Time{},
+			errors.Wrap(err, "error encoding transparency log entry")
+	}
+	if err = verifySignature(
+		v.tlogKey,
+		signed,
+		bundle.SignedEntryTimestamp,
+	); err != nil {
+		return time.Time{},
+			errors.New("transparency log entry's signed entry timestamp is invalid")
+	}
+	body, err := base64.StdEncoding.DecodeString(bundle.Payload.Body)
+	if err != nil {
+		return time.Time{},
+			errors.Wrap(err, "error decoding transparency log entry")
+	}
+	var entry hashedRekordEntry
+	if err = json.Unmarshal(body, &entry); err != nil {
+		return time.Time{},
+			errors.Wrap(err, "error parsing transparency log entry")
+	}
+	payloadHash := sha256.Sum256(payload)
+	if entry.Kind != "hashedrekord" ||
+		entry.Spec.Data.Hash.Algorithm != "sha256" ||
+		entry.Spec.Data.Hash.Value != hex.EncodeToString(payloadHash[:]) ||
+		!bytes.Equal(entry.Spec.Signature.Content, sig) {
+		return time.Time{},
+			errors.New("transparency log entry does not record this signature")
+	}
+	entryCerts, err := ParseCertificates(
+		string(entry.Spec.Signature.PublicKey.Content),
+	)
+	if err != nil || len(entryCerts) == 0 || !entryCerts[0].Equal(cert) {
+		return time.Time{}, errors.New(
+			"transparency log entry does not record the signing certificate",
+		)
+	}
+	return time.Unix(bundle.Payload.IntegratedTime, 0), nil
+}
+
+// newRepository returns a distribution.Repository for the repository with the
+// provided name in the registry whose API is served at the provided URL.
+func newRepository(
+	registryAPI string,
+	nameInRegistry string,
+	rt http.RoundTripper,
+	creds *Credentials,
+) (distribution.Repository, error) {
+	registryAPI = strings.TrimSuffix(registryAPI, "/")
+	manager := challenge.NewSimpleManager()
+	resp, err := (&http.Client{Transport: rt}).Get(registryAPI + "/v2/")
+	if err != nil {
+		return nil, errors.Wrapf(err, "error pinging registry %q", registryAPI)
+	}
+	defer resp.Body.Close()
+	if err = manager.AddResponse(resp); err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error processing authentication challenge from registry %q",
+			registryAPI,
+		)
+	}
+	if creds == nil {
+		creds = &Credentials{}
+	}
+	store := &credentialStore{creds: *creds}
+	named, err := reference.WithName(nameInRegistry)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing repository name %q", nameInRegistry)
+	}
+	return client.NewRepository(
+		named,
+		registryAPI,
+		transport.NewTransport(
+			rt,
+			auth.NewAuthorizer(
+				manager,
+				auth.NewTokenHandler(rt, store, nameInRegistry, "pull"),
+				auth.NewBasicHandler(store),
+			),
+		),
+	)
+}
+
+// credentialStore is an implementation of auth.CredentialStore that always
+// returns the same credentials.
+type credentialStore struct {
+	creds Credentials
+}
+
+func (c *credentialStore) Basic(*url.URL) (string, string) {
+	return c.creds.Username, c.creds.Password
+}
+
+func (c *credentialStore) RefreshToken(*url.URL, string) string {
+	return ""
+}
+
+func (c *credentialStore) SetRefreshToken(*url.URL, string, string) {}
+
+// ParsePublicKey parses a PEM-encoded public key.
+func ParsePublicKey(pemStr string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(pemStr))
+	if block == nil {
+		return nil, errors.New("public key is not PEM-encoded")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing public key")
+	}
+	return key, nil
+}
+
+// ParseCertificates parses all certificates in a PEM-encoded bundle.
+func ParseCertificates(pemStr string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(pemStr)
+	for {
+		var block *pem.Block
+		if block, rest = pem.Decode(rest); block == nil {
+			return certs, nil
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing certificate")
+		}
+		certs = append(certs, cert)
+	}
+}
+
+// verifySignature verifies the provided signature of the provided payload
+// using the provided public key, as cosign would have created it.
+func verifySignature(key crypto.PublicKey, payload, sig []byte) error {
+	hash := sha256.Sum256(payload)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(k, hash[:], sig) {
+			return errors.New("signature is invalid")
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(k, crypto.SHA256, hash[:], sig); err != nil {
+			return errors.New("signature is invalid")
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, payload, sig) {
+			return errors.New("signature is invalid")
+		}
+	default:
+		return errors.Errorf("unsupported public key type %T", key)
+	}
+	return nil
+}
+
+// certificateHasIdentity returns true if any of the provided certificate's
+// email address or URI subject alternative names equals the provided identity.
+func certificateHasIdentity(cert *x509.Certificate, identity string) bool {
+	for _, email := range cert.EmailAddresses {
+		if email == identity {
+			return true
+		}
+	}
+	for _, uri := range cert.URIs {
+		if uri.String() == identity {
+			return true
+		}
+	}
+	return false
+}
+
+// certificateIssuer returns the OIDC issuer that Fulcio recorded in the
+// provided certificate, or an empty string if none was recorded.
+func certificateIssuer(cert *x509.Certificate) string {
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(oidIssuerV2) {
+			var issuer string
+			if _, err := asn1.UnmarshalWithParams(ext.Value, &issuer, "utf8"); err == nil {
+				return issuer
+			}
+		}
+	}
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(oidIssuerV1) {
+			return string(ext.Value)
+		}
+	}
+	return ""
+}
+
+// isNotFound returns true if the provided error indicates that a manifest
+// does not exist.
+func isNotFound(err error) bool {
+	var unexpected *client.UnexpectedHTTPResponseError
+	if errors.As(err, &unexpected) &&
+		unexpected.StatusCode == http.StatusNotFound {
+		return true
+	}
+	var errs errcode.Errors
+	if errors.As(err, &errs) {
+		for _, e := range errs {
+			if ec, ok := e.(errcode.Error); ok &&
+				ec.Code == v2.ErrorCodeManifestUnknown {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,743 @@
+package images
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/argoproj-labs/argocd-image-updater/pkg/registry"
+	"github.com/distribution/distribution/v3"
+	"github.com/distribution/distribution/v3/manifest"
+	"github.com/distribution/distribution/v3/manifest/ocischema"
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const (
+	testRepoName = "example/app"
+	testIdentity = "builder@example.com"
+	testIssuer   = "https://issuer.example.com"
+)
+
+// fakeRegistry is a minimal, in-memory implementation of the parts of the OCI
+// distribution API that are used for signature verification.
+type fakeRegistry struct {
+	tags      []string
+	manifests map[string][]byte
+	blobs     map[digest.Digest][]byte
+}
+
+func newFakeRegistry() *fakeRegistry {
+	return &fakeRegistry{
+		manifests: map[string][]byte{},
+		blobs:     map[digest.Digest][]byte{},
+	}
+}
+
+func (f *fakeRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	prefix := "/v2/" + testRepoName
+	switch {
+	case r.URL.Path == "/v2/":
+		w.WriteHeader(http.StatusOK)
+	case r.URL.Path == prefix+"/tags/list":
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"name": testRepoName,
+			"tags": f.tags,
+		})
+	case strings.HasPrefix(r.URL.Path, prefix+"/manifests/"):
+		ref := strings.TrimPrefix(r.URL.Path, prefix+"/manifests/")
+		m, ok := f.manifests[ref]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", ocischema.SchemaVersion.MediaType)
+		w.Header().Set("Docker-Content-Digest", digest.FromBytes(m).String())
+		_, _ = w.Write(m)
+	case strings.HasPrefix(r.URL.Path, prefix+"/blobs/"):
+		b, ok := f.blobs[digest.Digest(strings.TrimPrefix(r.URL.Path, prefix+"/blobs/"))]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(b)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// addImage adds an arbitrary image manifest to the registry, tagged with the
+// provided name, and returns its digest.
+func (f *fakeRegistry) addImage(t *testing.T, name string) digest.Digest {
+	m, err := json.Marshal(ocischema.Manifest{
+		Versioned: ocischema.SchemaVersion,
+		Config: distribution.Descriptor{
+			MediaType: "application/vnd.oci.image.config.v1+json",
+			Digest:    digest.FromString(name),
+			Size:      int64(len(name)),
+		},
+	})
+	require.NoError(t, err)
+	dgst := digest.FromBytes(m)
+	f.manifests[dgst.String()] = m
+	f.manifests[name] = m
+	f.tags = append(f.tags, name)
+	return dgst
+}
+
+// addSignature adds a cosign signature manifest for the image with the
+// provided digest. The signature layer signs a payload that references
+// payloadDigest and carries the provided annotations, in addition to the
+// signature itself. If a transparency log is provided, the signature is also
+// recorded in it.
+func (f *fakeRegistry) addSignature(
+	t *testing.T,
+	imageDigest digest.Digest,
+	payloadDigest digest.Digest,
+	key *ecdsa.PrivateKey,
+	tlog *testTransparencyLog,
+	annotations map[string]string,
+) {
+	payload := []byte(fmt.Sprintf(
+		`{"critical":{"identity":{"docker-reference":"%s"},`+
+			`"image":{"docker-manifest-digest":"%s"},`+
+			`"type":"cosign container image signature"},"optional":null}`,
+		testRepoName,
+		payloadDigest,
+	))
+	hash := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	require.NoError(t, err)
+	layerAnnotations := map[string]string{
+		cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(sig),
+	}
+	for k, v := range annotations {
+		layerAnnotations[k] = v
+	}
+	if tlog != nil {
+		layerAnnotations[cosignBundleAnnotation] = tlog.record(
+			t,
+			payload,
+			sig,
+			annotations[cosignCertificateAnnotation],
+		)
+	}
+	blobDigest := digest.FromBytes(payload)
+	f.blobs[blobDigest] = payload
+	m, err := json.Marshal(ocischema.Manifest{
+		Versioned: manifest.Versioned{
+			SchemaVersion: 2,
+			MediaType:     ocischema.SchemaVersion.MediaType,
+		},
+		Config: distribution.Descriptor{
+			MediaType: "application/vnd.oci.image.config.v1+json",
+			Digest:    digest.FromString("{}"),
+			Size:      2,
+		},
+		Layers: []distribution.Descriptor{{
+			MediaType:   cosignSignatureMediaType,
+			Digest:      blobDigest,
+			Size:        int64(len(payload)),
+			Annotations: layerAnnotations,
+		}},
+	})
+	require.NoError(t, err)
+	f.manifests[strings.Replace(imageDigest.String(), ":", "-", 1)+".sig"] = m
+}
+
+// testTransparencyLog records signatures as a Rekor transparency log would.
+type testTransparencyLog struct {
+	key *ecdsa.PrivateKey
+	// integratedTime is the time at which the log records every signature.
+	integratedTime time.Time
+	// forgerKey, if set, is used in place of key to sign the log's entries, as
+	// someone impersonating the log would.
+	forgerKey *ecdsa.PrivateKey
+}
+
+func newTestTransparencyLog(
+	t *testing.T,
+	integratedTime time.Time,
+) *testTransparencyLog {
+	return &testTransparencyLog{
+		key:            newTestKey(t),
+		integratedTime: integratedTime,
+	}
+}
+
+// record returns the value of the cosignBundleAnnotation for an entry that
+// records the provided signature of the provided payload, made using the
+// provided PEM-encoded certificate.
+func (l *testTransparencyLog) record(
+	t *testing.T,
+	payload []byte,
+	sig []byte,
+	cert string,
+) string {
+	payloadHash := sha256.Sum256(payload)
+	body, err := json.Marshal(map[string]any{
+		"apiVersion": "0.0.1",
+		"kind":       "hashedrekord",
+		"spec": map[string]any{
+			"data": map[string]any{
+				"hash": map[string]any{
+					"algorithm": "sha256",
+					"value":     hex.EncodeToString(payloadHash[:]),
+				},
+			},
+			"signature": map[string]any{
+				"content":   sig,
+				"publicKey": map[string]any{"content": []byte(cert)},
+			},
+		},
+	})
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKIXPublicKey(&l.key.PublicKey)
+	require.NoError(t, err)
+	logID := sha256.Sum256(keyDER)
+	encodedBody := base64.StdEncoding.EncodeToString(body)
+	// This is the canonical JSON encoding of the bundle's payload, which is
+	// what the log signs.
+	signed := fmt.Sprintf(
+		`{"body":%q,"integratedTime":%d,"logID":%q,"logIndex":1}`,
+		encodedBody,
+		l.integratedTime.Unix(),
+		hex.EncodeToString(logID[:]),
+	)
+	signingKey := l.key
+	if l.forgerKey != nil {
+		signingKey = l.forgerKey
+	}
+	signedHash := sha256.Sum256([]byte(signed))
+	set, err := ecdsa.SignASN1(rand.Reader, signingKey, signedHash[:])
+	require.NoError(t, err)
+	bundle, err := json.Marshal(map[string]any{
+		"SignedEntryTimestamp": set,
+		"Payload":              json.RawMessage(signed),
+	})
+	require.NoError(t, err)
+	return string(bundle)
+}
+
+func newTestKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func encodePublicKey(t *testing.T, key crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func encodeCertificate(der []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+// newTestCA returns a self-signed CA certificate and its key.
+func newTestCA(t *testing.T) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key := newTestKey(t)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+// newTestSigningCertificate returns a PEM-encoded, short-lived code signing
+// certificate, issued by the provided CA to the provided identity, as Fulcio
+// would issue it.
+func newTestSigningCertificate(
+	t *testing.T,
+	ca *x509.Certificate,
+	caKey *ecdsa.PrivateKey,
+	key *ecdsa.PrivateKey,
+	identity string,
+	issuer string,
+) string {
+	issuerValue, err := asn1.MarshalWithParams(issuer, "utf8")
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:   big.NewInt(2),
+		NotBefore:      time.Now().Add(-20 * time.Minute),
+		NotAfter:       time.Now().Add(-10 * time.Minute),
+		EmailAddresses: []string{identity},
+		KeyUsage:       x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		ExtraExtensions: []pkix.Extension{{
+			Id:    oidIssuerV2,
+			Value: issuerValue,
+		}},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	require.NoError(t, err)
+	return encodeCertificate(der)
+}
+
+func TestNewSignatureVerifier(t *testing.T) {
+	ca, _ := newTestCA(t)
+	tlogPublicKey := encodePublicKey(t, &newTestKey(t).PublicKey)
+	testCases := []struct {
+		name         string
+		verification *kargoapi.ImageVerification
+		assertions   func(*signatureVerifier, error)
+	}{
+		{
+			name:         "neither public key nor keyless",
+			verification: &kargoapi.ImageVerification{},
+			assertions: func(_ *signatureVerifier, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "neither a public key nor keyless")
+			},
+		},
+		{
+			name: "invalid public key",
+			verification: &kargoapi.ImageVerification{
+				PublicKey: "bogus",
+			},
+			assertions: func(_ *signatureVerifier, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "not PEM-encoded")
+			},
+		},
+		{
+			name: "no certificate authorities",
+			verification: &kargoapi.ImageVerification{
+				Keyless: &kargoapi.KeylessVerification{
+					Identity:               testIdentity,
+					Issuer:                 testIssuer,
+					CertificateAuthorities: "bogus",
+				},
+			},
+			assertions: func(_ *signatureVerifier, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "found no certificate authorities")
+			},
+		},
+		{
+			name: "invalid transparency log public key",
+			verification: &kargoapi.ImageVerification{
+				Keyless: &kargoapi.KeylessVerification{
+					Identity:                 testIdentity,
+					Issuer:                   testIssuer,
+					CertificateAuthorities:   encodeCertificate(ca.Raw),
+					TransparencyLogPublicKey: "bogus",
+				},
+			},
+			assertions: func(_ *signatureVerifier, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error parsing transparency log public key")
+			},
+		},
+		{
+			name: "public key",
+			verification: &kargoapi.ImageVerification{
+				PublicKey: encodePublicKey(t, &newTestKey(t).PublicKey),
+			},
+			assertions: func(v *signatureVerifier, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, v.publicKey)
+				require.Nil(t, v.keyless)
+			},
+		},
+		{
+			name: "keyless",
+			verification: &kargoapi.ImageVerification{
+				Keyless: &kargoapi.KeylessVerification{
+					Identity:                 testIdentity,
+					Issuer:                   testIssuer,
+					CertificateAuthorities:   encodeCertificate(ca.Raw),
+					TransparencyLogPublicKey: tlogPublicKey,
+				},
+			},
+			assertions: func(v *signatureVerifier, err error) {
+				require.NoError(t, err)
+				require.Nil(t, v.publicKey)
+				require.NotNil(t, v.keyless)
+				require.NotNil(t, v.roots)
+				require.NotNil(t, v.tlogKey)
+				require.NotEmpty(t, v.tlogID)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(newSignatureVerifier(testCase.verification))
+		})
+	}
+}
+
+func TestSignatureVerifierVerify(t *testing.T) {
+	signingKey := newTestKey(t)
+	ca, caKey := newTestCA(t)
+	otherCA, otherCAKey := newTestCA(t)
+	// Signing certificates are valid from 20 until 10 minutes ago.
+	tlog := newTestTransparencyLog(t, time.Now().Add(-15*time.Minute))
+
+	keyVerification := &kargoapi.ImageVerification{
+		PublicKey: encodePublicKey(t, &signingKey.PublicKey),
+	}
+	keylessVerification := &kargoapi.ImageVerification{
+		Keyless: &kargoapi.KeylessVerification{
+			Identity:                 testIdentity,
+			Issuer:                   testIssuer,
+			CertificateAuthorities:   encodeCertificate(ca.Raw),
+			TransparencyLogPublicKey: encodePublicKey(t, &tlog.key.PublicKey),
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		verification *kargoapi.ImageVerification
+		setup        func(*fakeRegistry) digest.Digest
+		assertions   func(error)
+	}{
+		{
+			name:         "image is not signed",
+			verification: keyVerification,
+			setup: func(reg *fakeRegistry) digest.Digest {
+				return reg.addImage(t, "unsigned")
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "image is not signed", err.Error())
+			},
+		},
+		{
+			name:         "signature is for another image",
+			verification: keyVerification,
+			setup: func(reg *fakeRegistry) digest.Digest {
+				dgst := reg.addImage(t, "mismatch")
+				reg.addSignature(t, dgst, digest.FromString("other"), signingKey, nil, nil)
+				return dgst
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "signature is for digest")
+			},
+		},
+		{
+			name:         "signed with another key",
+			verification: keyVerification,
+			setup: func(reg *fakeRegistry) digest.Digest {
+				dgst := reg.addImage(t, "other-key")
+				reg.addSignature(t, dgst, dgst, newTestKey(t), nil, nil)
+				return dgst
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "signature is invalid")
+			},
+		},
+		{
+			name:         "signed with expected key",
+			verification: keyVerification,
+			setup: func(reg *fakeRegistry) digest.Digest {
+				dgst := reg.addImage(t, "signed")
+				reg.addSignature(t, dgst, dgst, signingKey, nil, nil)
+				return dgst
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:         "keyless signature without certificate",
+			verification: keylessVerification,
+			setup: func(reg *fakeRegistry) digest.Digest {
+				dgst := reg.addImage(t, "no-cert")
+				reg.addSignature(t, dgst, dgst, signingKey, nil, nil)
+				return dgst
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "signing certificate is missing")
+			},
+		},
+		{
+			name:         "keyless signature not recorded in a transparency log",
+			verification: keylessVerification,
+			setup: func(reg *fakeRegistry) digest.Digest {
+				dgst := reg.addImage(t, "no-tlog")
+				reg.addSignature(t, dgst, dgst, signingKey, nil, map[string]string{
+					cosignCertificateAnnotation: newTestSigningCertificate(
+						t, ca, caKey, signingKey, testIdentity, testIssuer,
+					),
+				})
+				return dgst
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "was not recorded in a transparency log")
+			},
+		},
+		{
+			name:         "keyless signature recorded in another transparency log",
+			verification: keylessVerification,
+			setup: func(reg *fakeRegistry) digest.Digest {
+				dgst := reg.addImage(t, "other-tlog")
+				otherTlog := newTestTransparencyLog(t, tlog.integratedTime)
+				reg.addSignature(t, dgst, dgst, signingKey, otherTlog, map[string]string{
+					cosignCertificateAnnotation: newTestSigningCertificate(
+						t, ca, caKey, signingKey, testIdentity, testIssuer,
+					),
+				})
+				return dgst
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "was recorded in transparency log")
+			},
+		},
+		{
+			name:         "keyless signature with forged transparency log entry",
+			verification: keylessVerification,
+			setup: func(reg *fakeRegistry) digest.Digest {
+				dgst := reg.addImage(t, "forged-tlog")
+				forgedTlog := &testTransparencyLog{
+					key:            tlog.key,
+					integratedTime: tlog.integratedTime,
+					forgerKey:      newTestKey(t),
+				}
+				reg.addSignature(t, dgst, dgst, signingKey, forgedTlog, map[string]string{
+					cosignCertificateAnnotation: newTestSigningCertificate(
+						t, ca, caKey, signingKey, testIdentity, testIssuer,
+					),
+				})
+				return dgst
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "signed entry timestamp is invalid")
+			},
+		},
+		{
+			name:         "keyless signature recorded after certificate expired",
+			verification: keylessVerification,
+			setup: func(reg *fakeRegistry) digest.Digest {
+				dgst := reg.addImage(t, "expired")
+				lateTlog := &testTransparencyLog{
+					key:            tlog.key,
+					integratedTime: time.Now(),
+				}
+				reg.addSignature(t, dgst, dgst, signingKey, lateTlog, map[string]string{
+					cosignCertificateAnnotation: newTestSigningCertificate(
+						t, ca, caKey, signingKey, testIdentity, testIssuer,
+					),
+				})
+				return dgst
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "when the signing certificate was not valid")
+			},
+		},
+		{
+			name:         "keyless signature with untrusted certificate",
+			verification: keylessVerification,
+			setup: func(reg *fakeRegistry) digest.Digest {
+				dgst := reg.addImage(t, "untrusted")
+				reg.addSignature(t, dgst, dgst, signingKey, tlog, map[string]string{
+					cosignCertificateAnnotation: newTestSigningCertificate(
+						t, otherCA, otherCAKey, signingKey, testIdentity, testIssuer,
+					),
+				})
+				return dgst
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error verifying signing certificate")
+			},
+		},
+		{
+			name:         "keyless signature by another identity",
+			verification: keylessVerification,
+			setup: func(reg *fakeRegistry) digest.Digest {
+				dgst := reg.addImage(t, "other-identity")
+				reg.addSignature(t, dgst, dgst, signingKey, tlog, map[string]string{
+					cosignCertificateAnnotation: newTestSigningCertificate(
+						t, ca, caKey, signingKey, "someone@example.com", testIssuer,
+					),
+				})
+				return dgst
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "was not issued to")
+			},
+		},
+		{
+			name:         "keyless signature from another issuer",
+			verification: keylessVerification,
+			setup: func(reg *fakeRegistry) digest.Digest {
+				dgst := reg.addImage(t, "other-issuer")
+				reg.addSignature(t, dgst, dgst, signingKey, tlog, map[string]string{
+					cosignCertificateAnnotation: newTestSigningCertificate(
+						t, ca, caKey, signingKey, testIdentity, "https://bogus.example.com",
+					),
+				})
+				return dgst
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "identity was issued by")
+			},
+		},
+		{
+			name:         "valid keyless signature",
+			verification: keylessVerification,
+			setup: func(reg *fakeRegistry) digest.Digest {
+				dgst := reg.addImage(t, "keyless")
+				reg.addSignature(t, dgst, dgst, signingKey, tlog, map[string]string{
+					cosignCertificateAnnotation: newTestSigningCertificate(
+						t, ca, caKey, signingKey, testIdentity, testIssuer,
+					),
+				})
+				return dgst
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			reg := newFakeRegistry()
+			dgst := testCase.setup(reg)
+			server := httptest.NewServer(reg)
+			defer server.Close()
+			repo, err := newRepository(
+				server.URL,
+				testRepoName,
+				http.DefaultTransport,
+				nil,
+			)
+			require.NoError(t, err)
+			verifier, err := newSignatureVerifier(testCase.verification)
+			require.NoError(t, err)
+			testCase.assertions(
+				verifier.verify(context.Background(), repo, dgst.String()),
+			)
+		})
+	}
+}
+
+func TestGetLatestTagWithVerification(t *testing.T) {
+	signingKey := newTestKey(t)
+	reg := newFakeRegistry()
+	signedDigest := reg.addImage(t, "1.0.0")
+	reg.addSignature(t, signedDigest, signedDigest, signingKey, nil, nil)
+	badlySignedDigest := reg.addImage(t, "1.1.0")
+	reg.addSignature(t, badlySignedDigest, badlySignedDigest, newTestKey(t), nil, nil)
+	unsignedDigest := reg.addImage(t, "1.2.0")
+
+	server := httptest.NewServer(reg)
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+	require.NoError(
+		t,
+		registry.AddRegistryEndpoint(
+			registry.NewRegistryEndpoint(
+				host,
+				"fake-registry",
+				server.URL,
+				"",
+				"",
+				true,
+				registry.TagListSortUnsorted,
+				0,
+				0,
+			),
+		),
+	)
+	repoURL := host + "/" + testRepoName
+
+	tag, dgst, failures, err := GetLatestTag(
+		context.Background(),
+		repoURL,
+		kargoapi.ImageUpdateStrategySemVer,
+		"",
+		"",
+		nil,
+		"",
+		&kargoapi.ImageVerification{
+			PublicKey: encodePublicKey(t, &signingKey.PublicKey),
+		},
+		nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, "1.0.0", tag)
+	require.Equal(t, signedDigest.String(), dgst)
+	require.Len(t, failures, 2)
+	require.Equal(t, "1.2.0", failures[0].Tag)
+	require.Equal(t, unsignedDigest.String(), failures[0].Digest)
+	require.Equal(t, "image is not signed", failures[0].Message)
+	require.Equal(t, "1.1.0", failures[1].Tag)
+	require.Equal(t, badlySignedDigest.String(), failures[1].Digest)
+	require.Contains(t, failures[1].Message, "signature is invalid")
+
+	// Without a verification policy, the newest version is selected.
+	tag, dgst, failures, err = GetLatestTag(
+		context.Background(),
+		repoURL,
+		kargoapi.ImageUpdateStrategySemVer,
+		"",
+		"",
+		nil,
+		"",
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, "1.2.0", tag)
+	require.Equal(t, unsignedDigest.String(), dgst)
+	require.Empty(t, failures)
+
+	// When no version can be verified, the failures are still returned.
+	_, _, failures, err = GetLatestTag(
+		context.Background(),
+		repoURL,
+		kargoapi.ImageUpdateStrategySemVer,
+		"^1.1.0",
+		"",
+		nil,
+		"",
+		&kargoapi.ImageVerification{
+			PublicKey: encodePublicKey(t, &signingKey.PublicKey),
+		},
+		nil,
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "with a verified signature")
+	require.Len(t, failures, 2)
+}
@@ -1,9 +1,12 @@
 package kustomize
 
 import (
+	"bytes"
 	"fmt"
 	"os/exec"
 
+	"github.com/pkg/errors"
+
 	libExec "github.com/akuity/kargo/internal/exec"
 )
 
@@ -26,3 +29,50 @@ func buildSetImageCmd(dir, repo, tag string) *exec.Cmd {
 	cmd.Dir = dir
 	return cmd
 }
+
+// SetImageDigest runs `kustomize edit set image ...` in the specified
+// directory, pinning the image to the specified digest instead of a tag. The
+// specified directory must already exist and contain a kustomization.yaml file.
+func SetImageDigest(dir, repo, digest string) error {
+	_, err := libExec.Exec(buildSetImageDigestCmd(dir, repo, digest))
+	return err
+}
+
+func buildSetImageDigestCmd(dir, repo, digest string) *exec.Cmd {
+	cmd := exec.Command( // nolint: gosec
+		"kustomize",
+		"edit",
+		"set",
+		"image",
+		fmt.Sprintf("%s=%s@%s", repo, repo, digest),
+	)
+	cmd.Dir = dir
+	return cmd
+}
+
+// Build runs `kustomize build` in the specified directory and returns the
+// resulting manifests. The specified directory must already exist and contain
+// a kustomization.yaml file.
+func Build(dir string) ([]byte, error) {
+	cmd := buildBuildCmd(dir)
+	// Only stdout contains manifests, so stderr is captured separately for use
+	// in error messages.
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	res, err := cmd.Output()
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"error running `kustomize build` in %q: %s",
+			dir,
+			stderr.String(),
+		)
+	}
+	return res, nil
+}
+
+func buildBuildCmd(dir string) *exec.Cmd {
+	cmd := exec.Command("kustomize", "build", ".")
+	cmd.Dir = dir
+	return cmd
+}
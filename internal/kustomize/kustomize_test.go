@@ -28,3 +28,33 @@ func TestBuildSetImageCmd(t *testing.T) {
 	)
 	require.Equal(t, testDir, cmd.Dir)
 }
+
+func TestBuildSetImageDigestCmd(t *testing.T) {
+	const testDir = "/some-dir"
+	const testImage = "some-image"
+	const testDigest = "sha256:some-digest"
+	cmd := buildSetImageDigestCmd(testDir, testImage, testDigest)
+	require.NotNil(t, cmd)
+	require.True(t, strings.HasSuffix(cmd.Path, "kustomize"))
+	require.Equal(
+		t,
+		[]string{
+			"kustomize",
+			"edit",
+			"set",
+			"image",
+			fmt.Sprintf("%s=%s@%s", testImage, testImage, testDigest),
+		},
+		cmd.Args,
+	)
+	require.Equal(t, testDir, cmd.Dir)
+}
+
+func TestBuildBuildCmd(t *testing.T) {
+	const testDir = "/some-dir"
+	cmd := buildBuildCmd(testDir)
+	require.NotNil(t, cmd)
+	require.True(t, strings.HasSuffix(cmd.Path, "kustomize"))
+	require.Equal(t, []string{"kustomize", "build", "."}, cmd.Args)
+	require.Equal(t, testDir, cmd.Dir)
+}
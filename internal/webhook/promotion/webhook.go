@@ -2,8 +2,11 @@ package promotion
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/pkg/errors"
+	admissionv1 "k8s.io/api/admission/v1"
 	authzv1 "k8s.io/api/authorization/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -16,6 +19,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kubeclient"
 	"github.com/akuity/kargo/internal/logging"
 	libWebhook "github.com/akuity/kargo/internal/webhook"
 )
@@ -55,6 +59,14 @@ type webhook struct {
 		action string,
 	) error
 
+	validateFreezeWindowsFn func(context.Context, *kargoapi.Promotion) error
+
+	listPromotionPoliciesFn func(
+		context.Context,
+		client.ObjectList,
+		...client.ListOption,
+	) error
+
 	admissionRequestFromContextFn func(context.Context) (admission.Request, error)
 
 	createSubjectAccessReviewFn func(
@@ -80,6 +92,8 @@ func newWebhook(kubeClient client.Client) *webhook {
 	w.getStageFn = kargoapi.GetStage
 	w.validateProjectFn = libWebhook.ValidateProject
 	w.authorizeFn = w.authorize
+	w.validateFreezeWindowsFn = w.validateFreezeWindows
+	w.listPromotionPoliciesFn = kubeClient.List
 	w.admissionRequestFromContextFn = admission.RequestFromContext
 	w.createSubjectAccessReviewFn = w.client.Create
 	return w
@@ -113,6 +127,38 @@ func (w *webhook) Default(ctx context.Context, obj runtime.Object) error {
 	ownerRef :=
 		metav1.NewControllerRef(stage, kargoapi.GroupVersion.WithKind("Stage"))
 	promo.ObjectMeta.OwnerReferences = []metav1.OwnerReference{*ownerRef}
+
+	// Record who, if anyone, chose to override freeze windows. This is only
+	// done upon creation, since that is the only time freeze windows are
+	// enforced. The record must be trustworthy, so any value supplied by the
+	// client is disregarded: it is overwritten upon creation and restored to
+	// its original value upon update.
+	req, err := w.admissionRequestFromContextFn(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error retrieving admission request from context")
+	}
+	switch req.Operation {
+	case admissionv1.Create:
+		delete(promo.Annotations, kargoapi.AnnotationKeyBreakGlassBy)
+		if _, ok := promo.Annotations[kargoapi.AnnotationKeyBreakGlass]; ok {
+			promo.Annotations[kargoapi.AnnotationKeyBreakGlassBy] =
+				req.UserInfo.Username
+		}
+	case admissionv1.Update:
+		oldPromo := &kargoapi.Promotion{}
+		if err = json.Unmarshal(req.OldObject.Raw, oldPromo); err != nil {
+			return errors.Wrap(err, "error unmarshaling existing Promotion")
+		}
+		by, ok := oldPromo.Annotations[kargoapi.AnnotationKeyBreakGlassBy]
+		if !ok {
+			delete(promo.Annotations, kargoapi.AnnotationKeyBreakGlassBy)
+			break
+		}
+		if promo.Annotations == nil {
+			promo.Annotations = map[string]string{}
+		}
+		promo.Annotations[kargoapi.AnnotationKeyBreakGlassBy] = by
+	}
 	return nil
 }
 
@@ -125,7 +171,10 @@ func (w *webhook) ValidateCreate(
 		w.validateProjectFn(ctx, w.client, promotionGroupKind, promo); err != nil {
 		return err
 	}
-	return w.authorizeFn(ctx, promo, "create")
+	if err := w.authorizeFn(ctx, promo, "create"); err != nil {
+		return err
+	}
+	return w.validateFreezeWindowsFn(ctx, promo)
 }
 
 func (w *webhook) ValidateUpdate(
@@ -184,6 +233,14 @@ func (w *webhook) authorize(
 		)
 	}
 
+	// Creating a rollback Promotion requires a distinct permission because
+	// rollbacks bypass the requirement that Freight be qualified for an upstream
+	// Stage.
+	verb := "promote"
+	if action == "create" && promo.Spec.Rollback {
+		verb = "rollback"
+	}
+
 	accessReview := &authzv1.SubjectAccessReview{
 		Spec: authzv1.SubjectAccessReviewSpec{
 			User:   req.UserInfo.Username,
@@ -192,7 +249,7 @@ func (w *webhook) authorize(
 				Group:     kargoapi.GroupVersion.Group,
 				Resource:  "stages",
 				Name:      promo.Spec.Stage,
-				Verb:      "promote",
+				Verb:      verb,
 				Namespace: promo.Namespace,
 			},
 		},
@@ -214,13 +271,75 @@ func (w *webhook) authorize(
 			promotionGroupResource,
 			promo.Name,
 			errors.Errorf(
-				"subject %q is not permitted to %s Promotions for Stage %q",
+				"subject %q is not permitted to %s Promotions for Stage %q "+
+					"(requires the %q verb on the Stage)",
 				req.UserInfo.Username,
 				action,
 				promo.Spec.Stage,
+				verb,
 			),
 		)
 	}
 
 	return nil
 }
+
+// validateFreezeWindows refuses the creation of a Promotion into a Stage for
+// which a freeze window is currently in effect, unless the Promotion bears the
+// break-glass annotation.
+func (w *webhook) validateFreezeWindows(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) error {
+	logger := logging.LoggerFromContext(ctx)
+
+	var policies kargoapi.PromotionPolicyList
+	if err := w.listPromotionPoliciesFn(
+		ctx,
+		&policies,
+		client.InNamespace(promo.Namespace),
+		client.MatchingFields{
+			kubeclient.PromotionPoliciesByStageIndexField: promo.Spec.Stage,
+		},
+	); err != nil {
+		return apierrors.NewInternalError(
+			errors.Wrap(err, "error listing promotion policies"),
+		)
+	}
+	now := time.Now()
+	for _, policy := range policies.Items {
+		frozen, err := policy.IsFrozen(now)
+		if err != nil {
+			return apierrors.NewInternalError(
+				errors.Wrapf(
+					err,
+					"error evaluating freeze windows of PromotionPolicy %q",
+					policy.Name,
+				),
+			)
+		}
+		if !frozen {
+			continue
+		}
+		if reason, ok := promo.Annotations[kargoapi.AnnotationKeyBreakGlass]; ok {
+			logger.WithField("reason", reason).Infof(
+				"Promotion %q overrides a freeze window of PromotionPolicy %q",
+				promo.Name,
+				policy.Name,
+			)
+			return nil
+		}
+		return apierrors.NewForbidden(
+			promotionGroupResource,
+			promo.Name,
+			errors.Errorf(
+				"Stage %q is frozen by PromotionPolicy %q; to override, annotate "+
+					"the Promotion with %q",
+				promo.Spec.Stage,
+				policy.Name,
+				kargoapi.AnnotationKeyBreakGlass,
+			),
+		)
+	}
+	return nil
+}
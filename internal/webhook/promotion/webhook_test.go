@@ -2,10 +2,14 @@ package promotion
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	authnv1 "k8s.io/api/authentication/v1"
 	authzv1 "k8s.io/api/authorization/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -24,15 +28,52 @@ func TestNewWebhook(t *testing.T) {
 	require.NotNil(t, w.getStageFn)
 	require.NotNil(t, w.validateProjectFn)
 	require.NotNil(t, w.authorizeFn)
+	require.NotNil(t, w.validateFreezeWindowsFn)
+	require.NotNil(t, w.listPromotionPoliciesFn)
 	require.NotNil(t, w.admissionRequestFromContextFn)
 	require.NotNil(t, w.createSubjectAccessReviewFn)
 }
 
 func TestDefault(t *testing.T) {
+	getStage := func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.Stage, error) {
+		return &kargoapi.Stage{}, nil
+	}
+	admissionRequest := func(
+		operation admissionv1.Operation,
+		oldAnnotations map[string]string,
+	) func(context.Context) (admission.Request, error) {
+		return func(context.Context) (admission.Request, error) {
+			req := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: operation,
+					UserInfo: authnv1.UserInfo{
+						Username: "alice",
+					},
+				},
+			}
+			if operation == admissionv1.Update {
+				oldPromo, err := json.Marshal(
+					&kargoapi.Promotion{
+						ObjectMeta: v1.ObjectMeta{
+							Annotations: oldAnnotations,
+						},
+					},
+				)
+				require.NoError(t, err)
+				req.OldObject.Raw = oldPromo
+			}
+			return req, nil
+		}
+	}
 	testCases := []struct {
-		name       string
-		webhook    *webhook
-		assertions func(*kargoapi.Promotion, error)
+		name        string
+		webhook     *webhook
+		annotations map[string]string
+		assertions  func(*kargoapi.Promotion, error)
 	}{
 		{
 			name: "error getting stage",
@@ -67,25 +108,149 @@ func TestDefault(t *testing.T) {
 			},
 		},
 		{
-			name: "success",
+			name: "error retrieving admission request",
 			webhook: &webhook{
-				getStageFn: func(
+				getStageFn: getStage,
+				admissionRequestFromContextFn: func(
 					context.Context,
-					client.Client,
-					types.NamespacedName,
-				) (*kargoapi.Stage, error) {
-					return &kargoapi.Stage{}, nil
+				) (admission.Request, error) {
+					return admission.Request{}, errors.New("something went wrong")
 				},
 			},
+			assertions: func(_ *kargoapi.Promotion, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			webhook: &webhook{
+				getStageFn:                    getStage,
+				admissionRequestFromContextFn: admissionRequest(admissionv1.Create, nil),
+			},
 			assertions: func(promo *kargoapi.Promotion, err error) {
 				require.NoError(t, err)
 				require.NotEmpty(t, promo.OwnerReferences)
+				require.NotContains(t, promo.Annotations, kargoapi.AnnotationKeyBreakGlassBy)
+			},
+		},
+		{
+			name: "success breaking glass",
+			webhook: &webhook{
+				getStageFn:                    getStage,
+				admissionRequestFromContextFn: admissionRequest(admissionv1.Create, nil),
+			},
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyBreakGlass: "hotfix",
+			},
+			assertions: func(promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"alice",
+					promo.Annotations[kargoapi.AnnotationKeyBreakGlassBy],
+				)
+			},
+		},
+		{
+			name: "client-supplied break-glass-by is overwritten upon creation",
+			webhook: &webhook{
+				getStageFn:                    getStage,
+				admissionRequestFromContextFn: admissionRequest(admissionv1.Create, nil),
+			},
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyBreakGlass:   "hotfix",
+				kargoapi.AnnotationKeyBreakGlassBy: "bob",
+			},
+			assertions: func(promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"alice",
+					promo.Annotations[kargoapi.AnnotationKeyBreakGlassBy],
+				)
+			},
+		},
+		{
+			name: "client-supplied break-glass-by is removed upon creation",
+			webhook: &webhook{
+				getStageFn:                    getStage,
+				admissionRequestFromContextFn: admissionRequest(admissionv1.Create, nil),
+			},
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyBreakGlassBy: "bob",
+			},
+			assertions: func(promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.NotContains(t, promo.Annotations, kargoapi.AnnotationKeyBreakGlassBy)
+			},
+		},
+		{
+			name: "error unmarshaling existing Promotion upon update",
+			webhook: &webhook{
+				getStageFn: getStage,
+				admissionRequestFromContextFn: func(
+					context.Context,
+				) (admission.Request, error) {
+					return admission.Request{
+						AdmissionRequest: admissionv1.AdmissionRequest{
+							Operation: admissionv1.Update,
+						},
+					}, nil
+				},
+			},
+			assertions: func(_ *kargoapi.Promotion, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "error unmarshaling existing Promotion")
+			},
+		},
+		{
+			name: "break-glass-by is restored upon update",
+			webhook: &webhook{
+				getStageFn: getStage,
+				admissionRequestFromContextFn: admissionRequest(
+					admissionv1.Update,
+					map[string]string{
+						kargoapi.AnnotationKeyBreakGlass:   "hotfix",
+						kargoapi.AnnotationKeyBreakGlassBy: "alice",
+					},
+				),
+			},
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyBreakGlass:   "hotfix",
+				kargoapi.AnnotationKeyBreakGlassBy: "bob",
+			},
+			assertions: func(promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"alice",
+					promo.Annotations[kargoapi.AnnotationKeyBreakGlassBy],
+				)
+			},
+		},
+		{
+			name: "break-glass-by cannot be added upon update",
+			webhook: &webhook{
+				getStageFn:                    getStage,
+				admissionRequestFromContextFn: admissionRequest(admissionv1.Update, nil),
+			},
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyBreakGlass:   "hotfix",
+				kargoapi.AnnotationKeyBreakGlassBy: "bob",
+			},
+			assertions: func(promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.NotContains(t, promo.Annotations, kargoapi.AnnotationKeyBreakGlassBy)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			promo := &kargoapi.Promotion{
+				ObjectMeta: v1.ObjectMeta{
+					Annotations: testCase.annotations,
+				},
 				Spec: &kargoapi.PromotionSpec{
 					Stage: "fake-stage",
 				},
@@ -139,6 +304,32 @@ func TestValidateCreate(t *testing.T) {
 				require.Equal(t, "something went wrong", err.Error())
 			},
 		},
+		{
+			name: "freeze window error",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
+					return nil
+				},
+				validateFreezeWindowsFn: func(
+					context.Context,
+					*kargoapi.Promotion,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
 		{
 			name: "success",
 			webhook: &webhook{
@@ -153,6 +344,12 @@ func TestValidateCreate(t *testing.T) {
 				authorizeFn: func(context.Context, *kargoapi.Promotion, string) error {
 					return nil
 				},
+				validateFreezeWindowsFn: func(
+					context.Context,
+					*kargoapi.Promotion,
+				) error {
+					return nil
+				},
 			},
 			assertions: func(err error) {
 				require.NoError(t, err)
@@ -313,6 +510,8 @@ func TestAuthorize(t *testing.T) {
 			client.Object,
 			...client.CreateOption,
 		) error
+		rollback   bool
+		action     string
 		assertions func(err error)
 	}{
 		{
@@ -389,9 +588,58 @@ func TestAuthorize(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "creating a rollback requires the rollback verb",
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, nil
+			},
+			createSubjectAccessReviewFn: func(
+				_ context.Context,
+				obj client.Object,
+				_ ...client.CreateOption,
+			) error {
+				review := obj.(*authzv1.SubjectAccessReview) // nolint: forcetypeassert
+				review.Status.Allowed = review.Spec.ResourceAttributes.Verb == "promote"
+				return nil
+			},
+			rollback: true,
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "is not permitted")
+				require.Contains(t, err.Error(), `requires the "rollback" verb`)
+			},
+		},
+		{
+			name: "deleting a rollback requires the promote verb",
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, nil
+			},
+			createSubjectAccessReviewFn: func(
+				_ context.Context,
+				obj client.Object,
+				_ ...client.CreateOption,
+			) error {
+				review := obj.(*authzv1.SubjectAccessReview) // nolint: forcetypeassert
+				review.Status.Allowed = review.Spec.ResourceAttributes.Verb == "promote"
+				return nil
+			},
+			rollback: true,
+			action:   "delete",
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
+			action := testCase.action
+			if action == "" {
+				action = "create"
+			}
 			w := &webhook{
 				admissionRequestFromContextFn: testCase.admissionRequestFromContextFn,
 				createSubjectAccessReviewFn:   testCase.createSubjectAccessReviewFn,
@@ -404,11 +652,146 @@ func TestAuthorize(t *testing.T) {
 							Name:      "fake-promotion",
 							Namespace: "fake-namespace",
 						},
+						Spec: &kargoapi.PromotionSpec{
+							Stage:    "fake-stage",
+							Rollback: testCase.rollback,
+						},
+					},
+					action,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateFreezeWindows(t *testing.T) {
+	// Freeze windows that are always and never in effect, respectively
+	alwaysFrozen := kargoapi.PromotionPolicy{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "always-frozen",
+		},
+		FreezeWindows: []kargoapi.PromotionWindow{
+			{
+				Schedule: "* * * * *",
+				Duration: v1.Duration{Duration: time.Hour},
+			},
+		},
+	}
+	neverFrozen := kargoapi.PromotionPolicy{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "never-frozen",
+		},
+		FreezeWindows: []kargoapi.PromotionWindow{
+			{
+				Schedule: "0 0 30 2 *",
+				Duration: v1.Duration{Duration: time.Hour},
+			},
+		},
+	}
+	listPoliciesFn := func(policies ...kargoapi.PromotionPolicy) func(
+		context.Context,
+		client.ObjectList,
+		...client.ListOption,
+	) error {
+		return func(
+			_ context.Context,
+			objList client.ObjectList,
+			_ ...client.ListOption,
+		) error {
+			list, ok := objList.(*kargoapi.PromotionPolicyList)
+			require.True(t, ok)
+			list.Items = policies
+			return nil
+		}
+	}
+	testCases := []struct {
+		name        string
+		webhook     *webhook
+		annotations map[string]string
+		assertions  func(error)
+	}{
+		{
+			name: "error listing PromotionPolicies",
+			webhook: &webhook{
+				listPromotionPoliciesFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "invalid freeze window",
+			webhook: &webhook{
+				listPromotionPoliciesFn: listPoliciesFn(
+					kargoapi.PromotionPolicy{
+						FreezeWindows: []kargoapi.PromotionWindow{
+							{
+								Schedule: "bogus",
+							},
+						},
+					},
+				),
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "invalid schedule")
+			},
+		},
+		{
+			name: "not frozen",
+			webhook: &webhook{
+				listPromotionPoliciesFn: listPoliciesFn(neverFrozen),
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "frozen",
+			webhook: &webhook{
+				listPromotionPoliciesFn: listPoliciesFn(neverFrozen, alwaysFrozen),
+			},
+			assertions: func(err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "is frozen by PromotionPolicy")
+				require.Contains(t, err.Error(), kargoapi.AnnotationKeyBreakGlass)
+			},
+		},
+		{
+			name: "frozen with break-glass override",
+			webhook: &webhook{
+				listPromotionPoliciesFn: listPoliciesFn(alwaysFrozen),
+			},
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyBreakGlass: "hotfix",
+			},
+			assertions: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.webhook.validateFreezeWindows(
+					context.Background(),
+					&kargoapi.Promotion{
+						ObjectMeta: v1.ObjectMeta{
+							Name:        "fake-promotion",
+							Namespace:   "fake-namespace",
+							Annotations: testCase.annotations,
+						},
 						Spec: &kargoapi.PromotionSpec{
 							Stage: "fake-stage",
 						},
 					},
-					"create",
 				),
 			)
 		})
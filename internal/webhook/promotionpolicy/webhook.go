@@ -3,11 +3,13 @@ package promotionpolicy
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -82,6 +84,9 @@ func (w *webhook) ValidateCreate(
 	); err != nil {
 		return err
 	}
+	if errs := validateWindows(policy); len(errs) > 0 {
+		return apierrors.NewInvalid(promotionPolicyGroupKind, policy.Name, errs)
+	}
 	return w.validateStageUniquenessFn(ctx, policy)
 }
 
@@ -91,6 +96,9 @@ func (w *webhook) ValidateUpdate(
 	newObj runtime.Object,
 ) error {
 	policy := newObj.(*kargoapi.PromotionPolicy) // nolint: forcetypeassert
+	if errs := validateWindows(policy); len(errs) > 0 {
+		return apierrors.NewInvalid(promotionPolicyGroupKind, policy.Name, errs)
+	}
 	return w.validateStageUniquenessFn(ctx, policy)
 }
 
@@ -131,3 +139,50 @@ func (w *webhook) validateStageUniqueness(
 	}
 	return nil
 }
+
+func validateWindows(policy *kargoapi.PromotionPolicy) field.ErrorList {
+	var errs field.ErrorList
+	for i, window := range policy.AutoPromotionWindows {
+		errs = append(
+			errs,
+			validateWindow(field.NewPath("autoPromotionWindows").Index(i), window)...,
+		)
+	}
+	for i, window := range policy.FreezeWindows {
+		errs = append(
+			errs,
+			validateWindow(field.NewPath("freezeWindows").Index(i), window)...,
+		)
+	}
+	return errs
+}
+
+func validateWindow(
+	f *field.Path,
+	window kargoapi.PromotionWindow,
+) field.ErrorList {
+	var errs field.ErrorList
+	if window.Duration.Duration <= 0 {
+		errs = append(
+			errs,
+			field.Invalid(f.Child("duration"), window.Duration, "must be positive"),
+		)
+	}
+	if window.TimeZone != "" {
+		if _, err := time.LoadLocation(window.TimeZone); err != nil {
+			errs = append(
+				errs,
+				field.Invalid(f.Child("timeZone"), window.TimeZone, err.Error()),
+			)
+			// The schedule cannot be evaluated without a valid time zone
+			return errs
+		}
+	}
+	if _, err := window.IsOpen(time.Now()); err != nil {
+		errs = append(
+			errs,
+			field.Invalid(f.Child("schedule"), window.Schedule, err.Error()),
+		)
+	}
+	return errs
+}
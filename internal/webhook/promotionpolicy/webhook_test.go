@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -235,3 +237,76 @@ func TestValidateStageUniqueness(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateWindows(t *testing.T) {
+	testCases := []struct {
+		name       string
+		policy     *kargoapi.PromotionPolicy
+		assertions func(field.ErrorList)
+	}{
+		{
+			name:   "no windows",
+			policy: &kargoapi.PromotionPolicy{},
+			assertions: func(errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+		{
+			name: "invalid windows",
+			policy: &kargoapi.PromotionPolicy{
+				AutoPromotionWindows: []kargoapi.PromotionWindow{
+					{
+						Schedule: "bogus",
+						Duration: metav1.Duration{Duration: time.Hour},
+					},
+				},
+				FreezeWindows: []kargoapi.PromotionWindow{
+					{
+						Schedule: "0 12 * * 5",
+						Duration: metav1.Duration{Duration: time.Hour},
+					},
+					{
+						Schedule: "0 12 * * 5",
+						TimeZone: "Bogus/Zone",
+					},
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 3)
+				require.Equal(
+					t,
+					"autoPromotionWindows[0].schedule",
+					errs[0].Field,
+				)
+				require.Equal(t, "freezeWindows[1].duration", errs[1].Field)
+				require.Equal(t, "freezeWindows[1].timeZone", errs[2].Field)
+			},
+		},
+		{
+			name: "valid windows",
+			policy: &kargoapi.PromotionPolicy{
+				AutoPromotionWindows: []kargoapi.PromotionWindow{
+					{
+						Schedule: "0 9 * * 1-4",
+						Duration: metav1.Duration{Duration: 8 * time.Hour},
+						TimeZone: "Europe/Berlin",
+					},
+				},
+				FreezeWindows: []kargoapi.PromotionWindow{
+					{
+						Schedule: "0 12 * * 5",
+						Duration: metav1.Duration{Duration: 60 * time.Hour},
+					},
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(validateWindows(testCase.policy))
+		})
+	}
+}
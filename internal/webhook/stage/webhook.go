@@ -3,15 +3,23 @@ package stage
 import (
 	"context"
 	"fmt"
+	"text/template"
 
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/util/jsonpath"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/promotion"
+	"github.com/akuity/kargo/internal/credentials"
 	libWebhook "github.com/akuity/kargo/internal/webhook"
 )
 
@@ -34,26 +42,36 @@ type webhook struct {
 		client.Object,
 	) error
 
-	validateCreateOrUpdateFn func(*kargoapi.Stage) error
+	validateCreateOrUpdateFn func(context.Context, *kargoapi.Stage) error
 
 	validateSpecFn func(*field.Path, *kargoapi.StageSpec) field.ErrorList
+
+	getSecretMetadataFn func(
+		context.Context,
+		client.ObjectKey,
+		client.Object,
+		...client.GetOption,
+	) error
 }
 
 func SetupWebhookWithManager(mgr ctrl.Manager) error {
-	w := newWebhook(mgr.GetClient())
+	// Secrets are read directly from the API server, rather than from a cache,
+	// so that the webhook server never needs to list or watch Secrets.
+	w := newWebhook(mgr.GetClient(), mgr.GetAPIReader())
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(&kargoapi.Stage{}).
 		WithValidator(w).
 		Complete()
 }
 
-func newWebhook(kubeClient client.Client) *webhook {
+func newWebhook(kubeClient client.Client, apiReader client.Reader) *webhook {
 	w := &webhook{
 		client: kubeClient,
 	}
 	w.validateProjectFn = libWebhook.ValidateProject
 	w.validateCreateOrUpdateFn = w.validateCreateOrUpdate
 	w.validateSpecFn = w.validateSpec
+	w.getSecretMetadataFn = apiReader.Get
 	return w
 }
 
@@ -66,16 +84,16 @@ func (w *webhook) ValidateCreate(
 		w.validateProjectFn(ctx, w.client, stageGroupKind, stage); err != nil {
 		return err
 	}
-	return w.validateCreateOrUpdateFn(stage)
+	return w.validateCreateOrUpdateFn(ctx, stage)
 }
 
 func (w *webhook) ValidateUpdate(
-	_ context.Context,
+	ctx context.Context,
 	_ runtime.Object,
 	newObj runtime.Object,
 ) error {
 	stage := newObj.(*kargoapi.Stage) // nolint: forcetypeassert
-	return w.validateCreateOrUpdateFn(stage)
+	return w.validateCreateOrUpdateFn(ctx, stage)
 }
 
 func (w *webhook) ValidateDelete(context.Context, runtime.Object) error {
@@ -83,10 +101,24 @@ func (w *webhook) ValidateDelete(context.Context, runtime.Object) error {
 	return nil
 }
 
-func (w *webhook) validateCreateOrUpdate(e *kargoapi.Stage) error {
+func (w *webhook) validateCreateOrUpdate(
+	ctx context.Context,
+	e *kargoapi.Stage,
+) error {
 	if errs := w.validateSpecFn(field.NewPath("spec"), e.Spec); len(errs) > 0 {
 		return apierrors.NewInvalid(stageGroupKind, e.Name, errs)
 	}
+	if e.Spec == nil || e.Spec.PromotionMechanisms == nil {
+		return nil
+	}
+	if errs := w.validateHTTPWebhookSecrets(
+		ctx,
+		field.NewPath("spec", "promotionMechanisms", "httpWebhooks"),
+		e.Namespace,
+		e.Spec.PromotionMechanisms.HTTPWebhooks,
+	); len(errs) > 0 {
+		return apierrors.NewInvalid(stageGroupKind, e.Name, errs)
+	}
 	return nil
 }
 
@@ -140,24 +172,316 @@ func (w *webhook) validatePromotionMechanisms(
 	}
 	// Must define at least one mechanism
 	if len(promoMechs.GitRepoUpdates) == 0 &&
-		len(promoMechs.ArgoCDAppUpdates) == 0 {
+		len(promoMechs.ArgoCDAppUpdates) == 0 &&
+		len(promoMechs.FluxUpdates) == 0 &&
+		len(promoMechs.KubernetesPatches) == 0 &&
+		len(promoMechs.Jobs) == 0 &&
+		len(promoMechs.HTTPWebhooks) == 0 {
 		return field.ErrorList{
 			field.Invalid(
 				f,
 				promoMechs,
 				fmt.Sprintf(
-					"at least one of %s.gitRepoUpdates or %s.argoCDAppUpdates must "+
-						"be non-empty",
+					"at least one of %s.gitRepoUpdates, %s.argoCDAppUpdates, "+
+						"%s.fluxUpdates, %s.kubernetesPatches, %s.jobs, or "+
+						"%s.httpWebhooks must be non-empty",
+					f.String(),
+					f.String(),
+					f.String(),
+					f.String(),
 					f.String(),
 					f.String(),
 				),
 			),
 		}
 	}
-	return w.validateGitRepoUpdates(
+	errs := w.validateGitRepoUpdates(
 		f.Child("gitRepoUpdates"),
 		promoMechs.GitRepoUpdates,
 	)
+	errs = append(
+		errs,
+		w.validateFluxUpdates(f.Child("fluxUpdates"), promoMechs.FluxUpdates)...,
+	)
+	errs = append(
+		errs,
+		w.validateKubernetesPatches(
+			f.Child("kubernetesPatches"),
+			promoMechs.KubernetesPatches,
+		)...,
+	)
+	errs = append(errs, w.validateJobs(f.Child("jobs"), promoMechs.Jobs)...)
+	return append(
+		errs,
+		w.validateHTTPWebhooks(f.Child("httpWebhooks"), promoMechs.HTTPWebhooks)...,
+	)
+}
+
+func (w *webhook) validateJobs(
+	f *field.Path,
+	jobs []kargoapi.PromotionJob,
+) field.ErrorList {
+	var errs field.ErrorList
+	names := make(map[string]struct{}, len(jobs))
+	for i, job := range jobs {
+		if _, ok := names[job.Name]; ok {
+			errs = append(errs, field.Duplicate(f.Index(i).Child("name"), job.Name))
+		}
+		names[job.Name] = struct{}{}
+		errs = append(errs, w.validateJob(f.Index(i), job)...)
+	}
+	return errs
+}
+
+func (w *webhook) validateJob(
+	f *field.Path,
+	job kargoapi.PromotionJob,
+) field.ErrorList {
+	spec := batchv1.JobSpec{}
+	if err := yaml.UnmarshalStrict([]byte(job.Template), &spec); err != nil {
+		return field.ErrorList{
+			field.Invalid(
+				f.Child("template"),
+				job.Template,
+				fmt.Sprintf("%s.template is not a valid Job spec: %s", f.String(), err),
+			),
+		}
+	}
+	if len(spec.Template.Spec.Containers) == 0 {
+		return field.ErrorList{
+			field.Invalid(
+				f.Child("template"),
+				job.Template,
+				fmt.Sprintf(
+					"%s.template must define at least one container",
+					f.String(),
+				),
+			),
+		}
+	}
+	switch spec.Template.Spec.RestartPolicy {
+	case "", corev1.RestartPolicyNever, corev1.RestartPolicyOnFailure:
+	default:
+		return field.ErrorList{
+			field.Invalid(
+				f.Child("template"),
+				job.Template,
+				fmt.Sprintf(
+					"%s.template may only specify a restart policy of %s or %s",
+					f.String(),
+					corev1.RestartPolicyNever,
+					corev1.RestartPolicyOnFailure,
+				),
+			),
+		}
+	}
+	if spec.Template.Spec.ServiceAccountName != "" ||
+		spec.Template.Spec.DeprecatedServiceAccount != "" {
+		// Permitting this would allow anyone who can modify a Stage to run a Job
+		// with the permissions of any ServiceAccount in the Stage's namespace.
+		return field.ErrorList{
+			field.Forbidden(
+				f.Child("template"),
+				fmt.Sprintf(
+					"%s.template may not specify a service account; Jobs always run "+
+						"as the namespace's default ServiceAccount",
+					f.String(),
+				),
+			),
+		}
+	}
+	return nil
+}
+
+func (w *webhook) validateHTTPWebhooks(
+	f *field.Path,
+	webhooks []kargoapi.HTTPWebhook,
+) field.ErrorList {
+	var errs field.ErrorList
+	names := make(map[string]struct{}, len(webhooks))
+	for i, webhook := range webhooks {
+		if _, ok := names[webhook.Name]; ok {
+			errs = append(
+				errs,
+				field.Duplicate(f.Index(i).Child("name"), webhook.Name),
+			)
+		}
+		names[webhook.Name] = struct{}{}
+		errs = append(errs, w.validateHTTPWebhook(f.Index(i), webhook)...)
+	}
+	return errs
+}
+
+func (w *webhook) validateHTTPWebhook(
+	f *field.Path,
+	webhook kargoapi.HTTPWebhook,
+) field.ErrorList {
+	if _, err := template.New("").
+		Funcs(promotion.TemplateFuncs()).
+		Parse(webhook.Body); err != nil {
+		return field.ErrorList{
+			field.Invalid(
+				f.Child("body"),
+				webhook.Body,
+				fmt.Sprintf("%s.body is not a valid template: %s", f.String(), err),
+			),
+		}
+	}
+	if webhook.SuccessCriteria != nil && webhook.SuccessCriteria.JSONPath != "" {
+		if err := jsonpath.New("").Parse(
+			webhook.SuccessCriteria.JSONPath,
+		); err != nil {
+			return field.ErrorList{
+				field.Invalid(
+					f.Child("successCriteria", "jsonPath"),
+					webhook.SuccessCriteria.JSONPath,
+					fmt.Sprintf(
+						"%s.successCriteria.jsonPath is not a valid JSONPath "+
+							"expression: %s",
+						f.String(),
+						err,
+					),
+				),
+			}
+		}
+	}
+	return nil
+}
+
+// validateHTTPWebhookSecrets rejects HTTP webhooks that name a headers Secret
+// that may not be used for that purpose, such as one holding repository
+// credentials. A Secret that does not exist yet is accepted, since the
+// promotion mechanism applies the same check when the webhook is called.
+func (w *webhook) validateHTTPWebhookSecrets(
+	ctx context.Context,
+	f *field.Path,
+	namespace string,
+	webhooks []kargoapi.HTTPWebhook,
+) field.ErrorList {
+	var errs field.ErrorList
+	for i, webhook := range webhooks {
+		if webhook.HeadersSecretName == "" {
+			continue
+		}
+		secret := &metav1.PartialObjectMetadata{}
+		secret.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+		if err := w.getSecretMetadataFn(
+			ctx,
+			client.ObjectKey{
+				Namespace: namespace,
+				Name:      webhook.HeadersSecretName,
+			},
+			secret,
+		); err != nil {
+			if !apierrors.IsNotFound(err) {
+				errs = append(
+					errs,
+					field.InternalError(f.Index(i).Child("headersSecretName"), err),
+				)
+			}
+			continue
+		}
+		if err := credentials.ValidateWebhookHeadersSecret(secret); err != nil {
+			errs = append(
+				errs,
+				field.Forbidden(
+					f.Index(i).Child("headersSecretName"),
+					err.Error(),
+				),
+			)
+		}
+	}
+	return errs
+}
+
+func (w *webhook) validateKubernetesPatches(
+	f *field.Path,
+	patches []kargoapi.KubernetesPatch,
+) field.ErrorList {
+	var errs field.ErrorList
+	for i, patch := range patches {
+		errs = append(errs, w.validateKubernetesPatch(f.Index(i), patch)...)
+	}
+	return errs
+}
+
+func (w *webhook) validateKubernetesPatch(
+	f *field.Path,
+	patch kargoapi.KubernetesPatch,
+) field.ErrorList {
+	if _, err := schema.ParseGroupVersion(patch.APIVersion); err != nil {
+		return field.ErrorList{
+			field.Invalid(
+				f.Child("apiVersion"),
+				patch.APIVersion,
+				fmt.Sprintf(
+					"%s.apiVersion is not a valid API version: %s",
+					f.String(),
+					err,
+				),
+			),
+		}
+	}
+	if _, err := template.New("").
+		Funcs(promotion.TemplateFuncs()).
+		Parse(patch.Patch); err != nil {
+		return field.ErrorList{
+			field.Invalid(
+				f.Child("patch"),
+				patch.Patch,
+				fmt.Sprintf("%s.patch is not a valid template: %s", f.String(), err),
+			),
+		}
+	}
+	return nil
+}
+
+func (w *webhook) validateFluxUpdates(
+	f *field.Path,
+	updates []kargoapi.FluxUpdate,
+) field.ErrorList {
+	var errs field.ErrorList
+	for i, update := range updates {
+		errs = append(errs, w.validateFluxUpdate(f.Index(i), update)...)
+	}
+	return errs
+}
+
+func (w *webhook) validateFluxUpdate(
+	f *field.Path,
+	update kargoapi.FluxUpdate,
+) field.ErrorList {
+	if update.HelmRelease != nil &&
+		update.Kind != kargoapi.FluxResourceKindHelmRelease {
+		return field.ErrorList{
+			field.Invalid(
+				f,
+				update,
+				fmt.Sprintf(
+					"%s.helmRelease may only be defined when %s.kind is %s",
+					f.String(),
+					f.String(),
+					kargoapi.FluxResourceKindHelmRelease,
+				),
+			),
+		}
+	}
+	if update.Kustomization != nil &&
+		update.Kind != kargoapi.FluxResourceKindKustomization {
+		return field.ErrorList{
+			field.Invalid(
+				f,
+				update,
+				fmt.Sprintf(
+					"%s.kustomization may only be defined when %s.kind is %s",
+					f.String(),
+					f.String(),
+					kargoapi.FluxResourceKindKustomization,
+				),
+			),
+		}
+	}
+	return nil
 }
 
 func (w *webhook) validateGitRepoUpdates(
@@ -185,14 +509,22 @@ func (w *webhook) validateGitRepoUpdate(
 	if update.Helm != nil {
 		count++
 	}
+	if update.HelmTemplate != nil {
+		count++
+	}
+	if len(update.YAMLUpdates) > 0 {
+		count++
+	}
 	if count > 1 {
 		return field.ErrorList{
 			field.Invalid(
 				f,
 				update,
 				fmt.Sprintf(
-					"no more than one of %s.render, or %s.kustomize, or %s.helm may "+
-						"be defined",
+					"no more than one of %s.render, or %s.kustomize, or %s.helm, or "+
+						"%s.helmTemplate, or %s.yamlUpdates may be defined",
+					f.String(),
+					f.String(),
 					f.String(),
 					f.String(),
 					f.String(),
@@ -200,7 +532,78 @@ func (w *webhook) validateGitRepoUpdate(
 			),
 		}
 	}
-	return w.validateHelmPromotionMechanism(f.Child("helm"), update.Helm)
+	if update.Render != nil && update.PullRequest != nil {
+		return field.ErrorList{
+			field.Invalid(
+				f,
+				update,
+				fmt.Sprintf(
+					"%s.pullRequest may not be defined in conjunction with %s.render",
+					f.String(),
+					f.String(),
+				),
+			),
+		}
+	}
+	// Rendered manifests replace the entire contents of the write branch, so
+	// it cannot also be the branch the sources are read from. An unspecified
+	// read branch means the repository's default branch, which cannot be known
+	// here and may well be the write branch, so one must be specified.
+	var renderField string
+	if update.HelmTemplate != nil {
+		renderField = f.Child("helmTemplate").String()
+	} else if update.Kustomize != nil && update.Kustomize.Build != nil {
+		renderField = f.Child("kustomize", "build").String()
+	}
+	if renderField != "" && update.ReadBranch == "" {
+		return field.ErrorList{
+			field.Required(
+				f.Child("readBranch"),
+				fmt.Sprintf(
+					"%s.readBranch is required when %s is defined",
+					f.String(),
+					renderField,
+				),
+			),
+		}
+	}
+	if renderField != "" && update.ReadBranch == update.WriteBranch {
+		return field.ErrorList{
+			field.Invalid(
+				f,
+				update,
+				fmt.Sprintf(
+					"%s.readBranch and %s.writeBranch may not be the same when "+
+						"%s is defined",
+					f.String(),
+					f.String(),
+					renderField,
+				),
+			),
+		}
+	}
+	if update.CommitMessageTemplate != "" {
+		if _, err := template.New("").
+			Funcs(promotion.TemplateFuncs()).
+			Parse(update.CommitMessageTemplate); err != nil {
+			return field.ErrorList{
+				field.Invalid(
+					f.Child("commitMessageTemplate"),
+					update.CommitMessageTemplate,
+					fmt.Sprintf(
+						"%s.commitMessageTemplate is not a valid template: %s",
+						f.String(),
+						err,
+					),
+				),
+			}
+		}
+	}
+	errs := w.validateHelmPromotionMechanism(f.Child("helm"), update.Helm)
+	return append(
+		errs,
+		w.validateYAMLUpdates(f.Child("yamlUpdates"), update.YAMLUpdates)...,
+	)
 }
 
 func (w *webhook) validateHelmPromotionMechanism(
@@ -226,3 +629,70 @@ func (w *webhook) validateHelmPromotionMechanism(
 	}
 	return nil
 }
+
+func (w *webhook) validateYAMLUpdates(
+	f *field.Path,
+	updates []kargoapi.YAMLUpdate,
+) field.ErrorList {
+	var errs field.ErrorList
+	for i, update := range updates {
+		errs = append(errs, w.validateYAMLUpdate(f.Index(i), update)...)
+	}
+	return errs
+}
+
+func (w *webhook) validateYAMLUpdate(
+	f *field.Path,
+	update kargoapi.YAMLUpdate,
+) field.ErrorList {
+	// Each type of value must identify the artifact it is to be taken from
+	switch update.Value {
+	case kargoapi.YAMLUpdateValueTypeImage,
+		kargoapi.YAMLUpdateValueTypeTag,
+		kargoapi.YAMLUpdateValueTypeDigest:
+		if update.Image == "" {
+			return field.ErrorList{
+				field.Required(
+					f.Child("image"),
+					fmt.Sprintf(
+						"%s.image is required when %s.value is %q",
+						f.String(),
+						f.String(),
+						update.Value,
+					),
+				),
+			}
+		}
+	case kargoapi.YAMLUpdateValueTypeCommitID:
+		if update.GitRepoURL == "" {
+			return field.ErrorList{
+				field.Required(
+					f.Child("gitRepoURL"),
+					fmt.Sprintf(
+						"%s.gitRepoURL is required when %s.value is %q",
+						f.String(),
+						f.String(),
+						update.Value,
+					),
+				),
+			}
+		}
+	case kargoapi.YAMLUpdateValueTypeChartVersion:
+		if update.ChartRegistryURL == "" || update.ChartName == "" {
+			return field.ErrorList{
+				field.Required(
+					f,
+					fmt.Sprintf(
+						"%s.chartRegistryURL and %s.chartName are required when "+
+							"%s.value is %q",
+						f.String(),
+						f.String(),
+						f.String(),
+						update.Value,
+					),
+				),
+			}
+		}
+	}
+	return nil
+}
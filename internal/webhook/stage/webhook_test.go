@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -16,11 +18,12 @@ import (
 
 func TestNewWebhook(t *testing.T) {
 	kubeClient := fake.NewClientBuilder().Build()
-	w := newWebhook(kubeClient)
+	w := newWebhook(kubeClient, kubeClient)
 	// Assert that all overridable behaviors were initialized to a default:
 	require.NotNil(t, w.validateProjectFn)
 	require.NotNil(t, w.validateCreateOrUpdateFn)
 	require.NotNil(t, w.validateSpecFn)
+	require.NotNil(t, w.getSecretMetadataFn)
 }
 
 func TestValidateCreate(t *testing.T) {
@@ -57,7 +60,7 @@ func TestValidateCreate(t *testing.T) {
 				) error {
 					return nil
 				},
-				validateCreateOrUpdateFn: func(*kargoapi.Stage) error {
+				validateCreateOrUpdateFn: func(context.Context, *kargoapi.Stage) error {
 					return errors.New("something went wrong")
 				},
 			},
@@ -77,7 +80,7 @@ func TestValidateCreate(t *testing.T) {
 				) error {
 					return nil
 				},
-				validateCreateOrUpdateFn: func(*kargoapi.Stage) error {
+				validateCreateOrUpdateFn: func(context.Context, *kargoapi.Stage) error {
 					return nil
 				},
 			},
@@ -107,7 +110,7 @@ func TestValidateUpdate(t *testing.T) {
 		{
 			name: "error validating stage",
 			webhook: &webhook{
-				validateCreateOrUpdateFn: func(*kargoapi.Stage) error {
+				validateCreateOrUpdateFn: func(context.Context, *kargoapi.Stage) error {
 					return errors.New("something went wrong")
 				},
 			},
@@ -119,7 +122,7 @@ func TestValidateUpdate(t *testing.T) {
 		{
 			name: "success",
 			webhook: &webhook{
-				validateCreateOrUpdateFn: func(*kargoapi.Stage) error {
+				validateCreateOrUpdateFn: func(context.Context, *kargoapi.Stage) error {
 					return nil
 				},
 			},
@@ -184,7 +187,10 @@ func TestValidateCreateOrUpdate(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
-				testCase.webhook.validateCreateOrUpdate(&kargoapi.Stage{}),
+				testCase.webhook.validateCreateOrUpdate(
+					context.Background(),
+					&kargoapi.Stage{},
+				),
 			)
 		})
 	}
@@ -234,8 +240,12 @@ func TestValidateSpec(t *testing.T) {
 							Field:    "spec.promotionMechanisms",
 							BadValue: spec.PromotionMechanisms,
 							Detail: "at least one of " +
-								"spec.promotionMechanisms.gitRepoUpdates or " +
-								"spec.promotionMechanisms.argoCDAppUpdates must be non-empty",
+								"spec.promotionMechanisms.gitRepoUpdates, " +
+								"spec.promotionMechanisms.argoCDAppUpdates, " +
+								"spec.promotionMechanisms.fluxUpdates, " +
+								"spec.promotionMechanisms.kubernetesPatches, " +
+								"spec.promotionMechanisms.jobs, or " +
+								"spec.promotionMechanisms.httpWebhooks must be non-empty",
 						},
 					},
 					errs,
@@ -380,8 +390,12 @@ func TestValidatePromotionMechanisms(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "promotionMechanisms",
 							BadValue: promoMechs,
-							Detail: "at least one of promotionMechanisms.gitRepoUpdates or " +
-								"promotionMechanisms.argoCDAppUpdates must be non-empty",
+							Detail: "at least one of promotionMechanisms.gitRepoUpdates, " +
+								"promotionMechanisms.argoCDAppUpdates, " +
+								"promotionMechanisms.fluxUpdates, " +
+								"promotionMechanisms.kubernetesPatches, " +
+								"promotionMechanisms.jobs, or " +
+								"promotionMechanisms.httpWebhooks must be non-empty",
 						},
 					},
 					errs,
@@ -417,6 +431,426 @@ func TestValidatePromotionMechanisms(t *testing.T) {
 	}
 }
 
+func TestValidateFluxUpdate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		update     kargoapi.FluxUpdate
+		assertions func(kargoapi.FluxUpdate, field.ErrorList)
+	}{
+		{
+			name: "helmRelease defined for wrong kind",
+			update: kargoapi.FluxUpdate{
+				Kind:        kargoapi.FluxResourceKindKustomization,
+				HelmRelease: &kargoapi.FluxHelmReleaseUpdate{},
+			},
+			assertions: func(update kargoapi.FluxUpdate, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "fluxUpdate",
+							BadValue: update,
+							Detail: "fluxUpdate.helmRelease may only be defined when " +
+								"fluxUpdate.kind is HelmRelease",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "kustomization defined for wrong kind",
+			update: kargoapi.FluxUpdate{
+				Kind:          kargoapi.FluxResourceKindHelmRelease,
+				Kustomization: &kargoapi.FluxKustomizationUpdate{},
+			},
+			assertions: func(update kargoapi.FluxUpdate, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "fluxUpdate",
+							BadValue: update,
+							Detail: "fluxUpdate.kustomization may only be defined when " +
+								"fluxUpdate.kind is Kustomization",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "valid",
+			update: kargoapi.FluxUpdate{
+				Kind:        kargoapi.FluxResourceKindHelmRelease,
+				HelmRelease: &kargoapi.FluxHelmReleaseUpdate{},
+			},
+			assertions: func(_ kargoapi.FluxUpdate, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				testCase.update,
+				w.validateFluxUpdate(field.NewPath("fluxUpdate"), testCase.update),
+			)
+		})
+	}
+}
+
+func TestValidateKubernetesPatch(t *testing.T) {
+	testCases := []struct {
+		name       string
+		patch      kargoapi.KubernetesPatch
+		assertions func(field.ErrorList)
+	}{
+		{
+			name: "invalid API version",
+			patch: kargoapi.KubernetesPatch{
+				APIVersion: "a/b/c",
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "kubernetesPatch.apiVersion", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "is not a valid API version")
+			},
+		},
+		{
+			name: "invalid template",
+			patch: kargoapi.KubernetesPatch{
+				APIVersion: "apps/v1",
+				Patch:      "{{ .Images ",
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "kubernetesPatch.patch", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "is not a valid template")
+			},
+		},
+		{
+			name: "valid",
+			patch: kargoapi.KubernetesPatch{
+				APIVersion: "apps/v1",
+				Patch:      `{"metadata":{"labels":{"tag":"{{ .ImageTag "nginx" }}"}}}`,
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				w.validateKubernetesPatch(
+					field.NewPath("kubernetesPatch"),
+					testCase.patch,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateJobs(t *testing.T) {
+	const validTemplate = `
+template:
+  spec:
+    containers:
+    - name: migrate
+      image: migrate:latest
+`
+	testCases := []struct {
+		name       string
+		jobs       []kargoapi.PromotionJob
+		assertions func(field.ErrorList)
+	}{
+		{
+			name: "duplicate names",
+			jobs: []kargoapi.PromotionJob{
+				{
+					Name:     "migrate",
+					Template: validTemplate,
+				},
+				{
+					Name:     "migrate",
+					Template: validTemplate,
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeDuplicate, errs[0].Type)
+				require.Equal(t, "jobs[1].name", errs[0].Field)
+			},
+		},
+		{
+			name: "invalid template",
+			jobs: []kargoapi.PromotionJob{
+				{
+					Name:     "migrate",
+					Template: "bogus: true",
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "jobs[0].template", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "is not a valid Job spec")
+			},
+		},
+		{
+			name: "no containers",
+			jobs: []kargoapi.PromotionJob{
+				{
+					Name:     "migrate",
+					Template: "backoffLimit: 1",
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Contains(t, errs[0].Detail, "must define at least one container")
+			},
+		},
+		{
+			name: "invalid restart policy",
+			jobs: []kargoapi.PromotionJob{
+				{
+					Name:     "migrate",
+					Template: validTemplate + "    restartPolicy: Always\n",
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Contains(t, errs[0].Detail, "may only specify a restart policy")
+			},
+		},
+		{
+			name: "service account name specified",
+			jobs: []kargoapi.PromotionJob{
+				{
+					Name:     "migrate",
+					Template: validTemplate + "    serviceAccountName: admin\n",
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+				require.Contains(t, errs[0].Detail, "may not specify a service account")
+			},
+		},
+		{
+			name: "deprecated service account specified",
+			jobs: []kargoapi.PromotionJob{
+				{
+					Name:     "migrate",
+					Template: validTemplate + "    serviceAccount: admin\n",
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+			},
+		},
+		{
+			name: "valid",
+			jobs: []kargoapi.PromotionJob{
+				{
+					Name:     "migrate",
+					Template: validTemplate,
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				w.validateJobs(field.NewPath("jobs"), testCase.jobs),
+			)
+		})
+	}
+}
+
+func TestValidateHTTPWebhooks(t *testing.T) {
+	testCases := []struct {
+		name       string
+		webhooks   []kargoapi.HTTPWebhook
+		assertions func(field.ErrorList)
+	}{
+		{
+			name: "duplicate names",
+			webhooks: []kargoapi.HTTPWebhook{
+				{Name: "fake-name"},
+				{Name: "fake-name"},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeDuplicate, errs[0].Type)
+				require.Equal(t, "httpWebhooks[1].name", errs[0].Field)
+			},
+		},
+		{
+			name: "invalid body template",
+			webhooks: []kargoapi.HTTPWebhook{
+				{
+					Name: "fake-name",
+					Body: "{{ .Freight ",
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "httpWebhooks[0].body", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "is not a valid template")
+			},
+		},
+		{
+			name: "invalid JSONPath",
+			webhooks: []kargoapi.HTTPWebhook{
+				{
+					Name: "fake-name",
+					SuccessCriteria: &kargoapi.HTTPSuccessCriteria{
+						JSONPath: "{.status",
+					},
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "httpWebhooks[0].successCriteria.jsonPath", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "is not a valid JSONPath expression")
+			},
+		},
+		{
+			name: "valid",
+			webhooks: []kargoapi.HTTPWebhook{
+				{
+					Name: "fake-name",
+					Body: `{"freight":{{ quote .Freight }},"commits":{{ toJson .Commits }}}`,
+					SuccessCriteria: &kargoapi.HTTPSuccessCriteria{
+						JSONPath: "{.status}",
+					},
+				},
+				{
+					Name: "another-fake-name",
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				w.validateHTTPWebhooks(
+					field.NewPath("httpWebhooks"),
+					testCase.webhooks,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateHTTPWebhookSecrets(t *testing.T) {
+	newSecret := func(name string, labels map[string]string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      name,
+				Labels:    labels,
+			},
+		}
+	}
+	kubeClient := fake.NewClientBuilder().WithObjects(
+		newSecret(
+			"webhook-headers",
+			map[string]string{
+				kargoapi.LabelSecretTypeKey: kargoapi.LabelSecretTypeWebhookHeaders,
+			},
+		),
+		newSecret(
+			"repo-creds",
+			map[string]string{
+				kargoapi.LabelSecretTypeKey: "repository",
+			},
+		),
+		newSecret("unlabeled", nil),
+	).Build()
+	testCases := []struct {
+		name       string
+		secretName string
+		assertions func(field.ErrorList)
+	}{
+		{
+			name: "no headers Secret",
+			assertions: func(errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+		{
+			name:       "headers Secret does not exist",
+			secretName: "missing",
+			assertions: func(errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+		{
+			name:       "headers Secret holds repository credentials",
+			secretName: "repo-creds",
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+				require.Equal(t, "httpWebhooks[0].headersSecretName", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "holds credentials")
+			},
+		},
+		{
+			name:       "headers Secret is not labeled for webhooks",
+			secretName: "unlabeled",
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeForbidden, errs[0].Type)
+				require.Contains(t, errs[0].Detail, "unless it is labeled")
+			},
+		},
+		{
+			name:       "headers Secret is labeled for webhooks",
+			secretName: "webhook-headers",
+			assertions: func(errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+	}
+	w := &webhook{
+		getSecretMetadataFn: kubeClient.Get,
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				w.validateHTTPWebhookSecrets(
+					context.Background(),
+					field.NewPath("httpWebhooks"),
+					"fake-namespace",
+					[]kargoapi.HTTPWebhook{
+						{
+							Name:              "fake-webhook",
+							HeadersSecretName: testCase.secretName,
+						},
+					},
+				),
+			)
+		})
+	}
+}
+
 func TestValidateGitRepoUpdates(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -439,8 +873,9 @@ func TestValidateGitRepoUpdates(t *testing.T) {
 							Field:    "gitRepoUpdates[0]",
 							BadValue: update,
 							Detail: "no more than one of gitRepoUpdates[0].render, or " +
-								"gitRepoUpdates[0].kustomize, or gitRepoUpdates[0].helm " +
-								"may be defined",
+								"gitRepoUpdates[0].kustomize, or gitRepoUpdates[0].helm, " +
+								"or gitRepoUpdates[0].helmTemplate, or " +
+								"gitRepoUpdates[0].yamlUpdates may be defined",
 						},
 					},
 					errs,
@@ -496,7 +931,60 @@ func TestValidateGitRepoUpdate(t *testing.T) {
 							Field:    "gitRepoUpdate",
 							BadValue: update,
 							Detail: "no more than one of gitRepoUpdate.render, or " +
-								"gitRepoUpdate.kustomize, or gitRepoUpdate.helm may be " +
+								"gitRepoUpdate.kustomize, or gitRepoUpdate.helm, or " +
+								"gitRepoUpdate.helmTemplate, or gitRepoUpdate.yamlUpdates " +
+								"may be defined",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "kustomize build does not specify a read branch",
+			update: kargoapi.GitRepoUpdate{
+				WriteBranch: "main",
+				Kustomize: &kargoapi.KustomizePromotionMechanism{
+					Build: &kargoapi.KustomizeBuild{},
+				},
+			},
+			assertions: func(_ kargoapi.GitRepoUpdate, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeRequired,
+							Field:    "gitRepoUpdate.readBranch",
+							BadValue: "",
+							Detail: "gitRepoUpdate.readBranch is required when " +
+								"gitRepoUpdate.kustomize.build is defined",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "kustomize build reads from and writes to the same branch",
+			update: kargoapi.GitRepoUpdate{
+				ReadBranch:  "main",
+				WriteBranch: "main",
+				Kustomize: &kargoapi.KustomizePromotionMechanism{
+					Build: &kargoapi.KustomizeBuild{},
+				},
+			},
+			assertions: func(update kargoapi.GitRepoUpdate, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "gitRepoUpdate",
+							BadValue: update,
+							Detail: "gitRepoUpdate.readBranch and gitRepoUpdate.writeBranch " +
+								"may not be the same when gitRepoUpdate.kustomize.build is " +
 								"defined",
 						},
 					},
@@ -505,6 +993,107 @@ func TestValidateGitRepoUpdate(t *testing.T) {
 			},
 		},
 
+		{
+			name: "helm template does not specify a read branch",
+			update: kargoapi.GitRepoUpdate{
+				WriteBranch:  "main",
+				HelmTemplate: &kargoapi.HelmTemplatePromotionMechanism{},
+			},
+			assertions: func(_ kargoapi.GitRepoUpdate, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeRequired,
+							Field:    "gitRepoUpdate.readBranch",
+							BadValue: "",
+							Detail: "gitRepoUpdate.readBranch is required when " +
+								"gitRepoUpdate.helmTemplate is defined",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "helm template reads from and writes to a different branch",
+			update: kargoapi.GitRepoUpdate{
+				ReadBranch:   "main",
+				WriteBranch:  "env/test",
+				HelmTemplate: &kargoapi.HelmTemplatePromotionMechanism{},
+			},
+			assertions: func(_ kargoapi.GitRepoUpdate, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+
+		{
+			name: "helm template reads from and writes to the same branch",
+			update: kargoapi.GitRepoUpdate{
+				ReadBranch:   "main",
+				WriteBranch:  "main",
+				HelmTemplate: &kargoapi.HelmTemplatePromotionMechanism{},
+			},
+			assertions: func(update kargoapi.GitRepoUpdate, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "gitRepoUpdate",
+							BadValue: update,
+							Detail: "gitRepoUpdate.readBranch and gitRepoUpdate.writeBranch " +
+								"may not be the same when gitRepoUpdate.helmTemplate is " +
+								"defined",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "pull request specified with render",
+			update: kargoapi.GitRepoUpdate{
+				Render:      &kargoapi.KargoRenderPromotionMechanism{},
+				PullRequest: &kargoapi.PullRequestPromotionMechanism{},
+			},
+			assertions: func(update kargoapi.GitRepoUpdate, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "gitRepoUpdate",
+							BadValue: update,
+							Detail: "gitRepoUpdate.pullRequest may not be defined in " +
+								"conjunction with gitRepoUpdate.render",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "invalid commit message template",
+			update: kargoapi.GitRepoUpdate{
+				Kustomize:             &kargoapi.KustomizePromotionMechanism{},
+				CommitMessageTemplate: "{{ .Stage ",
+			},
+			assertions: func(_ kargoapi.GitRepoUpdate, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+				require.Equal(t, "gitRepoUpdate.commitMessageTemplate", errs[0].Field)
+				require.Contains(
+					t,
+					errs[0].Detail,
+					"gitRepoUpdate.commitMessageTemplate is not a valid template",
+				)
+			},
+		},
+
 		{
 			name: "valid",
 			update: kargoapi.GitRepoUpdate{
@@ -591,3 +1180,94 @@ func TestValidateHelmPromotionMechanism(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateYAMLUpdate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		update     kargoapi.YAMLUpdate
+		assertions func(field.ErrorList)
+	}{
+		{
+			name: "image not specified",
+			update: kargoapi.YAMLUpdate{
+				Value: kargoapi.YAMLUpdateValueTypeDigest,
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeRequired,
+							Field:    "yamlUpdate.image",
+							BadValue: "",
+							Detail: `yamlUpdate.image is required when yamlUpdate.value ` +
+								`is "Digest"`,
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "git repo URL not specified",
+			update: kargoapi.YAMLUpdate{
+				Value: kargoapi.YAMLUpdateValueTypeCommitID,
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeRequired,
+							Field:    "yamlUpdate.gitRepoURL",
+							BadValue: "",
+							Detail: `yamlUpdate.gitRepoURL is required when ` +
+								`yamlUpdate.value is "CommitID"`,
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "chart not fully specified",
+			update: kargoapi.YAMLUpdate{
+				Value:            kargoapi.YAMLUpdateValueTypeChartVersion,
+				ChartRegistryURL: "fake-registry",
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeRequired,
+							Field:    "yamlUpdate",
+							BadValue: "",
+							Detail: `yamlUpdate.chartRegistryURL and yamlUpdate.chartName ` +
+								`are required when yamlUpdate.value is "ChartVersion"`,
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "valid",
+			update: kargoapi.YAMLUpdate{
+				Value: kargoapi.YAMLUpdateValueTypeTag,
+				Image: "fake-image",
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				w.validateYAMLUpdate(field.NewPath("yamlUpdate"), testCase.update),
+			)
+		})
+	}
+}
@@ -3,9 +3,11 @@ package warehouse
 import (
 	"context"
 	"fmt"
+	"regexp"
 
 	"github.com/Masterminds/semver"
 	"github.com/argoproj-labs/argocd-image-updater/pkg/image"
+	"github.com/gobwas/glob"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -14,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/images"
 	libWebhook "github.com/akuity/kargo/internal/webhook"
 )
 
@@ -127,6 +130,7 @@ func (w *webhook) validateSub(
 	var repoTypes int
 	if sub.Git != nil {
 		repoTypes++
+		errs = append(errs, w.validateGitSub(f.Child("git"), *sub.Git)...)
 	}
 	if sub.Image != nil {
 		repoTypes++
@@ -154,6 +158,37 @@ func (w *webhook) validateSub(
 	return errs
 }
 
+func (w *webhook) validateGitSub(
+	f *field.Path,
+	sub kargoapi.GitSubscription,
+) field.ErrorList {
+	var errs field.ErrorList
+	if err := validateSemverConstraint(
+		f.Child("semverConstraint"),
+		sub.SemverConstraint,
+	); err != nil {
+		errs = field.ErrorList{err}
+	}
+	if sub.AllowTags != "" {
+		if _, err := regexp.Compile(sub.AllowTags); err != nil {
+			errs = append(errs, field.Invalid(f.Child("allowTags"), sub.AllowTags, ""))
+		}
+	}
+	errs = append(errs, validateGlobs(f.Child("includePaths"), sub.IncludePaths)...)
+	errs = append(errs, validateGlobs(f.Child("excludePaths"), sub.ExcludePaths)...)
+	return errs
+}
+
+func validateGlobs(f *field.Path, patterns []string) field.ErrorList {
+	var errs field.ErrorList
+	for i, pattern := range patterns {
+		if _, err := glob.Compile(pattern, '/'); err != nil {
+			errs = append(errs, field.Invalid(f.Index(i), pattern, ""))
+		}
+	}
+	return errs
+}
+
 func (w *webhook) validateImageSub(
 	f *field.Path,
 	sub kargoapi.ImageSubscription,
@@ -170,9 +205,62 @@ func (w *webhook) validateImageSub(
 			errs = append(errs, field.Invalid(f.Child("platform"), sub.Platform, ""))
 		}
 	}
+	if sub.Verification != nil {
+		errs = append(
+			errs,
+			validateImageVerification(f.Child("verification"), *sub.Verification)...,
+		)
+	}
 	return errs
 }
 
+func validateImageVerification(
+	f *field.Path,
+	verification kargoapi.ImageVerification,
+) field.ErrorList {
+	if (verification.PublicKey == "") == (verification.Keyless == nil) {
+		return field.ErrorList{
+			field.Invalid(
+				f,
+				verification,
+				"exactly one of publicKey or keyless must be specified",
+			),
+		}
+	}
+	if verification.PublicKey != "" {
+		if _, err := images.ParsePublicKey(verification.PublicKey); err != nil {
+			return field.ErrorList{
+				field.Invalid(f.Child("publicKey"), verification.PublicKey, err.Error()),
+			}
+		}
+		return nil
+	}
+	certs, err := images.ParseCertificates(
+		verification.Keyless.CertificateAuthorities,
+	)
+	if err != nil || len(certs) == 0 {
+		return field.ErrorList{
+			field.Invalid(
+				f.Child("keyless", "certificateAuthorities"),
+				verification.Keyless.CertificateAuthorities,
+				"must be a PEM-encoded bundle of one or more certificates",
+			),
+		}
+	}
+	if _, err = images.ParsePublicKey(
+		verification.Keyless.TransparencyLogPublicKey,
+	); err != nil {
+		return field.ErrorList{
+			field.Invalid(
+				f.Child("keyless", "transparencyLogPublicKey"),
+				verification.Keyless.TransparencyLogPublicKey,
+				err.Error(),
+			),
+		}
+	}
+	return nil
+}
+
 func (w *webhook) validateChartSub(
 	f *field.Path,
 	sub kargoapi.ChartSubscription,
@@ -2,8 +2,16 @@ package warehouse
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -424,6 +432,77 @@ func TestValidateSub(t *testing.T) {
 	}
 }
 
+func TestValidateGitSub(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sub        kargoapi.GitSubscription
+		assertions func(field.ErrorList)
+	}{
+		{
+			name: "invalid",
+			sub: kargoapi.GitSubscription{
+				SemverConstraint: "bogus",
+				AllowTags:        "(",
+				IncludePaths:     []string{"apps/**", "["},
+				ExcludePaths:     []string{"docs/["},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "git.semverConstraint",
+							BadValue: "bogus",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "git.allowTags",
+							BadValue: "(",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "git.includePaths[1]",
+							BadValue: "[",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "git.excludePaths[0]",
+							BadValue: "docs/[",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "valid",
+			sub: kargoapi.GitSubscription{
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategySemVer,
+				SemverConstraint:        "^1.0.0",
+				AllowTags:               `^v\d+`,
+				IncludePaths:            []string{"apps/**"},
+				ExcludePaths:            []string{"**/*.md"},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				w.validateGitSub(
+					field.NewPath("git"),
+					testCase.sub,
+				),
+			)
+		})
+	}
+}
+
 func TestValidateImageSub(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -476,6 +555,154 @@ func TestValidateImageSub(t *testing.T) {
 	}
 }
 
+func TestValidateImageVerification(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	publicKey := string(
+		pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: keyDER}),
+	)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "fake-ca"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		IsCA:         true,
+	}
+	certDER, err := x509.CreateCertificate(
+		rand.Reader,
+		template,
+		template,
+		&key.PublicKey,
+		key,
+	)
+	require.NoError(t, err)
+	ca := string(
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+	)
+
+	testCases := []struct {
+		name         string
+		verification kargoapi.ImageVerification
+		assertions   func(field.ErrorList)
+	}{
+		{
+			name:         "neither public key nor keyless",
+			verification: kargoapi.ImageVerification{},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "verification", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "exactly one of")
+			},
+		},
+
+		{
+			name: "both public key and keyless",
+			verification: kargoapi.ImageVerification{
+				PublicKey: publicKey,
+				Keyless: &kargoapi.KeylessVerification{
+					Identity:                 "fake-identity",
+					Issuer:                   "fake-issuer",
+					CertificateAuthorities:   ca,
+					TransparencyLogPublicKey: publicKey,
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "verification", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "exactly one of")
+			},
+		},
+
+		{
+			name: "invalid public key",
+			verification: kargoapi.ImageVerification{
+				PublicKey: "bogus",
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "verification.publicKey", errs[0].Field)
+			},
+		},
+
+		{
+			name: "invalid certificate authorities",
+			verification: kargoapi.ImageVerification{
+				Keyless: &kargoapi.KeylessVerification{
+					Identity:                 "fake-identity",
+					Issuer:                   "fake-issuer",
+					CertificateAuthorities:   "bogus",
+					TransparencyLogPublicKey: publicKey,
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(
+					t,
+					"verification.keyless.certificateAuthorities",
+					errs[0].Field,
+				)
+			},
+		},
+
+		{
+			name: "invalid transparency log public key",
+			verification: kargoapi.ImageVerification{
+				Keyless: &kargoapi.KeylessVerification{
+					Identity:                 "fake-identity",
+					Issuer:                   "fake-issuer",
+					CertificateAuthorities:   ca,
+					TransparencyLogPublicKey: "bogus",
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(
+					t,
+					"verification.keyless.transparencyLogPublicKey",
+					errs[0].Field,
+				)
+			},
+		},
+
+		{
+			name: "valid public key",
+			verification: kargoapi.ImageVerification{
+				PublicKey: publicKey,
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
+		{
+			name: "valid keyless",
+			verification: kargoapi.ImageVerification{
+				Keyless: &kargoapi.KeylessVerification{
+					Identity:                 "fake-identity",
+					Issuer:                   "fake-issuer",
+					CertificateAuthorities:   ca,
+					TransparencyLogPublicKey: publicKey,
+				},
+			},
+			assertions: func(errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				validateImageVerification(
+					field.NewPath("verification"),
+					testCase.verification,
+				),
+			)
+		})
+	}
+}
+
 func TestValidateChartSub(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -74,9 +74,15 @@ const (
 	// KargoServicePromoteStageProcedure is the fully-qualified name of the KargoService's PromoteStage
 	// RPC.
 	KargoServicePromoteStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/PromoteStage"
+	// KargoServiceRollbackStageProcedure is the fully-qualified name of the KargoService's
+	// RollbackStage RPC.
+	KargoServiceRollbackStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/RollbackStage"
 	// KargoServicePromoteSubscribersProcedure is the fully-qualified name of the KargoService's
 	// PromoteSubscribers RPC.
 	KargoServicePromoteSubscribersProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/PromoteSubscribers"
+	// KargoServicePreviewPromotionProcedure is the fully-qualified name of the KargoService's
+	// PreviewPromotion RPC.
+	KargoServicePreviewPromotionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/PreviewPromotion"
 	// KargoServiceRefreshStageProcedure is the fully-qualified name of the KargoService's RefreshStage
 	// RPC.
 	KargoServiceRefreshStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/RefreshStage"
@@ -92,6 +98,12 @@ const (
 	// KargoServiceWatchPromotionProcedure is the fully-qualified name of the KargoService's
 	// WatchPromotion RPC.
 	KargoServiceWatchPromotionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/WatchPromotion"
+	// KargoServiceAbortPromotionProcedure is the fully-qualified name of the KargoService's
+	// AbortPromotion RPC.
+	KargoServiceAbortPromotionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/AbortPromotion"
+	// KargoServiceApprovePromotionProcedure is the fully-qualified name of the KargoService's
+	// ApprovePromotion RPC.
+	KargoServiceApprovePromotionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ApprovePromotion"
 	// KargoServiceSetAutoPromotionForStageProcedure is the fully-qualified name of the KargoService's
 	// SetAutoPromotionForStage RPC.
 	KargoServiceSetAutoPromotionForStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/SetAutoPromotionForStage"
@@ -161,13 +173,17 @@ type KargoServiceClient interface {
 	UpdateStage(context.Context, *connect.Request[v1alpha1.UpdateStageRequest]) (*connect.Response[v1alpha1.UpdateStageResponse], error)
 	DeleteStage(context.Context, *connect.Request[v1alpha1.DeleteStageRequest]) (*connect.Response[v1alpha1.DeleteStageResponse], error)
 	PromoteStage(context.Context, *connect.Request[v1alpha1.PromoteStageRequest]) (*connect.Response[v1alpha1.PromoteStageResponse], error)
+	RollbackStage(context.Context, *connect.Request[v1alpha1.RollbackStageRequest]) (*connect.Response[v1alpha1.RollbackStageResponse], error)
 	PromoteSubscribers(context.Context, *connect.Request[v1alpha1.PromoteSubscribersRequest]) (*connect.Response[v1alpha1.PromoteSubscribersResponse], error)
+	PreviewPromotion(context.Context, *connect.Request[v1alpha1.PreviewPromotionRequest]) (*connect.Response[v1alpha1.PreviewPromotionResponse], error)
 	RefreshStage(context.Context, *connect.Request[v1alpha1.RefreshStageRequest]) (*connect.Response[v1alpha1.RefreshStageResponse], error)
 	// Promotion APIs
 	ListPromotions(context.Context, *connect.Request[v1alpha1.ListPromotionsRequest]) (*connect.Response[v1alpha1.ListPromotionsResponse], error)
 	WatchPromotions(context.Context, *connect.Request[v1alpha1.WatchPromotionsRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchPromotionsResponse], error)
 	GetPromotion(context.Context, *connect.Request[v1alpha1.GetPromotionRequest]) (*connect.Response[v1alpha1.GetPromotionResponse], error)
 	WatchPromotion(context.Context, *connect.Request[v1alpha1.WatchPromotionRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchPromotionResponse], error)
+	AbortPromotion(context.Context, *connect.Request[v1alpha1.AbortPromotionRequest]) (*connect.Response[v1alpha1.AbortPromotionResponse], error)
+	ApprovePromotion(context.Context, *connect.Request[v1alpha1.ApprovePromotionRequest]) (*connect.Response[v1alpha1.ApprovePromotionResponse], error)
 	SetAutoPromotionForStage(context.Context, *connect.Request[v1alpha1.SetAutoPromotionForStageRequest]) (*connect.Response[v1alpha1.SetAutoPromotionForStageResponse], error)
 	CreatePromotionPolicy(context.Context, *connect.Request[v1alpha1.CreatePromotionPolicyRequest]) (*connect.Response[v1alpha1.CreatePromotionPolicyResponse], error)
 	ListPromotionPolicies(context.Context, *connect.Request[v1alpha1.ListPromotionPoliciesRequest]) (*connect.Response[v1alpha1.ListPromotionPoliciesResponse], error)
@@ -271,11 +287,21 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+KargoServicePromoteStageProcedure,
 			opts...,
 		),
+		rollbackStage: connect.NewClient[v1alpha1.RollbackStageRequest, v1alpha1.RollbackStageResponse](
+			httpClient,
+			baseURL+KargoServiceRollbackStageProcedure,
+			opts...,
+		),
 		promoteSubscribers: connect.NewClient[v1alpha1.PromoteSubscribersRequest, v1alpha1.PromoteSubscribersResponse](
 			httpClient,
 			baseURL+KargoServicePromoteSubscribersProcedure,
 			opts...,
 		),
+		previewPromotion: connect.NewClient[v1alpha1.PreviewPromotionRequest, v1alpha1.PreviewPromotionResponse](
+			httpClient,
+			baseURL+KargoServicePreviewPromotionProcedure,
+			opts...,
+		),
 		refreshStage: connect.NewClient[v1alpha1.RefreshStageRequest, v1alpha1.RefreshStageResponse](
 			httpClient,
 			baseURL+KargoServiceRefreshStageProcedure,
@@ -301,6 +327,16 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+KargoServiceWatchPromotionProcedure,
 			opts...,
 		),
+		abortPromotion: connect.NewClient[v1alpha1.AbortPromotionRequest, v1alpha1.AbortPromotionResponse](
+			httpClient,
+			baseURL+KargoServiceAbortPromotionProcedure,
+			opts...,
+		),
+		approvePromotion: connect.NewClient[v1alpha1.ApprovePromotionRequest, v1alpha1.ApprovePromotionResponse](
+			httpClient,
+			baseURL+KargoServiceApprovePromotionProcedure,
+			opts...,
+		),
 		setAutoPromotionForStage: connect.NewClient[v1alpha1.SetAutoPromotionForStageRequest, v1alpha1.SetAutoPromotionForStageResponse](
 			httpClient,
 			baseURL+KargoServiceSetAutoPromotionForStageProcedure,
@@ -401,12 +437,16 @@ type kargoServiceClient struct {
 	updateStage              *connect.Client[v1alpha1.UpdateStageRequest, v1alpha1.UpdateStageResponse]
 	deleteStage              *connect.Client[v1alpha1.DeleteStageRequest, v1alpha1.DeleteStageResponse]
 	promoteStage             *connect.Client[v1alpha1.PromoteStageRequest, v1alpha1.PromoteStageResponse]
+	rollbackStage            *connect.Client[v1alpha1.RollbackStageRequest, v1alpha1.RollbackStageResponse]
 	promoteSubscribers       *connect.Client[v1alpha1.PromoteSubscribersRequest, v1alpha1.PromoteSubscribersResponse]
+	previewPromotion         *connect.Client[v1alpha1.PreviewPromotionRequest, v1alpha1.PreviewPromotionResponse]
 	refreshStage             *connect.Client[v1alpha1.RefreshStageRequest, v1alpha1.RefreshStageResponse]
 	listPromotions           *connect.Client[v1alpha1.ListPromotionsRequest, v1alpha1.ListPromotionsResponse]
 	watchPromotions          *connect.Client[v1alpha1.WatchPromotionsRequest, v1alpha1.WatchPromotionsResponse]
 	getPromotion             *connect.Client[v1alpha1.GetPromotionRequest, v1alpha1.GetPromotionResponse]
 	watchPromotion           *connect.Client[v1alpha1.WatchPromotionRequest, v1alpha1.WatchPromotionResponse]
+	abortPromotion           *connect.Client[v1alpha1.AbortPromotionRequest, v1alpha1.AbortPromotionResponse]
+	approvePromotion         *connect.Client[v1alpha1.ApprovePromotionRequest, v1alpha1.ApprovePromotionResponse]
 	setAutoPromotionForStage *connect.Client[v1alpha1.SetAutoPromotionForStageRequest, v1alpha1.SetAutoPromotionForStageResponse]
 	createPromotionPolicy    *connect.Client[v1alpha1.CreatePromotionPolicyRequest, v1alpha1.CreatePromotionPolicyResponse]
 	listPromotionPolicies    *connect.Client[v1alpha1.ListPromotionPoliciesRequest, v1alpha1.ListPromotionPoliciesResponse]
@@ -501,11 +541,21 @@ func (c *kargoServiceClient) PromoteStage(ctx context.Context, req *connect.Requ
 	return c.promoteStage.CallUnary(ctx, req)
 }
 
+// RollbackStage calls akuity.io.kargo.service.v1alpha1.KargoService.RollbackStage.
+func (c *kargoServiceClient) RollbackStage(ctx context.Context, req *connect.Request[v1alpha1.RollbackStageRequest]) (*connect.Response[v1alpha1.RollbackStageResponse], error) {
+	return c.rollbackStage.CallUnary(ctx, req)
+}
+
 // PromoteSubscribers calls akuity.io.kargo.service.v1alpha1.KargoService.PromoteSubscribers.
 func (c *kargoServiceClient) PromoteSubscribers(ctx context.Context, req *connect.Request[v1alpha1.PromoteSubscribersRequest]) (*connect.Response[v1alpha1.PromoteSubscribersResponse], error) {
 	return c.promoteSubscribers.CallUnary(ctx, req)
 }
 
+// PreviewPromotion calls akuity.io.kargo.service.v1alpha1.KargoService.PreviewPromotion.
+func (c *kargoServiceClient) PreviewPromotion(ctx context.Context, req *connect.Request[v1alpha1.PreviewPromotionRequest]) (*connect.Response[v1alpha1.PreviewPromotionResponse], error) {
+	return c.previewPromotion.CallUnary(ctx, req)
+}
+
 // RefreshStage calls akuity.io.kargo.service.v1alpha1.KargoService.RefreshStage.
 func (c *kargoServiceClient) RefreshStage(ctx context.Context, req *connect.Request[v1alpha1.RefreshStageRequest]) (*connect.Response[v1alpha1.RefreshStageResponse], error) {
 	return c.refreshStage.CallUnary(ctx, req)
@@ -531,6 +581,16 @@ func (c *kargoServiceClient) WatchPromotion(ctx context.Context, req *connect.Re
 	return c.watchPromotion.CallServerStream(ctx, req)
 }
 
+// AbortPromotion calls akuity.io.kargo.service.v1alpha1.KargoService.AbortPromotion.
+func (c *kargoServiceClient) AbortPromotion(ctx context.Context, req *connect.Request[v1alpha1.AbortPromotionRequest]) (*connect.Response[v1alpha1.AbortPromotionResponse], error) {
+	return c.abortPromotion.CallUnary(ctx, req)
+}
+
+// ApprovePromotion calls akuity.io.kargo.service.v1alpha1.KargoService.ApprovePromotion.
+func (c *kargoServiceClient) ApprovePromotion(ctx context.Context, req *connect.Request[v1alpha1.ApprovePromotionRequest]) (*connect.Response[v1alpha1.ApprovePromotionResponse], error) {
+	return c.approvePromotion.CallUnary(ctx, req)
+}
+
 // SetAutoPromotionForStage calls
 // akuity.io.kargo.service.v1alpha1.KargoService.SetAutoPromotionForStage.
 func (c *kargoServiceClient) SetAutoPromotionForStage(ctx context.Context, req *connect.Request[v1alpha1.SetAutoPromotionForStageRequest]) (*connect.Response[v1alpha1.SetAutoPromotionForStageResponse], error) {
@@ -632,13 +692,17 @@ type KargoServiceHandler interface {
 	UpdateStage(context.Context, *connect.Request[v1alpha1.UpdateStageRequest]) (*connect.Response[v1alpha1.UpdateStageResponse], error)
 	DeleteStage(context.Context, *connect.Request[v1alpha1.DeleteStageRequest]) (*connect.Response[v1alpha1.DeleteStageResponse], error)
 	PromoteStage(context.Context, *connect.Request[v1alpha1.PromoteStageRequest]) (*connect.Response[v1alpha1.PromoteStageResponse], error)
+	RollbackStage(context.Context, *connect.Request[v1alpha1.RollbackStageRequest]) (*connect.Response[v1alpha1.RollbackStageResponse], error)
 	PromoteSubscribers(context.Context, *connect.Request[v1alpha1.PromoteSubscribersRequest]) (*connect.Response[v1alpha1.PromoteSubscribersResponse], error)
+	PreviewPromotion(context.Context, *connect.Request[v1alpha1.PreviewPromotionRequest]) (*connect.Response[v1alpha1.PreviewPromotionResponse], error)
 	RefreshStage(context.Context, *connect.Request[v1alpha1.RefreshStageRequest]) (*connect.Response[v1alpha1.RefreshStageResponse], error)
 	// Promotion APIs
 	ListPromotions(context.Context, *connect.Request[v1alpha1.ListPromotionsRequest]) (*connect.Response[v1alpha1.ListPromotionsResponse], error)
 	WatchPromotions(context.Context, *connect.Request[v1alpha1.WatchPromotionsRequest], *connect.ServerStream[v1alpha1.WatchPromotionsResponse]) error
 	GetPromotion(context.Context, *connect.Request[v1alpha1.GetPromotionRequest]) (*connect.Response[v1alpha1.GetPromotionResponse], error)
 	WatchPromotion(context.Context, *connect.Request[v1alpha1.WatchPromotionRequest], *connect.ServerStream[v1alpha1.WatchPromotionResponse]) error
+	AbortPromotion(context.Context, *connect.Request[v1alpha1.AbortPromotionRequest]) (*connect.Response[v1alpha1.AbortPromotionResponse], error)
+	ApprovePromotion(context.Context, *connect.Request[v1alpha1.ApprovePromotionRequest]) (*connect.Response[v1alpha1.ApprovePromotionResponse], error)
 	SetAutoPromotionForStage(context.Context, *connect.Request[v1alpha1.SetAutoPromotionForStageRequest]) (*connect.Response[v1alpha1.SetAutoPromotionForStageResponse], error)
 	CreatePromotionPolicy(context.Context, *connect.Request[v1alpha1.CreatePromotionPolicyRequest]) (*connect.Response[v1alpha1.CreatePromotionPolicyResponse], error)
 	ListPromotionPolicies(context.Context, *connect.Request[v1alpha1.ListPromotionPoliciesRequest]) (*connect.Response[v1alpha1.ListPromotionPoliciesResponse], error)
@@ -738,11 +802,21 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		svc.PromoteStage,
 		opts...,
 	)
+	kargoServiceRollbackStageHandler := connect.NewUnaryHandler(
+		KargoServiceRollbackStageProcedure,
+		svc.RollbackStage,
+		opts...,
+	)
 	kargoServicePromoteSubscribersHandler := connect.NewUnaryHandler(
 		KargoServicePromoteSubscribersProcedure,
 		svc.PromoteSubscribers,
 		opts...,
 	)
+	kargoServicePreviewPromotionHandler := connect.NewUnaryHandler(
+		KargoServicePreviewPromotionProcedure,
+		svc.PreviewPromotion,
+		opts...,
+	)
 	kargoServiceRefreshStageHandler := connect.NewUnaryHandler(
 		KargoServiceRefreshStageProcedure,
 		svc.RefreshStage,
@@ -768,6 +842,16 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		svc.WatchPromotion,
 		opts...,
 	)
+	kargoServiceAbortPromotionHandler := connect.NewUnaryHandler(
+		KargoServiceAbortPromotionProcedure,
+		svc.AbortPromotion,
+		opts...,
+	)
+	kargoServiceApprovePromotionHandler := connect.NewUnaryHandler(
+		KargoServiceApprovePromotionProcedure,
+		svc.ApprovePromotion,
+		opts...,
+	)
 	kargoServiceSetAutoPromotionForStageHandler := connect.NewUnaryHandler(
 		KargoServiceSetAutoPromotionForStageProcedure,
 		svc.SetAutoPromotionForStage,
@@ -880,8 +964,12 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceDeleteStageHandler.ServeHTTP(w, r)
 		case KargoServicePromoteStageProcedure:
 			kargoServicePromoteStageHandler.ServeHTTP(w, r)
+		case KargoServiceRollbackStageProcedure:
+			kargoServiceRollbackStageHandler.ServeHTTP(w, r)
 		case KargoServicePromoteSubscribersProcedure:
 			kargoServicePromoteSubscribersHandler.ServeHTTP(w, r)
+		case KargoServicePreviewPromotionProcedure:
+			kargoServicePreviewPromotionHandler.ServeHTTP(w, r)
 		case KargoServiceRefreshStageProcedure:
 			kargoServiceRefreshStageHandler.ServeHTTP(w, r)
 		case KargoServiceListPromotionsProcedure:
@@ -892,6 +980,10 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceGetPromotionHandler.ServeHTTP(w, r)
 		case KargoServiceWatchPromotionProcedure:
 			kargoServiceWatchPromotionHandler.ServeHTTP(w, r)
+		case KargoServiceAbortPromotionProcedure:
+			kargoServiceAbortPromotionHandler.ServeHTTP(w, r)
+		case KargoServiceApprovePromotionProcedure:
+			kargoServiceApprovePromotionHandler.ServeHTTP(w, r)
 		case KargoServiceSetAutoPromotionForStageProcedure:
 			kargoServiceSetAutoPromotionForStageHandler.ServeHTTP(w, r)
 		case KargoServiceCreatePromotionPolicyProcedure:
@@ -993,10 +1085,18 @@ func (UnimplementedKargoServiceHandler) PromoteStage(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.PromoteStage is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) RollbackStage(context.Context, *connect.Request[v1alpha1.RollbackStageRequest]) (*connect.Response[v1alpha1.RollbackStageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.RollbackStage is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) PromoteSubscribers(context.Context, *connect.Request[v1alpha1.PromoteSubscribersRequest]) (*connect.Response[v1alpha1.PromoteSubscribersResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.PromoteSubscribers is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) PreviewPromotion(context.Context, *connect.Request[v1alpha1.PreviewPromotionRequest]) (*connect.Response[v1alpha1.PreviewPromotionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.PreviewPromotion is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) RefreshStage(context.Context, *connect.Request[v1alpha1.RefreshStageRequest]) (*connect.Response[v1alpha1.RefreshStageResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.RefreshStage is not implemented"))
 }
@@ -1017,6 +1117,14 @@ func (UnimplementedKargoServiceHandler) WatchPromotion(context.Context, *connect
 	return connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.WatchPromotion is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) AbortPromotion(context.Context, *connect.Request[v1alpha1.AbortPromotionRequest]) (*connect.Response[v1alpha1.AbortPromotionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.AbortPromotion is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) ApprovePromotion(context.Context, *connect.Request[v1alpha1.ApprovePromotionRequest]) (*connect.Response[v1alpha1.ApprovePromotionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ApprovePromotion is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) SetAutoPromotionForStage(context.Context, *connect.Request[v1alpha1.SetAutoPromotionForStageRequest]) (*connect.Response[v1alpha1.SetAutoPromotionForStageResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.SetAutoPromotionForStage is not implemented"))
 }
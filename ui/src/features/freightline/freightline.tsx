@@ -198,6 +198,12 @@ const CommitInfo = ({ commit }: { commit: GitCommit }) => (
     </div>
     <div>Branch:</div>
     <div>{commit.branch}</div>
+    {commit.tag && (
+      <>
+        <div>Tag:</div>
+        <div>{commit.tag}</div>
+      </>
+    )}
     {commit.author && (
       <>
         <div>Author:</div>
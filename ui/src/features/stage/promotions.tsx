@@ -1,9 +1,11 @@
 import { createPromiseClient } from '@bufbuild/connect';
 import {
+  faBan,
   faCircleCheck,
   faCircleExclamation,
   faCircleNotch,
-  faCircleQuestion
+  faCircleQuestion,
+  faForward
 } from '@fortawesome/free-solid-svg-icons';
 import { FontAwesomeIcon } from '@fortawesome/react-fontawesome';
 import { useQuery, useQueryClient } from '@tanstack/react-query';
@@ -112,6 +114,18 @@ export const Promotions = () => {
                 />
               </Popover>
             );
+          case 'Aborted':
+            return (
+              <Popover content={promotion.status.error} title='Aborted' placement='right'>
+                <FontAwesomeIcon color='#aaa' icon={faBan} size='lg' />
+              </Popover>
+            );
+          case 'Superseded':
+            return (
+              <Tooltip title={`Superseded by ${promotion.status.supersededBy}`} placement='right'>
+                <FontAwesomeIcon color='#aaa' icon={faForward} size='lg' />
+              </Tooltip>
+            );
           case 'Pending':
           case 'Running':
             return (
@@ -61,6 +61,17 @@ export const Subscriptions = (props: {
                   </Typography.Link>
                 </Descriptions.Item>
                 <Descriptions.Item label='Branch'>{gitRepo.branch}</Descriptions.Item>
+                {gitRepo.commitSelectionStrategy &&
+                  gitRepo.commitSelectionStrategy !== 'NewestFromBranch' && (
+                    <Descriptions.Item label='Commit Selection Strategy'>
+                      {gitRepo.commitSelectionStrategy}
+                    </Descriptions.Item>
+                  )}
+                {gitRepo.semverConstraint && (
+                  <Descriptions.Item label='Semver Constraint'>
+                    {gitRepo.semverConstraint}
+                  </Descriptions.Item>
+                )}
               </Descriptions>
             ))}
           </Space>
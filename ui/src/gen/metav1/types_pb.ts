@@ -6,6 +6,73 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.metav1.Condition
+ */
+export class Condition extends Message<Condition> {
+  /**
+   * @generated from field: optional string type = 1;
+   */
+  type?: string;
+
+  /**
+   * @generated from field: optional string status = 2;
+   */
+  status?: string;
+
+  /**
+   * @generated from field: optional int64 observed_generation = 3;
+   */
+  observedGeneration?: bigint;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp last_transition_time = 4;
+   */
+  lastTransitionTime?: Timestamp;
+
+  /**
+   * @generated from field: optional string reason = 5;
+   */
+  reason?: string;
+
+  /**
+   * @generated from field: optional string message = 6;
+   */
+  message?: string;
+
+  constructor(data?: PartialMessage<Condition>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.metav1.Condition";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "observed_generation", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 4, name: "last_transition_time", kind: "message", T: Timestamp, opt: true },
+    { no: 5, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Condition {
+    return new Condition().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Condition {
+    return new Condition().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Condition {
+    return new Condition().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Condition | PlainMessage<Condition> | undefined, b: Condition | PlainMessage<Condition> | undefined): boolean {
+    return proto3.util.equals(Condition, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.metav1.FieldsV1
  */
@@ -5,7 +5,7 @@
 
 import { createQueryService } from "@bufbuild/connect-query";
 import { MethodKind } from "@bufbuild/protobuf";
-import { AdminLoginRequest, AdminLoginResponse, CreateOrUpdateResourceRequest, CreateOrUpdateResourceResponse, CreateProjectRequest, CreateProjectResponse, CreatePromotionPolicyRequest, CreatePromotionPolicyResponse, CreateResourceRequest, CreateResourceResponse, CreateStageRequest, CreateStageResponse, CreateWarehouseRequest, CreateWarehouseResponse, DeleteProjectRequest, DeleteProjectResponse, DeletePromotionPolicyRequest, DeletePromotionPolicyResponse, DeleteResourceRequest, DeleteResourceResponse, DeleteStageRequest, DeleteStageResponse, DeleteWarehouseRequest, DeleteWarehouseResponse, GetConfigRequest, GetConfigResponse, GetPromotionPolicyRequest, GetPromotionPolicyResponse, GetPromotionRequest, GetPromotionResponse, GetPublicConfigRequest, GetPublicConfigResponse, GetStageRequest, GetStageResponse, GetVersionInfoRequest, GetVersionInfoResponse, GetWarehouseRequest, GetWarehouseResponse, ListProjectsRequest, ListProjectsResponse, ListPromotionPoliciesRequest, ListPromotionPoliciesResponse, ListPromotionsRequest, ListPromotionsResponse, ListStagesRequest, ListStagesResponse, ListWarehousesRequest, ListWarehousesResponse, PromoteStageRequest, PromoteStageResponse, PromoteSubscribersRequest, PromoteSubscribersResponse, QueryFreightRequest, QueryFreightResponse, RefreshStageRequest, RefreshStageResponse, RefreshWarehouseRequest, RefreshWarehouseResponse, SetAutoPromotionForStageRequest, SetAutoPromotionForStageResponse, UpdatePromotionPolicyRequest, UpdatePromotionPolicyResponse, UpdateResourceRequest, UpdateResourceResponse, UpdateStageRequest, UpdateStageResponse, UpdateWarehouseRequest, UpdateWarehouseResponse } from "./service_pb.js";
+import { AbortPromotionRequest, AbortPromotionResponse, AdminLoginRequest, AdminLoginResponse, ApprovePromotionRequest, ApprovePromotionResponse, CreateOrUpdateResourceRequest, CreateOrUpdateResourceResponse, CreateProjectRequest, CreateProjectResponse, CreatePromotionPolicyRequest, CreatePromotionPolicyResponse, CreateResourceRequest, CreateResourceResponse, CreateStageRequest, CreateStageResponse, CreateWarehouseRequest, CreateWarehouseResponse, DeleteProjectRequest, DeleteProjectResponse, DeletePromotionPolicyRequest, DeletePromotionPolicyResponse, DeleteResourceRequest, DeleteResourceResponse, DeleteStageRequest, DeleteStageResponse, DeleteWarehouseRequest, DeleteWarehouseResponse, GetConfigRequest, GetConfigResponse, GetPromotionPolicyRequest, GetPromotionPolicyResponse, GetPromotionRequest, GetPromotionResponse, GetPublicConfigRequest, GetPublicConfigResponse, GetStageRequest, GetStageResponse, GetVersionInfoRequest, GetVersionInfoResponse, GetWarehouseRequest, GetWarehouseResponse, ListProjectsRequest, ListProjectsResponse, ListPromotionPoliciesRequest, ListPromotionPoliciesResponse, ListPromotionsRequest, ListPromotionsResponse, ListStagesRequest, ListStagesResponse, ListWarehousesRequest, ListWarehousesResponse, PreviewPromotionRequest, PreviewPromotionResponse, PromoteStageRequest, PromoteStageResponse, PromoteSubscribersRequest, PromoteSubscribersResponse, QueryFreightRequest, QueryFreightResponse, RefreshStageRequest, RefreshStageResponse, RefreshWarehouseRequest, RefreshWarehouseResponse, RollbackStageRequest, RollbackStageResponse, SetAutoPromotionForStageRequest, SetAutoPromotionForStageResponse, UpdatePromotionPolicyRequest, UpdatePromotionPolicyResponse, UpdateResourceRequest, UpdateResourceResponse, UpdateStageRequest, UpdateStageResponse, UpdateWarehouseRequest, UpdateWarehouseResponse } from "./service_pb.js";
 
 export const typeName = "akuity.io.kargo.service.v1alpha1.KargoService";
 
@@ -250,6 +250,23 @@ export const promoteStage = createQueryService({
   },
 }).promoteStage;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.RollbackStage
+ */
+export const rollbackStage = createQueryService({
+  service: {
+    methods: {
+      rollbackStage: {
+        name: "RollbackStage",
+        kind: MethodKind.Unary,
+        I: RollbackStageRequest,
+        O: RollbackStageResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).rollbackStage;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.PromoteSubscribers
  */
@@ -267,6 +284,23 @@ export const promoteSubscribers = createQueryService({
   },
 }).promoteSubscribers;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.PreviewPromotion
+ */
+export const previewPromotion = createQueryService({
+  service: {
+    methods: {
+      previewPromotion: {
+        name: "PreviewPromotion",
+        kind: MethodKind.Unary,
+        I: PreviewPromotionRequest,
+        O: PreviewPromotionResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).previewPromotion;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.RefreshStage
  */
@@ -320,6 +354,40 @@ export const getPromotion = createQueryService({
   },
 }).getPromotion;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.AbortPromotion
+ */
+export const abortPromotion = createQueryService({
+  service: {
+    methods: {
+      abortPromotion: {
+        name: "AbortPromotion",
+        kind: MethodKind.Unary,
+        I: AbortPromotionRequest,
+        O: AbortPromotionResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).abortPromotion;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ApprovePromotion
+ */
+export const approvePromotion = createQueryService({
+  service: {
+    methods: {
+      approvePromotion: {
+        name: "ApprovePromotion",
+        kind: MethodKind.Unary,
+        I: ApprovePromotionRequest,
+        O: ApprovePromotionResponse,
+      },
+    },
+    typeName: "akuity.io.kargo.service.v1alpha1.KargoService",
+  },
+}).approvePromotion;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.SetAutoPromotionForStage
  */
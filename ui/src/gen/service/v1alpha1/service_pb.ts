@@ -1633,6 +1633,105 @@ export class PromoteStageResponse extends Message<PromoteStageResponse> {
   }
 }
 
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.RollbackStageRequest
+ */
+export class RollbackStageRequest extends Message<RollbackStageRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from oneof akuity.io.kargo.service.v1alpha1.RollbackStageRequest.target
+   */
+  target: {
+    /**
+     * @generated from field: string freight = 3;
+     */
+    value: string;
+    case: "freight";
+  } | {
+    /**
+     * @generated from field: int32 steps = 4;
+     */
+    value: number;
+    case: "steps";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<RollbackStageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RollbackStageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "target" },
+    { no: 4, name: "steps", kind: "scalar", T: 5 /* ScalarType.INT32 */, oneof: "target" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RollbackStageRequest {
+    return new RollbackStageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RollbackStageRequest {
+    return new RollbackStageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RollbackStageRequest {
+    return new RollbackStageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RollbackStageRequest | PlainMessage<RollbackStageRequest> | undefined, b: RollbackStageRequest | PlainMessage<RollbackStageRequest> | undefined): boolean {
+    return proto3.util.equals(RollbackStageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.RollbackStageResponse
+ */
+export class RollbackStageResponse extends Message<RollbackStageResponse> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.Promotion promotion = 1;
+   */
+  promotion?: Promotion;
+
+  constructor(data?: PartialMessage<RollbackStageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.RollbackStageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "promotion", kind: "message", T: Promotion },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RollbackStageResponse {
+    return new RollbackStageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RollbackStageResponse {
+    return new RollbackStageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RollbackStageResponse {
+    return new RollbackStageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RollbackStageResponse | PlainMessage<RollbackStageResponse> | undefined, b: RollbackStageResponse | PlainMessage<RollbackStageResponse> | undefined): boolean {
+    return proto3.util.equals(RollbackStageResponse, a, b);
+  }
+}
+
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.PromoteSubscribersRequest
  */
@@ -1719,6 +1818,208 @@ export class PromoteSubscribersResponse extends Message<PromoteSubscribersRespon
   }
 }
 
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.PreviewPromotionRequest
+ */
+export class PreviewPromotionRequest extends Message<PreviewPromotionRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string stage = 2;
+   */
+  stage = "";
+
+  /**
+   * @generated from field: string freight = 3;
+   */
+  freight = "";
+
+  constructor(data?: PartialMessage<PreviewPromotionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.PreviewPromotionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PreviewPromotionRequest {
+    return new PreviewPromotionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PreviewPromotionRequest {
+    return new PreviewPromotionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PreviewPromotionRequest {
+    return new PreviewPromotionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PreviewPromotionRequest | PlainMessage<PreviewPromotionRequest> | undefined, b: PreviewPromotionRequest | PlainMessage<PreviewPromotionRequest> | undefined): boolean {
+    return proto3.util.equals(PreviewPromotionRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.PreviewPromotionResponse
+ */
+export class PreviewPromotionResponse extends Message<PreviewPromotionResponse> {
+  /**
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.GitRepoDiff git_repo_diffs = 1;
+   */
+  gitRepoDiffs: GitRepoDiff[] = [];
+
+  /**
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.ArgoCDAppDiff argo_cd_app_diffs = 2;
+   */
+  argoCdAppDiffs: ArgoCDAppDiff[] = [];
+
+  /**
+   * @generated from field: repeated string warnings = 3;
+   */
+  warnings: string[] = [];
+
+  constructor(data?: PartialMessage<PreviewPromotionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.PreviewPromotionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "git_repo_diffs", kind: "message", T: GitRepoDiff, repeated: true },
+    { no: 2, name: "argo_cd_app_diffs", kind: "message", T: ArgoCDAppDiff, repeated: true },
+    { no: 3, name: "warnings", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PreviewPromotionResponse {
+    return new PreviewPromotionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PreviewPromotionResponse {
+    return new PreviewPromotionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PreviewPromotionResponse {
+    return new PreviewPromotionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PreviewPromotionResponse | PlainMessage<PreviewPromotionResponse> | undefined, b: PreviewPromotionResponse | PlainMessage<PreviewPromotionResponse> | undefined): boolean {
+    return proto3.util.equals(PreviewPromotionResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.GitRepoDiff
+ */
+export class GitRepoDiff extends Message<GitRepoDiff> {
+  /**
+   * @generated from field: string repo_url = 1;
+   */
+  repoUrl = "";
+
+  /**
+   * @generated from field: string branch = 2;
+   */
+  branch = "";
+
+  /**
+   * @generated from field: string commit_message = 3;
+   */
+  commitMessage = "";
+
+  /**
+   * @generated from field: string diff = 4;
+   */
+  diff = "";
+
+  constructor(data?: PartialMessage<GitRepoDiff>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.GitRepoDiff";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "commit_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "diff", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitRepoDiff {
+    return new GitRepoDiff().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GitRepoDiff {
+    return new GitRepoDiff().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GitRepoDiff {
+    return new GitRepoDiff().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GitRepoDiff | PlainMessage<GitRepoDiff> | undefined, b: GitRepoDiff | PlainMessage<GitRepoDiff> | undefined): boolean {
+    return proto3.util.equals(GitRepoDiff, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ArgoCDAppDiff
+ */
+export class ArgoCDAppDiff extends Message<ArgoCDAppDiff> {
+  /**
+   * @generated from field: string namespace = 1;
+   */
+  namespace = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string diff = 3;
+   */
+  diff = "";
+
+  constructor(data?: PartialMessage<ArgoCDAppDiff>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ArgoCDAppDiff";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "diff", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArgoCDAppDiff {
+    return new ArgoCDAppDiff().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArgoCDAppDiff {
+    return new ArgoCDAppDiff().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArgoCDAppDiff {
+    return new ArgoCDAppDiff().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArgoCDAppDiff | PlainMessage<ArgoCDAppDiff> | undefined, b: ArgoCDAppDiff | PlainMessage<ArgoCDAppDiff> | undefined): boolean {
+    return proto3.util.equals(ArgoCDAppDiff, a, b);
+  }
+}
+
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.RefreshStageRequest
  */
@@ -2186,6 +2487,158 @@ export class WatchPromotionResponse extends Message<WatchPromotionResponse> {
   }
 }
 
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.AbortPromotionRequest
+ */
+export class AbortPromotionRequest extends Message<AbortPromotionRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<AbortPromotionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.AbortPromotionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AbortPromotionRequest {
+    return new AbortPromotionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AbortPromotionRequest {
+    return new AbortPromotionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AbortPromotionRequest {
+    return new AbortPromotionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AbortPromotionRequest | PlainMessage<AbortPromotionRequest> | undefined, b: AbortPromotionRequest | PlainMessage<AbortPromotionRequest> | undefined): boolean {
+    return proto3.util.equals(AbortPromotionRequest, a, b);
+  }
+}
+
+/**
+ * explicitly empty 
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.AbortPromotionResponse
+ */
+export class AbortPromotionResponse extends Message<AbortPromotionResponse> {
+  constructor(data?: PartialMessage<AbortPromotionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.AbortPromotionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AbortPromotionResponse {
+    return new AbortPromotionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AbortPromotionResponse {
+    return new AbortPromotionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AbortPromotionResponse {
+    return new AbortPromotionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AbortPromotionResponse | PlainMessage<AbortPromotionResponse> | undefined, b: AbortPromotionResponse | PlainMessage<AbortPromotionResponse> | undefined): boolean {
+    return proto3.util.equals(AbortPromotionResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ApprovePromotionRequest
+ */
+export class ApprovePromotionRequest extends Message<ApprovePromotionRequest> {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<ApprovePromotionRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ApprovePromotionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "project", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApprovePromotionRequest {
+    return new ApprovePromotionRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApprovePromotionRequest {
+    return new ApprovePromotionRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApprovePromotionRequest {
+    return new ApprovePromotionRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApprovePromotionRequest | PlainMessage<ApprovePromotionRequest> | undefined, b: ApprovePromotionRequest | PlainMessage<ApprovePromotionRequest> | undefined): boolean {
+    return proto3.util.equals(ApprovePromotionRequest, a, b);
+  }
+}
+
+/**
+ * explicitly empty 
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.ApprovePromotionResponse
+ */
+export class ApprovePromotionResponse extends Message<ApprovePromotionResponse> {
+  constructor(data?: PartialMessage<ApprovePromotionResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "akuity.io.kargo.service.v1alpha1.ApprovePromotionResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApprovePromotionResponse {
+    return new ApprovePromotionResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApprovePromotionResponse {
+    return new ApprovePromotionResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApprovePromotionResponse {
+    return new ApprovePromotionResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApprovePromotionResponse | PlainMessage<ApprovePromotionResponse> | undefined, b: ApprovePromotionResponse | PlainMessage<ApprovePromotionResponse> | undefined): boolean {
+    return proto3.util.equals(ApprovePromotionResponse, a, b);
+  }
+}
+
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.SetAutoPromotionForStageRequest
  */
@@ -5,7 +5,105 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
-import { ListMeta, ObjectMeta } from "../metav1/types_pb.js";
+import { Condition, ListMeta, ObjectMeta } from "../metav1/types_pb.js";
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ApprovalRequirement
+ */
+export class ApprovalRequirement extends Message<ApprovalRequirement> {
+  /**
+   * @generated from field: int32 required_approvals = 1;
+   */
+  requiredApprovals = 0;
+
+  /**
+   * @generated from field: repeated string groups = 2;
+   */
+  groups: string[] = [];
+
+  constructor(data?: PartialMessage<ApprovalRequirement>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ApprovalRequirement";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "required_approvals", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "groups", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApprovalRequirement {
+    return new ApprovalRequirement().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApprovalRequirement {
+    return new ApprovalRequirement().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApprovalRequirement {
+    return new ApprovalRequirement().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApprovalRequirement | PlainMessage<ApprovalRequirement> | undefined, b: ApprovalRequirement | PlainMessage<ApprovalRequirement> | undefined): boolean {
+    return proto3.util.equals(ApprovalRequirement, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppSyncInfo
+ */
+export class ArgoCDAppSyncInfo extends Message<ArgoCDAppSyncInfo> {
+  /**
+   * @generated from field: string namespace = 1;
+   */
+  namespace = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: optional string phase = 3;
+   */
+  phase?: string;
+
+  /**
+   * @generated from field: optional string message = 4;
+   */
+  message?: string;
+
+  constructor(data?: PartialMessage<ArgoCDAppSyncInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppSyncInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArgoCDAppSyncInfo {
+    return new ArgoCDAppSyncInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArgoCDAppSyncInfo {
+    return new ArgoCDAppSyncInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArgoCDAppSyncInfo {
+    return new ArgoCDAppSyncInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArgoCDAppSyncInfo | PlainMessage<ArgoCDAppSyncInfo> | undefined, b: ArgoCDAppSyncInfo | PlainMessage<ArgoCDAppSyncInfo> | undefined): boolean {
+    return proto3.util.equals(ArgoCDAppSyncInfo, a, b);
+  }
+}
 
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppUpdate
@@ -26,6 +124,11 @@ export class ArgoCDAppUpdate extends Message<ArgoCDAppUpdate> {
    */
   sourceUpdates: ArgoCDSourceUpdate[] = [];
 
+  /**
+   * @generated from field: optional bool wait_for_sync = 4;
+   */
+  waitForSync?: boolean;
+
   constructor(data?: PartialMessage<ArgoCDAppUpdate>) {
     super();
     proto3.util.initPartial(data, this);
@@ -37,6 +140,7 @@ export class ArgoCDAppUpdate extends Message<ArgoCDAppUpdate> {
     { no: 1, name: "app_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "app_namespace", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 3, name: "source_updates", kind: "message", T: ArgoCDSourceUpdate, repeated: true },
+    { no: 4, name: "wait_for_sync", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArgoCDAppUpdate {
@@ -151,6 +255,11 @@ export class ArgoCDKustomize extends Message<ArgoCDKustomize> {
    */
   images: string[] = [];
 
+  /**
+   * @generated from field: optional bool use_digest = 2;
+   */
+  useDigest?: boolean;
+
   constructor(data?: PartialMessage<ArgoCDKustomize>) {
     super();
     proto3.util.initPartial(data, this);
@@ -160,6 +269,7 @@ export class ArgoCDKustomize extends Message<ArgoCDKustomize> {
   static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDKustomize";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "images", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "use_digest", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArgoCDKustomize {
@@ -369,6 +479,196 @@ export class ChartSubscription extends Message<ChartSubscription> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FluxHelmImageUpdate
+ */
+export class FluxHelmImageUpdate extends Message<FluxHelmImageUpdate> {
+  /**
+   * @generated from field: string image = 1;
+   */
+  image = "";
+
+  /**
+   * @generated from field: string key = 2;
+   */
+  key = "";
+
+  /**
+   * @generated from field: string value = 3;
+   */
+  value = "";
+
+  constructor(data?: PartialMessage<FluxHelmImageUpdate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.FluxHelmImageUpdate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "image", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FluxHelmImageUpdate {
+    return new FluxHelmImageUpdate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FluxHelmImageUpdate {
+    return new FluxHelmImageUpdate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FluxHelmImageUpdate {
+    return new FluxHelmImageUpdate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FluxHelmImageUpdate | PlainMessage<FluxHelmImageUpdate> | undefined, b: FluxHelmImageUpdate | PlainMessage<FluxHelmImageUpdate> | undefined): boolean {
+    return proto3.util.equals(FluxHelmImageUpdate, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FluxHelmReleaseUpdate
+ */
+export class FluxHelmReleaseUpdate extends Message<FluxHelmReleaseUpdate> {
+  /**
+   * @generated from field: optional string chart_registry_url = 1 [json_name = "chartRegistryURL"];
+   */
+  chartRegistryUrl?: string;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.FluxHelmImageUpdate images = 2;
+   */
+  images: FluxHelmImageUpdate[] = [];
+
+  constructor(data?: PartialMessage<FluxHelmReleaseUpdate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.FluxHelmReleaseUpdate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "chart_registry_url", jsonName: "chartRegistryURL", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "images", kind: "message", T: FluxHelmImageUpdate, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FluxHelmReleaseUpdate {
+    return new FluxHelmReleaseUpdate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FluxHelmReleaseUpdate {
+    return new FluxHelmReleaseUpdate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FluxHelmReleaseUpdate {
+    return new FluxHelmReleaseUpdate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FluxHelmReleaseUpdate | PlainMessage<FluxHelmReleaseUpdate> | undefined, b: FluxHelmReleaseUpdate | PlainMessage<FluxHelmReleaseUpdate> | undefined): boolean {
+    return proto3.util.equals(FluxHelmReleaseUpdate, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FluxKustomizationUpdate
+ */
+export class FluxKustomizationUpdate extends Message<FluxKustomizationUpdate> {
+  /**
+   * @generated from field: repeated string images = 1;
+   */
+  images: string[] = [];
+
+  constructor(data?: PartialMessage<FluxKustomizationUpdate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.FluxKustomizationUpdate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "images", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FluxKustomizationUpdate {
+    return new FluxKustomizationUpdate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FluxKustomizationUpdate {
+    return new FluxKustomizationUpdate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FluxKustomizationUpdate {
+    return new FluxKustomizationUpdate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FluxKustomizationUpdate | PlainMessage<FluxKustomizationUpdate> | undefined, b: FluxKustomizationUpdate | PlainMessage<FluxKustomizationUpdate> | undefined): boolean {
+    return proto3.util.equals(FluxKustomizationUpdate, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FluxUpdate
+ */
+export class FluxUpdate extends Message<FluxUpdate> {
+  /**
+   * @generated from field: string kind = 1;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: optional string namespace = 3;
+   */
+  namespace?: string;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.FluxHelmReleaseUpdate helm_release = 4;
+   */
+  helmRelease?: FluxHelmReleaseUpdate;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.FluxKustomizationUpdate kustomization = 5;
+   */
+  kustomization?: FluxKustomizationUpdate;
+
+  constructor(data?: PartialMessage<FluxUpdate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.FluxUpdate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "helm_release", kind: "message", T: FluxHelmReleaseUpdate, opt: true },
+    { no: 5, name: "kustomization", kind: "message", T: FluxKustomizationUpdate, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FluxUpdate {
+    return new FluxUpdate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FluxUpdate {
+    return new FluxUpdate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FluxUpdate {
+    return new FluxUpdate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FluxUpdate | PlainMessage<FluxUpdate> | undefined, b: FluxUpdate | PlainMessage<FluxUpdate> | undefined): boolean {
+    return proto3.util.equals(FluxUpdate, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.GitCommit
  */
@@ -403,6 +703,11 @@ export class GitCommit extends Message<GitCommit> {
    */
   author = "";
 
+  /**
+   * @generated from field: optional string tag = 7;
+   */
+  tag?: string;
+
   constructor(data?: PartialMessage<GitCommit>) {
     super();
     proto3.util.initPartial(data, this);
@@ -417,6 +722,7 @@ export class GitCommit extends Message<GitCommit> {
     { no: 4, name: "health_check_commit", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 5, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "author", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "tag", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitCommit {
@@ -470,6 +776,26 @@ export class GitRepoUpdate extends Message<GitRepoUpdate> {
    */
   render?: KargoRenderPromotionMechanism;
 
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.PullRequestPromotionMechanism pull_request = 8;
+   */
+  pullRequest?: PullRequestPromotionMechanism;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.YAMLUpdate yaml_updates = 9;
+   */
+  yamlUpdates: YAMLUpdate[] = [];
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.HelmTemplatePromotionMechanism helm_template = 10;
+   */
+  helmTemplate?: HelmTemplatePromotionMechanism;
+
+  /**
+   * @generated from field: optional string commit_message_template = 11;
+   */
+  commitMessageTemplate?: string;
+
   constructor(data?: PartialMessage<GitRepoUpdate>) {
     super();
     proto3.util.initPartial(data, this);
@@ -484,6 +810,10 @@ export class GitRepoUpdate extends Message<GitRepoUpdate> {
     { no: 5, name: "kustomize", kind: "message", T: KustomizePromotionMechanism, opt: true },
     { no: 6, name: "helm", kind: "message", T: HelmPromotionMechanism, opt: true },
     { no: 7, name: "render", kind: "message", T: KargoRenderPromotionMechanism, opt: true },
+    { no: 8, name: "pull_request", kind: "message", T: PullRequestPromotionMechanism, opt: true },
+    { no: 9, name: "yaml_updates", kind: "message", T: YAMLUpdate, repeated: true },
+    { no: 10, name: "helm_template", kind: "message", T: HelmTemplatePromotionMechanism, opt: true },
+    { no: 11, name: "commit_message_template", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitRepoUpdate {
@@ -517,6 +847,36 @@ export class GitSubscription extends Message<GitSubscription> {
    */
   branch = "";
 
+  /**
+   * @generated from field: optional string commit_selection_strategy = 3;
+   */
+  commitSelectionStrategy?: string;
+
+  /**
+   * @generated from field: optional string semver_constraint = 4;
+   */
+  semverConstraint?: string;
+
+  /**
+   * @generated from field: optional string allow_tags = 5;
+   */
+  allowTags?: string;
+
+  /**
+   * @generated from field: repeated string ignore_tags = 6;
+   */
+  ignoreTags: string[] = [];
+
+  /**
+   * @generated from field: repeated string include_paths = 7;
+   */
+  includePaths: string[] = [];
+
+  /**
+   * @generated from field: repeated string exclude_paths = 8;
+   */
+  excludePaths: string[] = [];
+
   constructor(data?: PartialMessage<GitSubscription>) {
     super();
     proto3.util.initPartial(data, this);
@@ -527,6 +887,12 @@ export class GitSubscription extends Message<GitSubscription> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "repo_url", jsonName: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "commit_selection_strategy", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "semver_constraint", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "allow_tags", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "ignore_tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 7, name: "include_paths", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 8, name: "exclude_paths", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitSubscription {
@@ -565,6 +931,11 @@ export class Health extends Message<Health> {
    */
   argocdApps: ArgoCDAppState[] = [];
 
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.FluxResourceStatus flux_resources = 4;
+   */
+  fluxResources: FluxResourceStatus[] = [];
+
   constructor(data?: PartialMessage<Health>) {
     super();
     proto3.util.initPartial(data, this);
@@ -576,6 +947,7 @@ export class Health extends Message<Health> {
     { no: 1, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "issues", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 3, name: "argocd_apps", jsonName: "argoCDApps", kind: "message", T: ArgoCDAppState, repeated: true },
+    { no: 4, name: "flux_resources", kind: "message", T: FluxResourceStatus, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Health {
@@ -743,35 +1115,102 @@ export class ArgoCDAppSyncStatus extends Message<ArgoCDAppSyncStatus> {
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.HelmChartDependencyUpdate
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FluxResourceStatus
  */
-export class HelmChartDependencyUpdate extends Message<HelmChartDependencyUpdate> {
+export class FluxResourceStatus extends Message<FluxResourceStatus> {
   /**
-   * @generated from field: string registry_url = 1 [json_name = "registryURL"];
+   * @generated from field: string kind = 1;
    */
-  registryUrl = "";
+  kind = "";
 
   /**
-   * @generated from field: string name = 2;
+   * @generated from field: string namespace = 2;
+   */
+  namespace = "";
+
+  /**
+   * @generated from field: string name = 3;
    */
   name = "";
 
   /**
-   * @generated from field: string chart_path = 3;
+   * @generated from field: string ready = 4;
    */
-  chartPath = "";
+  ready = "";
 
-  constructor(data?: PartialMessage<HelmChartDependencyUpdate>) {
+  /**
+   * @generated from field: string reason = 5;
+   */
+  reason = "";
+
+  /**
+   * @generated from field: string message = 6;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<FluxResourceStatus>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.HelmChartDependencyUpdate";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.FluxResourceStatus";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "registry_url", jsonName: "registryURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "chart_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "ready", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FluxResourceStatus {
+    return new FluxResourceStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FluxResourceStatus {
+    return new FluxResourceStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FluxResourceStatus {
+    return new FluxResourceStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FluxResourceStatus | PlainMessage<FluxResourceStatus> | undefined, b: FluxResourceStatus | PlainMessage<FluxResourceStatus> | undefined): boolean {
+    return proto3.util.equals(FluxResourceStatus, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.HelmChartDependencyUpdate
+ */
+export class HelmChartDependencyUpdate extends Message<HelmChartDependencyUpdate> {
+  /**
+   * @generated from field: string registry_url = 1 [json_name = "registryURL"];
+   */
+  registryUrl = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string chart_path = 3;
+   */
+  chartPath = "";
+
+  constructor(data?: PartialMessage<HelmChartDependencyUpdate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.HelmChartDependencyUpdate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "registry_url", jsonName: "registryURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "chart_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HelmChartDependencyUpdate {
@@ -890,572 +1329,1767 @@ export class HelmPromotionMechanism extends Message<HelmPromotionMechanism> {
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Image
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.HelmTemplateImageUpdate
  */
-export class Image extends Message<Image> {
+export class HelmTemplateImageUpdate extends Message<HelmTemplateImageUpdate> {
   /**
-   * @generated from field: string repo_url = 1 [json_name = "repoURL"];
+   * @generated from field: string image = 1;
    */
-  repoUrl = "";
+  image = "";
 
   /**
-   * @generated from field: string tag = 2;
+   * @generated from field: string key = 2;
    */
-  tag = "";
+  key = "";
 
-  constructor(data?: PartialMessage<Image>) {
+  /**
+   * @generated from field: string value = 3;
+   */
+  value = "";
+
+  constructor(data?: PartialMessage<HelmTemplateImageUpdate>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.Image";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.HelmTemplateImageUpdate";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "repo_url", jsonName: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "tag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "image", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Image {
-    return new Image().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HelmTemplateImageUpdate {
+    return new HelmTemplateImageUpdate().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Image {
-    return new Image().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HelmTemplateImageUpdate {
+    return new HelmTemplateImageUpdate().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Image {
-    return new Image().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HelmTemplateImageUpdate {
+    return new HelmTemplateImageUpdate().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Image | PlainMessage<Image> | undefined, b: Image | PlainMessage<Image> | undefined): boolean {
-    return proto3.util.equals(Image, a, b);
+  static equals(a: HelmTemplateImageUpdate | PlainMessage<HelmTemplateImageUpdate> | undefined, b: HelmTemplateImageUpdate | PlainMessage<HelmTemplateImageUpdate> | undefined): boolean {
+    return proto3.util.equals(HelmTemplateImageUpdate, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ImageSubscription
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.HelmTemplatePromotionMechanism
  */
-export class ImageSubscription extends Message<ImageSubscription> {
+export class HelmTemplatePromotionMechanism extends Message<HelmTemplatePromotionMechanism> {
   /**
-   * @generated from field: string repo_url = 1 [json_name = "repoURL"];
+   * @generated from field: string chart_path = 1;
    */
-  repoUrl = "";
+  chartPath = "";
 
   /**
-   * @generated from field: string update_strategy = 2;
+   * @generated from field: string release_name = 2;
    */
-  updateStrategy = "";
+  releaseName = "";
 
   /**
-   * @generated from field: optional string semver_constraint = 3;
+   * @generated from field: optional string namespace = 3;
    */
-  semverConstraint?: string;
+  namespace?: string;
 
   /**
-   * @generated from field: optional string allow_tags = 4;
+   * @generated from field: repeated string values_files = 4;
    */
-  allowTags?: string;
+  valuesFiles: string[] = [];
 
   /**
-   * @generated from field: repeated string ignore_tags = 5;
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.HelmTemplateImageUpdate images = 5;
    */
-  ignoreTags: string[] = [];
+  images: HelmTemplateImageUpdate[] = [];
 
   /**
-   * @generated from field: optional string platform = 6;
+   * @generated from field: optional string output_path = 6;
    */
-  platform?: string;
+  outputPath?: string;
 
-  constructor(data?: PartialMessage<ImageSubscription>) {
+  constructor(data?: PartialMessage<HelmTemplatePromotionMechanism>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ImageSubscription";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.HelmTemplatePromotionMechanism";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "repo_url", jsonName: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "update_strategy", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "semver_constraint", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
-    { no: 4, name: "allow_tags", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
-    { no: 5, name: "ignore_tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
-    { no: 6, name: "platform", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 1, name: "chart_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "release_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "values_files", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "images", kind: "message", T: HelmTemplateImageUpdate, repeated: true },
+    { no: 6, name: "output_path", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImageSubscription {
-    return new ImageSubscription().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HelmTemplatePromotionMechanism {
+    return new HelmTemplatePromotionMechanism().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImageSubscription {
-    return new ImageSubscription().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HelmTemplatePromotionMechanism {
+    return new HelmTemplatePromotionMechanism().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImageSubscription {
-    return new ImageSubscription().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HelmTemplatePromotionMechanism {
+    return new HelmTemplatePromotionMechanism().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ImageSubscription | PlainMessage<ImageSubscription> | undefined, b: ImageSubscription | PlainMessage<ImageSubscription> | undefined): boolean {
-    return proto3.util.equals(ImageSubscription, a, b);
+  static equals(a: HelmTemplatePromotionMechanism | PlainMessage<HelmTemplatePromotionMechanism> | undefined, b: HelmTemplatePromotionMechanism | PlainMessage<HelmTemplatePromotionMechanism> | undefined): boolean {
+    return proto3.util.equals(HelmTemplatePromotionMechanism, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.KustomizeImageUpdate
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.HTTPHeader
  */
-export class KustomizeImageUpdate extends Message<KustomizeImageUpdate> {
+export class HTTPHeader extends Message<HTTPHeader> {
   /**
-   * @generated from field: string image = 1;
+   * @generated from field: string name = 1;
    */
-  image = "";
+  name = "";
 
   /**
-   * @generated from field: string path = 2;
+   * @generated from field: string value = 2;
    */
-  path = "";
+  value = "";
 
-  constructor(data?: PartialMessage<KustomizeImageUpdate>) {
+  constructor(data?: PartialMessage<HTTPHeader>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.KustomizeImageUpdate";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.HTTPHeader";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "image", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KustomizeImageUpdate {
-    return new KustomizeImageUpdate().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HTTPHeader {
+    return new HTTPHeader().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KustomizeImageUpdate {
-    return new KustomizeImageUpdate().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HTTPHeader {
+    return new HTTPHeader().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KustomizeImageUpdate {
-    return new KustomizeImageUpdate().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HTTPHeader {
+    return new HTTPHeader().fromJsonString(jsonString, options);
   }
 
-  static equals(a: KustomizeImageUpdate | PlainMessage<KustomizeImageUpdate> | undefined, b: KustomizeImageUpdate | PlainMessage<KustomizeImageUpdate> | undefined): boolean {
-    return proto3.util.equals(KustomizeImageUpdate, a, b);
+  static equals(a: HTTPHeader | PlainMessage<HTTPHeader> | undefined, b: HTTPHeader | PlainMessage<HTTPHeader> | undefined): boolean {
+    return proto3.util.equals(HTTPHeader, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.KustomizePromotionMechanism
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.HTTPSuccessCriteria
  */
-export class KustomizePromotionMechanism extends Message<KustomizePromotionMechanism> {
+export class HTTPSuccessCriteria extends Message<HTTPSuccessCriteria> {
   /**
-   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.KustomizeImageUpdate images = 1;
+   * @generated from field: repeated int32 status_codes = 1;
    */
-  images: KustomizeImageUpdate[] = [];
+  statusCodes: number[] = [];
 
-  constructor(data?: PartialMessage<KustomizePromotionMechanism>) {
+  /**
+   * @generated from field: optional string json_path = 2;
+   */
+  jsonPath?: string;
+
+  /**
+   * @generated from field: optional string value = 3;
+   */
+  value?: string;
+
+  constructor(data?: PartialMessage<HTTPSuccessCriteria>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.KustomizePromotionMechanism";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.HTTPSuccessCriteria";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "images", kind: "message", T: KustomizeImageUpdate, repeated: true },
+    { no: 1, name: "status_codes", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+    { no: 2, name: "json_path", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KustomizePromotionMechanism {
-    return new KustomizePromotionMechanism().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HTTPSuccessCriteria {
+    return new HTTPSuccessCriteria().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KustomizePromotionMechanism {
-    return new KustomizePromotionMechanism().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HTTPSuccessCriteria {
+    return new HTTPSuccessCriteria().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KustomizePromotionMechanism {
-    return new KustomizePromotionMechanism().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HTTPSuccessCriteria {
+    return new HTTPSuccessCriteria().fromJsonString(jsonString, options);
   }
 
-  static equals(a: KustomizePromotionMechanism | PlainMessage<KustomizePromotionMechanism> | undefined, b: KustomizePromotionMechanism | PlainMessage<KustomizePromotionMechanism> | undefined): boolean {
-    return proto3.util.equals(KustomizePromotionMechanism, a, b);
+  static equals(a: HTTPSuccessCriteria | PlainMessage<HTTPSuccessCriteria> | undefined, b: HTTPSuccessCriteria | PlainMessage<HTTPSuccessCriteria> | undefined): boolean {
+    return proto3.util.equals(HTTPSuccessCriteria, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Promotion
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.HTTPWebhook
  */
-export class Promotion extends Message<Promotion> {
+export class HTTPWebhook extends Message<HTTPWebhook> {
   /**
-   * @generated from field: string api_version = 1;
+   * @generated from field: string name = 1;
    */
-  apiVersion = "";
+  name = "";
 
   /**
-   * @generated from field: string kind = 2;
+   * @generated from field: string url = 2;
    */
-  kind = "";
+  url = "";
 
   /**
-   * @generated from field: optional github.com.akuity.kargo.pkg.api.metav1.ObjectMeta metadata = 3;
+   * @generated from field: optional string method = 3;
    */
-  metadata?: ObjectMeta;
+  method?: string;
 
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionSpec spec = 4;
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.HTTPHeader headers = 4;
    */
-  spec?: PromotionSpec;
+  headers: HTTPHeader[] = [];
 
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus status = 5;
+   * @generated from field: optional string headers_secret_name = 5;
    */
-  status?: PromotionStatus;
+  headersSecretName?: string;
 
-  constructor(data?: PartialMessage<Promotion>) {
+  /**
+   * @generated from field: optional string body = 6;
+   */
+  body?: string;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.HTTPSuccessCriteria success_criteria = 7;
+   */
+  successCriteria?: HTTPSuccessCriteria;
+
+  /**
+   * @generated from field: optional int32 timeout_seconds = 8;
+   */
+  timeoutSeconds?: number;
+
+  /**
+   * @generated from field: optional int32 retries = 9;
+   */
+  retries?: number;
+
+  constructor(data?: PartialMessage<HTTPWebhook>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.Promotion";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.HTTPWebhook";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "api_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "metadata", kind: "message", T: ObjectMeta, opt: true },
-    { no: 4, name: "spec", kind: "message", T: PromotionSpec },
-    { no: 5, name: "status", kind: "message", T: PromotionStatus },
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "method", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "headers", kind: "message", T: HTTPHeader, repeated: true },
+    { no: 5, name: "headers_secret_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "body", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "success_criteria", kind: "message", T: HTTPSuccessCriteria, opt: true },
+    { no: 8, name: "timeout_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 9, name: "retries", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Promotion {
-    return new Promotion().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HTTPWebhook {
+    return new HTTPWebhook().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Promotion {
-    return new Promotion().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HTTPWebhook {
+    return new HTTPWebhook().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Promotion {
-    return new Promotion().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HTTPWebhook {
+    return new HTTPWebhook().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Promotion | PlainMessage<Promotion> | undefined, b: Promotion | PlainMessage<Promotion> | undefined): boolean {
-    return proto3.util.equals(Promotion, a, b);
+  static equals(a: HTTPWebhook | PlainMessage<HTTPWebhook> | undefined, b: HTTPWebhook | PlainMessage<HTTPWebhook> | undefined): boolean {
+    return proto3.util.equals(HTTPWebhook, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionInfo
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.HTTPWebhookResult
  */
-export class PromotionInfo extends Message<PromotionInfo> {
+export class HTTPWebhookResult extends Message<HTTPWebhookResult> {
   /**
    * @generated from field: string name = 1;
    */
   name = "";
 
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.SimpleFreight freight = 2;
+   * @generated from field: int32 attempts = 2;
    */
-  freight?: SimpleFreight;
+  attempts = 0;
 
-  constructor(data?: PartialMessage<PromotionInfo>) {
+  /**
+   * @generated from field: bool succeeded = 3;
+   */
+  succeeded = false;
+
+  /**
+   * @generated from field: int32 status_code = 4;
+   */
+  statusCode = 0;
+
+  /**
+   * @generated from field: string response = 5;
+   */
+  response = "";
+
+  /**
+   * @generated from field: string message = 6;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<HTTPWebhookResult>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionInfo";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.HTTPWebhookResult";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "freight", kind: "message", T: SimpleFreight },
+    { no: 2, name: "attempts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "succeeded", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "status_code", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "response", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionInfo {
-    return new PromotionInfo().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HTTPWebhookResult {
+    return new HTTPWebhookResult().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionInfo {
-    return new PromotionInfo().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HTTPWebhookResult {
+    return new HTTPWebhookResult().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionInfo {
-    return new PromotionInfo().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HTTPWebhookResult {
+    return new HTTPWebhookResult().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PromotionInfo | PlainMessage<PromotionInfo> | undefined, b: PromotionInfo | PlainMessage<PromotionInfo> | undefined): boolean {
-    return proto3.util.equals(PromotionInfo, a, b);
+  static equals(a: HTTPWebhookResult | PlainMessage<HTTPWebhookResult> | undefined, b: HTTPWebhookResult | PlainMessage<HTTPWebhookResult> | undefined): boolean {
+    return proto3.util.equals(HTTPWebhookResult, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionList
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Image
  */
-export class PromotionList extends Message<PromotionList> {
+export class Image extends Message<Image> {
   /**
-   * @generated from field: optional github.com.akuity.kargo.pkg.api.metav1.ListMeta metadata = 1;
+   * @generated from field: string repo_url = 1 [json_name = "repoURL"];
    */
-  metadata?: ListMeta;
+  repoUrl = "";
 
   /**
-   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.Promotion items = 2;
+   * @generated from field: string tag = 2;
    */
-  items: Promotion[] = [];
+  tag = "";
 
-  constructor(data?: PartialMessage<PromotionList>) {
+  /**
+   * @generated from field: optional string digest = 3;
+   */
+  digest?: string;
+
+  constructor(data?: PartialMessage<Image>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionList";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.Image";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "metadata", kind: "message", T: ListMeta, opt: true },
-    { no: 2, name: "items", kind: "message", T: Promotion, repeated: true },
+    { no: 1, name: "repo_url", jsonName: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "digest", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionList {
-    return new PromotionList().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Image {
+    return new Image().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionList {
-    return new PromotionList().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Image {
+    return new Image().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionList {
-    return new PromotionList().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Image {
+    return new Image().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PromotionList | PlainMessage<PromotionList> | undefined, b: PromotionList | PlainMessage<PromotionList> | undefined): boolean {
-    return proto3.util.equals(PromotionList, a, b);
+  static equals(a: Image | PlainMessage<Image> | undefined, b: Image | PlainMessage<Image> | undefined): boolean {
+    return proto3.util.equals(Image, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionMechanisms
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ImageSubscription
+ */
+export class ImageSubscription extends Message<ImageSubscription> {
+  /**
+   * @generated from field: string repo_url = 1 [json_name = "repoURL"];
+   */
+  repoUrl = "";
+
+  /**
+   * @generated from field: string update_strategy = 2;
+   */
+  updateStrategy = "";
+
+  /**
+   * @generated from field: optional string semver_constraint = 3;
+   */
+  semverConstraint?: string;
+
+  /**
+   * @generated from field: optional string allow_tags = 4;
+   */
+  allowTags?: string;
+
+  /**
+   * @generated from field: repeated string ignore_tags = 5;
+   */
+  ignoreTags: string[] = [];
+
+  /**
+   * @generated from field: optional string platform = 6;
+   */
+  platform?: string;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ImageVerification verification = 7;
+   */
+  verification?: ImageVerification;
+
+  constructor(data?: PartialMessage<ImageSubscription>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ImageSubscription";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_url", jsonName: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "update_strategy", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "semver_constraint", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "allow_tags", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "ignore_tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "platform", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "verification", kind: "message", T: ImageVerification, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImageSubscription {
+    return new ImageSubscription().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImageSubscription {
+    return new ImageSubscription().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImageSubscription {
+    return new ImageSubscription().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImageSubscription | PlainMessage<ImageSubscription> | undefined, b: ImageSubscription | PlainMessage<ImageSubscription> | undefined): boolean {
+    return proto3.util.equals(ImageSubscription, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ImageVerification
+ */
+export class ImageVerification extends Message<ImageVerification> {
+  /**
+   * @generated from field: optional string public_key = 1;
+   */
+  publicKey?: string;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.KeylessVerification keyless = 2;
+   */
+  keyless?: KeylessVerification;
+
+  constructor(data?: PartialMessage<ImageVerification>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ImageVerification";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "public_key", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "keyless", kind: "message", T: KeylessVerification, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImageVerification {
+    return new ImageVerification().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImageVerification {
+    return new ImageVerification().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImageVerification {
+    return new ImageVerification().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImageVerification | PlainMessage<ImageVerification> | undefined, b: ImageVerification | PlainMessage<ImageVerification> | undefined): boolean {
+    return proto3.util.equals(ImageVerification, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.ImageVerificationFailure
+ */
+export class ImageVerificationFailure extends Message<ImageVerificationFailure> {
+  /**
+   * @generated from field: string repo_url = 1 [json_name = "repoURL"];
+   */
+  repoUrl = "";
+
+  /**
+   * @generated from field: string tag = 2;
+   */
+  tag = "";
+
+  /**
+   * @generated from field: optional string digest = 3;
+   */
+  digest?: string;
+
+  /**
+   * @generated from field: string message = 4;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<ImageVerificationFailure>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.ImageVerificationFailure";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_url", jsonName: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "digest", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImageVerificationFailure {
+    return new ImageVerificationFailure().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImageVerificationFailure {
+    return new ImageVerificationFailure().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImageVerificationFailure {
+    return new ImageVerificationFailure().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImageVerificationFailure | PlainMessage<ImageVerificationFailure> | undefined, b: ImageVerificationFailure | PlainMessage<ImageVerificationFailure> | undefined): boolean {
+    return proto3.util.equals(ImageVerificationFailure, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.KeylessVerification
+ */
+export class KeylessVerification extends Message<KeylessVerification> {
+  /**
+   * @generated from field: string identity = 1;
+   */
+  identity = "";
+
+  /**
+   * @generated from field: string issuer = 2;
+   */
+  issuer = "";
+
+  /**
+   * @generated from field: string certificate_authorities = 3;
+   */
+  certificateAuthorities = "";
+
+  /**
+   * @generated from field: string transparency_log_public_key = 4;
+   */
+  transparencyLogPublicKey = "";
+
+  constructor(data?: PartialMessage<KeylessVerification>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.KeylessVerification";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "identity", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "issuer", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "certificate_authorities", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "transparency_log_public_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KeylessVerification {
+    return new KeylessVerification().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KeylessVerification {
+    return new KeylessVerification().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KeylessVerification {
+    return new KeylessVerification().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KeylessVerification | PlainMessage<KeylessVerification> | undefined, b: KeylessVerification | PlainMessage<KeylessVerification> | undefined): boolean {
+    return proto3.util.equals(KeylessVerification, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.KubernetesPatch
+ */
+export class KubernetesPatch extends Message<KubernetesPatch> {
+  /**
+   * @generated from field: string api_version = 1;
+   */
+  apiVersion = "";
+
+  /**
+   * @generated from field: string kind = 2;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: optional string namespace = 4;
+   */
+  namespace?: string;
+
+  /**
+   * @generated from field: string type = 5;
+   */
+  type = "";
+
+  /**
+   * @generated from field: string patch = 6;
+   */
+  patch = "";
+
+  constructor(data?: PartialMessage<KubernetesPatch>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.KubernetesPatch";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "api_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "patch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KubernetesPatch {
+    return new KubernetesPatch().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KubernetesPatch {
+    return new KubernetesPatch().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KubernetesPatch {
+    return new KubernetesPatch().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KubernetesPatch | PlainMessage<KubernetesPatch> | undefined, b: KubernetesPatch | PlainMessage<KubernetesPatch> | undefined): boolean {
+    return proto3.util.equals(KubernetesPatch, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.KustomizeBuild
+ */
+export class KustomizeBuild extends Message<KustomizeBuild> {
+  /**
+   * @generated from field: string path = 1;
+   */
+  path = "";
+
+  /**
+   * @generated from field: optional string output_path = 2;
+   */
+  outputPath?: string;
+
+  constructor(data?: PartialMessage<KustomizeBuild>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.KustomizeBuild";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "output_path", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KustomizeBuild {
+    return new KustomizeBuild().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KustomizeBuild {
+    return new KustomizeBuild().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KustomizeBuild {
+    return new KustomizeBuild().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KustomizeBuild | PlainMessage<KustomizeBuild> | undefined, b: KustomizeBuild | PlainMessage<KustomizeBuild> | undefined): boolean {
+    return proto3.util.equals(KustomizeBuild, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.KustomizeImageUpdate
+ */
+export class KustomizeImageUpdate extends Message<KustomizeImageUpdate> {
+  /**
+   * @generated from field: string image = 1;
+   */
+  image = "";
+
+  /**
+   * @generated from field: string path = 2;
+   */
+  path = "";
+
+  /**
+   * @generated from field: optional bool use_digest = 3;
+   */
+  useDigest?: boolean;
+
+  constructor(data?: PartialMessage<KustomizeImageUpdate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.KustomizeImageUpdate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "image", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "use_digest", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KustomizeImageUpdate {
+    return new KustomizeImageUpdate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KustomizeImageUpdate {
+    return new KustomizeImageUpdate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KustomizeImageUpdate {
+    return new KustomizeImageUpdate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KustomizeImageUpdate | PlainMessage<KustomizeImageUpdate> | undefined, b: KustomizeImageUpdate | PlainMessage<KustomizeImageUpdate> | undefined): boolean {
+    return proto3.util.equals(KustomizeImageUpdate, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.KustomizePromotionMechanism
+ */
+export class KustomizePromotionMechanism extends Message<KustomizePromotionMechanism> {
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.KustomizeImageUpdate images = 1;
+   */
+  images: KustomizeImageUpdate[] = [];
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.KustomizeBuild build = 2;
+   */
+  build?: KustomizeBuild;
+
+  constructor(data?: PartialMessage<KustomizePromotionMechanism>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.KustomizePromotionMechanism";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "images", kind: "message", T: KustomizeImageUpdate, repeated: true },
+    { no: 2, name: "build", kind: "message", T: KustomizeBuild, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KustomizePromotionMechanism {
+    return new KustomizePromotionMechanism().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KustomizePromotionMechanism {
+    return new KustomizePromotionMechanism().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KustomizePromotionMechanism {
+    return new KustomizePromotionMechanism().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KustomizePromotionMechanism | PlainMessage<KustomizePromotionMechanism> | undefined, b: KustomizePromotionMechanism | PlainMessage<KustomizePromotionMechanism> | undefined): boolean {
+    return proto3.util.equals(KustomizePromotionMechanism, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Promotion
+ */
+export class Promotion extends Message<Promotion> {
+  /**
+   * @generated from field: string api_version = 1;
+   */
+  apiVersion = "";
+
+  /**
+   * @generated from field: string kind = 2;
+   */
+  kind = "";
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.metav1.ObjectMeta metadata = 3;
+   */
+  metadata?: ObjectMeta;
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionSpec spec = 4;
+   */
+  spec?: PromotionSpec;
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus status = 5;
+   */
+  status?: PromotionStatus;
+
+  constructor(data?: PartialMessage<Promotion>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.Promotion";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "api_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "metadata", kind: "message", T: ObjectMeta, opt: true },
+    { no: 4, name: "spec", kind: "message", T: PromotionSpec },
+    { no: 5, name: "status", kind: "message", T: PromotionStatus },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Promotion {
+    return new Promotion().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Promotion {
+    return new Promotion().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Promotion {
+    return new Promotion().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Promotion | PlainMessage<Promotion> | undefined, b: Promotion | PlainMessage<Promotion> | undefined): boolean {
+    return proto3.util.equals(Promotion, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionApproval
+ */
+export class PromotionApproval extends Message<PromotionApproval> {
+  /**
+   * @generated from field: string username = 1;
+   */
+  username = "";
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp approved_at = 2;
+   */
+  approvedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<PromotionApproval>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionApproval";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "approved_at", kind: "message", T: Timestamp, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionApproval {
+    return new PromotionApproval().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionApproval {
+    return new PromotionApproval().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionApproval {
+    return new PromotionApproval().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionApproval | PlainMessage<PromotionApproval> | undefined, b: PromotionApproval | PlainMessage<PromotionApproval> | undefined): boolean {
+    return proto3.util.equals(PromotionApproval, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionAttempt
+ */
+export class PromotionAttempt extends Message<PromotionAttempt> {
+  /**
+   * @generated from field: optional google.protobuf.Timestamp finished_at = 1;
+   */
+  finishedAt?: Timestamp;
+
+  /**
+   * @generated from field: string class = 2;
+   */
+  class = "";
+
+  /**
+   * @generated from field: string error = 3;
+   */
+  error = "";
+
+  /**
+   * @generated from field: string phase = 4;
+   */
+  phase = "";
+
+  constructor(data?: PartialMessage<PromotionAttempt>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionAttempt";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "finished_at", kind: "message", T: Timestamp, opt: true },
+    { no: 2, name: "class", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionAttempt {
+    return new PromotionAttempt().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionAttempt {
+    return new PromotionAttempt().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionAttempt {
+    return new PromotionAttempt().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionAttempt | PlainMessage<PromotionAttempt> | undefined, b: PromotionAttempt | PlainMessage<PromotionAttempt> | undefined): boolean {
+    return proto3.util.equals(PromotionAttempt, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionInfo
+ */
+export class PromotionInfo extends Message<PromotionInfo> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.v1alpha1.SimpleFreight freight = 2;
+   */
+  freight?: SimpleFreight;
+
+  constructor(data?: PartialMessage<PromotionInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "freight", kind: "message", T: SimpleFreight },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionInfo {
+    return new PromotionInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionInfo {
+    return new PromotionInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionInfo {
+    return new PromotionInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionInfo | PlainMessage<PromotionInfo> | undefined, b: PromotionInfo | PlainMessage<PromotionInfo> | undefined): boolean {
+    return proto3.util.equals(PromotionInfo, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJob
+ */
+export class PromotionJob extends Message<PromotionJob> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string template = 2;
+   */
+  template = "";
+
+  constructor(data?: PartialMessage<PromotionJob>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJob";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "template", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionJob {
+    return new PromotionJob().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionJob {
+    return new PromotionJob().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionJob {
+    return new PromotionJob().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionJob | PlainMessage<PromotionJob> | undefined, b: PromotionJob | PlainMessage<PromotionJob> | undefined): boolean {
+    return proto3.util.equals(PromotionJob, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionList
+ */
+export class PromotionList extends Message<PromotionList> {
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.metav1.ListMeta metadata = 1;
+   */
+  metadata?: ListMeta;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.Promotion items = 2;
+   */
+  items: Promotion[] = [];
+
+  constructor(data?: PartialMessage<PromotionList>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionList";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: ListMeta, opt: true },
+    { no: 2, name: "items", kind: "message", T: Promotion, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionList {
+    return new PromotionList().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionList {
+    return new PromotionList().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionList {
+    return new PromotionList().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionList | PlainMessage<PromotionList> | undefined, b: PromotionList | PlainMessage<PromotionList> | undefined): boolean {
+    return proto3.util.equals(PromotionList, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionMechanisms
  */
 export class PromotionMechanisms extends Message<PromotionMechanisms> {
   /**
-   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.GitRepoUpdate git_repo_updates = 1;
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.GitRepoUpdate git_repo_updates = 1;
+   */
+  gitRepoUpdates: GitRepoUpdate[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppUpdate argocd_app_updates = 2 [json_name = "argoCDAppUpdates"];
+   */
+  argocdAppUpdates: ArgoCDAppUpdate[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.FluxUpdate flux_updates = 3;
+   */
+  fluxUpdates: FluxUpdate[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.KubernetesPatch kubernetes_patches = 4;
+   */
+  kubernetesPatches: KubernetesPatch[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.HTTPWebhook http_webhooks = 5;
+   */
+  httpWebhooks: HTTPWebhook[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionJob jobs = 6;
+   */
+  jobs: PromotionJob[] = [];
+
+  /**
+   * @generated from field: optional string timeout = 7;
+   */
+  timeout?: string;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.PromotionRetryPolicy retry = 8;
+   */
+  retry?: PromotionRetryPolicy;
+
+  constructor(data?: PartialMessage<PromotionMechanisms>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionMechanisms";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "git_repo_updates", kind: "message", T: GitRepoUpdate, repeated: true },
+    { no: 2, name: "argocd_app_updates", jsonName: "argoCDAppUpdates", kind: "message", T: ArgoCDAppUpdate, repeated: true },
+    { no: 3, name: "flux_updates", kind: "message", T: FluxUpdate, repeated: true },
+    { no: 4, name: "kubernetes_patches", kind: "message", T: KubernetesPatch, repeated: true },
+    { no: 5, name: "http_webhooks", kind: "message", T: HTTPWebhook, repeated: true },
+    { no: 6, name: "jobs", kind: "message", T: PromotionJob, repeated: true },
+    { no: 7, name: "timeout", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 8, name: "retry", kind: "message", T: PromotionRetryPolicy, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionMechanisms {
+    return new PromotionMechanisms().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionMechanisms {
+    return new PromotionMechanisms().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionMechanisms {
+    return new PromotionMechanisms().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionMechanisms | PlainMessage<PromotionMechanisms> | undefined, b: PromotionMechanisms | PlainMessage<PromotionMechanisms> | undefined): boolean {
+    return proto3.util.equals(PromotionMechanisms, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicy
+ */
+export class PromotionPolicy extends Message<PromotionPolicy> {
+  /**
+   * @generated from field: string api_version = 1;
    */
-  gitRepoUpdates: GitRepoUpdate[] = [];
+  apiVersion = "";
 
   /**
-   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppUpdate argocd_app_updates = 2 [json_name = "argoCDAppUpdates"];
+   * @generated from field: string kind = 2;
    */
-  argocdAppUpdates: ArgoCDAppUpdate[] = [];
+  kind = "";
 
-  constructor(data?: PartialMessage<PromotionMechanisms>) {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.metav1.ObjectMeta metadata = 3;
+   */
+  metadata?: ObjectMeta;
+
+  /**
+   * @generated from field: string stage = 4;
+   */
+  stage = "";
+
+  /**
+   * @generated from field: bool enable_auto_promotion = 5;
+   */
+  enableAutoPromotion = false;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionWindow auto_promotion_windows = 6;
+   */
+  autoPromotionWindows: PromotionWindow[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionWindow freeze_windows = 7;
+   */
+  freezeWindows: PromotionWindow[] = [];
+
+  constructor(data?: PartialMessage<PromotionPolicy>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionMechanisms";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicy";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "git_repo_updates", kind: "message", T: GitRepoUpdate, repeated: true },
-    { no: 2, name: "argocd_app_updates", jsonName: "argoCDAppUpdates", kind: "message", T: ArgoCDAppUpdate, repeated: true },
+    { no: 1, name: "api_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "metadata", kind: "message", T: ObjectMeta },
+    { no: 4, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "enable_auto_promotion", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "auto_promotion_windows", kind: "message", T: PromotionWindow, repeated: true },
+    { no: 7, name: "freeze_windows", kind: "message", T: PromotionWindow, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionMechanisms {
-    return new PromotionMechanisms().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionPolicy {
+    return new PromotionPolicy().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionMechanisms {
-    return new PromotionMechanisms().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionPolicy {
+    return new PromotionPolicy().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionMechanisms {
-    return new PromotionMechanisms().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionPolicy {
+    return new PromotionPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionPolicy | PlainMessage<PromotionPolicy> | undefined, b: PromotionPolicy | PlainMessage<PromotionPolicy> | undefined): boolean {
+    return proto3.util.equals(PromotionPolicy, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicyList
+ */
+export class PromotionPolicyList extends Message<PromotionPolicyList> {
+  /**
+   * @generated from field: github.com.akuity.kargo.pkg.api.metav1.ListMeta metadata = 1;
+   */
+  metadata?: ListMeta;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicy items = 2;
+   */
+  items: PromotionPolicy[] = [];
+
+  constructor(data?: PartialMessage<PromotionPolicyList>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicyList";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: ListMeta },
+    { no: 2, name: "items", kind: "message", T: PromotionPolicy, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionPolicyList {
+    return new PromotionPolicyList().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionPolicyList {
+    return new PromotionPolicyList().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionPolicyList {
+    return new PromotionPolicyList().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionPolicyList | PlainMessage<PromotionPolicyList> | undefined, b: PromotionPolicyList | PlainMessage<PromotionPolicyList> | undefined): boolean {
+    return proto3.util.equals(PromotionPolicyList, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionRetryPolicy
+ */
+export class PromotionRetryPolicy extends Message<PromotionRetryPolicy> {
+  /**
+   * @generated from field: int32 max_attempts = 1;
+   */
+  maxAttempts = 0;
+
+  /**
+   * @generated from field: optional string backoff = 2;
+   */
+  backoff?: string;
+
+  /**
+   * @generated from field: optional string max_backoff = 3;
+   */
+  maxBackoff?: string;
+
+  /**
+   * @generated from field: repeated string retry_on = 4;
+   */
+  retryOn: string[] = [];
+
+  constructor(data?: PartialMessage<PromotionRetryPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionRetryPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "max_attempts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "backoff", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "max_backoff", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "retry_on", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionRetryPolicy {
+    return new PromotionRetryPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionRetryPolicy {
+    return new PromotionRetryPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionRetryPolicy {
+    return new PromotionRetryPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionRetryPolicy | PlainMessage<PromotionRetryPolicy> | undefined, b: PromotionRetryPolicy | PlainMessage<PromotionRetryPolicy> | undefined): boolean {
+    return proto3.util.equals(PromotionRetryPolicy, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionSpec
+ */
+export class PromotionSpec extends Message<PromotionSpec> {
+  /**
+   * @generated from field: string stage = 1;
+   */
+  stage = "";
+
+  /**
+   * @generated from field: string freight = 2;
+   */
+  freight = "";
+
+  /**
+   * @generated from field: bool rollback = 3;
+   */
+  rollback = false;
+
+  constructor(data?: PartialMessage<PromotionSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "rollback", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionSpec {
+    return new PromotionSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionSpec {
+    return new PromotionSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionSpec {
+    return new PromotionSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionSpec | PlainMessage<PromotionSpec> | undefined, b: PromotionSpec | PlainMessage<PromotionSpec> | undefined): boolean {
+    return proto3.util.equals(PromotionSpec, a, b);
+  }
+}
+
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus
+ */
+export class PromotionStatus extends Message<PromotionStatus> {
+  /**
+   * @generated from field: string phase = 1;
+   */
+  phase = "";
+
+  /**
+   * @generated from field: string error = 2;
+   */
+  error = "";
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PullRequestInfo pull_requests = 3;
+   */
+  pullRequests: PullRequestInfo[] = [];
+
+  /**
+   * @generated from field: string signing_key_id = 4 [json_name = "signingKeyID"];
+   */
+  signingKeyId = "";
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.ArgoCDAppSyncInfo argo_cd_app_syncs = 5 [json_name = "argoCDAppSyncs"];
+   */
+  argoCdAppSyncs: ArgoCDAppSyncInfo[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.HTTPWebhookResult http_webhook_results = 6;
+   */
+  httpWebhookResults: HTTPWebhookResult[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStepResult steps = 7;
+   */
+  steps: PromotionStepResult[] = [];
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp started_at = 8;
+   */
+  startedAt?: Timestamp;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionAttempt attempts = 9;
+   */
+  attempts: PromotionAttempt[] = [];
+
+  /**
+   * @generated from field: bool awaiting_approval = 10;
+   */
+  awaitingApproval = false;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionApproval approvals = 11;
+   */
+  approvals: PromotionApproval[] = [];
+
+  /**
+   * @generated from field: string superseded_by = 12;
+   */
+  supersededBy = "";
+
+  constructor(data?: PartialMessage<PromotionStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "pull_requests", kind: "message", T: PullRequestInfo, repeated: true },
+    { no: 4, name: "signing_key_id", jsonName: "signingKeyID", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "argo_cd_app_syncs", jsonName: "argoCDAppSyncs", kind: "message", T: ArgoCDAppSyncInfo, repeated: true },
+    { no: 6, name: "http_webhook_results", kind: "message", T: HTTPWebhookResult, repeated: true },
+    { no: 7, name: "steps", kind: "message", T: PromotionStepResult, repeated: true },
+    { no: 8, name: "started_at", kind: "message", T: Timestamp, opt: true },
+    { no: 9, name: "attempts", kind: "message", T: PromotionAttempt, repeated: true },
+    { no: 10, name: "awaiting_approval", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 11, name: "approvals", kind: "message", T: PromotionApproval, repeated: true },
+    { no: 12, name: "superseded_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionStatus {
+    return new PromotionStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionStatus {
+    return new PromotionStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionStatus {
+    return new PromotionStatus().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PromotionMechanisms | PlainMessage<PromotionMechanisms> | undefined, b: PromotionMechanisms | PlainMessage<PromotionMechanisms> | undefined): boolean {
-    return proto3.util.equals(PromotionMechanisms, a, b);
+  static equals(a: PromotionStatus | PlainMessage<PromotionStatus> | undefined, b: PromotionStatus | PlainMessage<PromotionStatus> | undefined): boolean {
+    return proto3.util.equals(PromotionStatus, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicy
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStepResult
  */
-export class PromotionPolicy extends Message<PromotionPolicy> {
+export class PromotionStepResult extends Message<PromotionStepResult> {
   /**
-   * @generated from field: string api_version = 1;
+   * @generated from field: string mechanism = 1;
    */
-  apiVersion = "";
+  mechanism = "";
 
   /**
-   * @generated from field: string kind = 2;
+   * @generated from field: optional string target = 2;
    */
-  kind = "";
+  target?: string;
 
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.metav1.ObjectMeta metadata = 3;
+   * @generated from field: optional google.protobuf.Timestamp started_at = 3;
    */
-  metadata?: ObjectMeta;
+  startedAt?: Timestamp;
 
   /**
-   * @generated from field: string stage = 4;
+   * @generated from field: optional google.protobuf.Timestamp finished_at = 4;
    */
-  stage = "";
+  finishedAt?: Timestamp;
 
   /**
-   * @generated from field: bool enable_auto_promotion = 5;
+   * @generated from field: optional string commit = 5;
    */
-  enableAutoPromotion = false;
+  commit?: string;
 
-  constructor(data?: PartialMessage<PromotionPolicy>) {
+  /**
+   * @generated from field: optional string error = 6;
+   */
+  error?: string;
+
+  /**
+   * @generated from field: optional string message = 7;
+   */
+  message?: string;
+
+  constructor(data?: PartialMessage<PromotionStepResult>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicy";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStepResult";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "api_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "metadata", kind: "message", T: ObjectMeta },
-    { no: 4, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "enable_auto_promotion", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "mechanism", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "target", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "started_at", kind: "message", T: Timestamp, opt: true },
+    { no: 4, name: "finished_at", kind: "message", T: Timestamp, opt: true },
+    { no: 5, name: "commit", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionPolicy {
-    return new PromotionPolicy().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionStepResult {
+    return new PromotionStepResult().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionPolicy {
-    return new PromotionPolicy().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionStepResult {
+    return new PromotionStepResult().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionPolicy {
-    return new PromotionPolicy().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionStepResult {
+    return new PromotionStepResult().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PromotionPolicy | PlainMessage<PromotionPolicy> | undefined, b: PromotionPolicy | PlainMessage<PromotionPolicy> | undefined): boolean {
-    return proto3.util.equals(PromotionPolicy, a, b);
+  static equals(a: PromotionStepResult | PlainMessage<PromotionStepResult> | undefined, b: PromotionStepResult | PlainMessage<PromotionStepResult> | undefined): boolean {
+    return proto3.util.equals(PromotionStepResult, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicyList
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionWindow
  */
-export class PromotionPolicyList extends Message<PromotionPolicyList> {
+export class PromotionWindow extends Message<PromotionWindow> {
   /**
-   * @generated from field: github.com.akuity.kargo.pkg.api.metav1.ListMeta metadata = 1;
+   * @generated from field: string schedule = 1;
    */
-  metadata?: ListMeta;
+  schedule = "";
 
   /**
-   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicy items = 2;
+   * @generated from field: string duration = 2;
    */
-  items: PromotionPolicy[] = [];
+  duration = "";
 
-  constructor(data?: PartialMessage<PromotionPolicyList>) {
+  /**
+   * @generated from field: optional string time_zone = 3;
+   */
+  timeZone?: string;
+
+  constructor(data?: PartialMessage<PromotionWindow>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionPolicyList";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionWindow";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "metadata", kind: "message", T: ListMeta },
-    { no: 2, name: "items", kind: "message", T: PromotionPolicy, repeated: true },
+    { no: 1, name: "schedule", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "duration", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionPolicyList {
-    return new PromotionPolicyList().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionWindow {
+    return new PromotionWindow().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionPolicyList {
-    return new PromotionPolicyList().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionWindow {
+    return new PromotionWindow().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionPolicyList {
-    return new PromotionPolicyList().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionWindow {
+    return new PromotionWindow().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PromotionPolicyList | PlainMessage<PromotionPolicyList> | undefined, b: PromotionPolicyList | PlainMessage<PromotionPolicyList> | undefined): boolean {
-    return proto3.util.equals(PromotionPolicyList, a, b);
+  static equals(a: PromotionWindow | PlainMessage<PromotionWindow> | undefined, b: PromotionWindow | PlainMessage<PromotionWindow> | undefined): boolean {
+    return proto3.util.equals(PromotionWindow, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionSpec
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PullRequestInfo
  */
-export class PromotionSpec extends Message<PromotionSpec> {
+export class PullRequestInfo extends Message<PullRequestInfo> {
   /**
-   * @generated from field: string stage = 1;
+   * @generated from field: string repo_url = 1 [json_name = "repoURL"];
    */
-  stage = "";
+  repoUrl = "";
 
   /**
-   * @generated from field: string freight = 2;
+   * @generated from field: string source_branch = 2;
    */
-  freight = "";
+  sourceBranch = "";
 
-  constructor(data?: PartialMessage<PromotionSpec>) {
+  /**
+   * @generated from field: string target_branch = 3;
+   */
+  targetBranch = "";
+
+  /**
+   * @generated from field: int64 number = 4;
+   */
+  number = protoInt64.zero;
+
+  /**
+   * @generated from field: string url = 5;
+   */
+  url = "";
+
+  /**
+   * @generated from field: string state = 6;
+   */
+  state = "";
+
+  /**
+   * @generated from field: optional string merge_commit = 7;
+   */
+  mergeCommit?: string;
+
+  constructor(data?: PartialMessage<PullRequestInfo>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionSpec";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PullRequestInfo";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "freight", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "repo_url", jsonName: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "source_branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "target_branch", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "number", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "merge_commit", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionSpec {
-    return new PromotionSpec().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PullRequestInfo {
+    return new PullRequestInfo().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionSpec {
-    return new PromotionSpec().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PullRequestInfo {
+    return new PullRequestInfo().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionSpec {
-    return new PromotionSpec().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PullRequestInfo {
+    return new PullRequestInfo().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PromotionSpec | PlainMessage<PromotionSpec> | undefined, b: PromotionSpec | PlainMessage<PromotionSpec> | undefined): boolean {
-    return proto3.util.equals(PromotionSpec, a, b);
+  static equals(a: PullRequestInfo | PlainMessage<PullRequestInfo> | undefined, b: PullRequestInfo | PlainMessage<PullRequestInfo> | undefined): boolean {
+    return proto3.util.equals(PullRequestInfo, a, b);
   }
 }
 
 /**
- * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.PullRequestPromotionMechanism
  */
-export class PromotionStatus extends Message<PromotionStatus> {
+export class PullRequestPromotionMechanism extends Message<PullRequestPromotionMechanism> {
   /**
-   * @generated from field: string phase = 1;
+   * @generated from field: optional string provider = 1;
    */
-  phase = "";
+  provider?: string;
 
   /**
-   * @generated from field: string error = 2;
+   * @generated from field: optional string api_url = 2 [json_name = "apiURL"];
    */
-  error = "";
+  apiUrl?: string;
 
-  constructor(data?: PartialMessage<PromotionStatus>) {
+  constructor(data?: PartialMessage<PullRequestPromotionMechanism>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PromotionStatus";
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.PullRequestPromotionMechanism";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "provider", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "api_url", jsonName: "apiURL", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionStatus {
-    return new PromotionStatus().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PullRequestPromotionMechanism {
+    return new PullRequestPromotionMechanism().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionStatus {
-    return new PromotionStatus().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PullRequestPromotionMechanism {
+    return new PullRequestPromotionMechanism().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionStatus {
-    return new PromotionStatus().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PullRequestPromotionMechanism {
+    return new PullRequestPromotionMechanism().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PromotionStatus | PlainMessage<PromotionStatus> | undefined, b: PromotionStatus | PlainMessage<PromotionStatus> | undefined): boolean {
-    return proto3.util.equals(PromotionStatus, a, b);
+  static equals(a: PullRequestPromotionMechanism | PlainMessage<PullRequestPromotionMechanism> | undefined, b: PullRequestPromotionMechanism | PlainMessage<PullRequestPromotionMechanism> | undefined): boolean {
+    return proto3.util.equals(PullRequestPromotionMechanism, a, b);
   }
 }
 
@@ -1626,6 +3260,16 @@ export class StageSpec extends Message<StageSpec> {
    */
   promotionMechanisms?: PromotionMechanisms;
 
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.ApprovalRequirement approval = 3;
+   */
+  approval?: ApprovalRequirement;
+
+  /**
+   * @generated from field: bool supersede_stale_promotions = 4;
+   */
+  supersedeStalePromotions = false;
+
   constructor(data?: PartialMessage<StageSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1636,6 +3280,8 @@ export class StageSpec extends Message<StageSpec> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "subscriptions", kind: "message", T: Subscriptions },
     { no: 2, name: "promotion_mechanisms", kind: "message", T: PromotionMechanisms },
+    { no: 3, name: "approval", kind: "message", T: ApprovalRequirement, opt: true },
+    { no: 4, name: "supersede_stale_promotions", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageSpec {
@@ -1734,6 +3380,49 @@ export class Freight extends Message<Freight> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference
+ */
+export class FreightReference extends Message<FreightReference> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp created_at = 2;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<FreightReference>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "created_at", kind: "message", T: Timestamp, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FreightReference {
+    return new FreightReference().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FreightReference {
+    return new FreightReference().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FreightReference {
+    return new FreightReference().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FreightReference | PlainMessage<FreightReference> | undefined, b: FreightReference | PlainMessage<FreightReference> | undefined): boolean {
+    return proto3.util.equals(FreightReference, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.FreightStatus
  */
@@ -1961,6 +3650,79 @@ export class StageSubscription extends Message<StageSubscription> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.SubscriptionStatus
+ */
+export class SubscriptionStatus extends Message<SubscriptionStatus> {
+  /**
+   * @generated from field: string repo_url = 1 [json_name = "repoURL"];
+   */
+  repoUrl = "";
+
+  /**
+   * @generated from field: optional string chart_name = 2;
+   */
+  chartName?: string;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.GitCommit last_commit = 3;
+   */
+  lastCommit?: GitCommit;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.Image last_image = 4;
+   */
+  lastImage?: Image;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.Chart last_chart = 5;
+   */
+  lastChart?: Chart;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp last_discovered_at = 6;
+   */
+  lastDiscoveredAt?: Timestamp;
+
+  /**
+   * @generated from field: optional string error = 7;
+   */
+  error?: string;
+
+  constructor(data?: PartialMessage<SubscriptionStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.SubscriptionStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_url", jsonName: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "chart_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "last_commit", kind: "message", T: GitCommit, opt: true },
+    { no: 4, name: "last_image", kind: "message", T: Image, opt: true },
+    { no: 5, name: "last_chart", kind: "message", T: Chart, opt: true },
+    { no: 6, name: "last_discovered_at", kind: "message", T: Timestamp, opt: true },
+    { no: 7, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SubscriptionStatus {
+    return new SubscriptionStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SubscriptionStatus {
+    return new SubscriptionStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SubscriptionStatus {
+    return new SubscriptionStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SubscriptionStatus | PlainMessage<SubscriptionStatus> | undefined, b: SubscriptionStatus | PlainMessage<SubscriptionStatus> | undefined): boolean {
+    return proto3.util.equals(SubscriptionStatus, a, b);
+  }
+}
+
 /**
  * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.Subscriptions
  */
@@ -2074,6 +3836,11 @@ export class WarehouseSpec extends Message<WarehouseSpec> {
    */
   subscriptions: RepoSubscription[] = [];
 
+  /**
+   * @generated from field: optional string poll_interval = 2;
+   */
+  pollInterval?: string;
+
   constructor(data?: PartialMessage<WarehouseSpec>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2083,6 +3850,7 @@ export class WarehouseSpec extends Message<WarehouseSpec> {
   static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.WarehouseSpec";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "subscriptions", kind: "message", T: RepoSubscription, repeated: true },
+    { no: 2, name: "poll_interval", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WarehouseSpec {
@@ -2116,6 +3884,31 @@ export class WarehouseStatus extends Message<WarehouseStatus> {
    */
   observedGeneration = protoInt64.zero;
 
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.ImageVerificationFailure image_verification_failures = 3;
+   */
+  imageVerificationFailures: ImageVerificationFailure[] = [];
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.metav1.Condition conditions = 4;
+   */
+  conditions: Condition[] = [];
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp last_polled_at = 5;
+   */
+  lastPolledAt?: Timestamp;
+
+  /**
+   * @generated from field: optional github.com.akuity.kargo.pkg.api.v1alpha1.FreightReference last_freight = 6;
+   */
+  lastFreight?: FreightReference;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.pkg.api.v1alpha1.SubscriptionStatus subscriptions = 7;
+   */
+  subscriptions: SubscriptionStatus[] = [];
+
   constructor(data?: PartialMessage<WarehouseStatus>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2126,6 +3919,11 @@ export class WarehouseStatus extends Message<WarehouseStatus> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "observed_generation", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "image_verification_failures", kind: "message", T: ImageVerificationFailure, repeated: true },
+    { no: 4, name: "conditions", kind: "message", T: Condition, repeated: true },
+    { no: 5, name: "last_polled_at", kind: "message", T: Timestamp, opt: true },
+    { no: 6, name: "last_freight", kind: "message", T: FreightReference, opt: true },
+    { no: 7, name: "subscriptions", kind: "message", T: SubscriptionStatus, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WarehouseStatus {
@@ -2145,3 +3943,76 @@ export class WarehouseStatus extends Message<WarehouseStatus> {
   }
 }
 
+/**
+ * @generated from message github.com.akuity.kargo.pkg.api.v1alpha1.YAMLUpdate
+ */
+export class YAMLUpdate extends Message<YAMLUpdate> {
+  /**
+   * @generated from field: string file = 1;
+   */
+  file = "";
+
+  /**
+   * @generated from field: string key = 2;
+   */
+  key = "";
+
+  /**
+   * @generated from field: string value = 3;
+   */
+  value = "";
+
+  /**
+   * @generated from field: optional string image = 4;
+   */
+  image?: string;
+
+  /**
+   * @generated from field: optional string git_repo_url = 5 [json_name = "gitRepoURL"];
+   */
+  gitRepoUrl?: string;
+
+  /**
+   * @generated from field: optional string chart_registry_url = 6 [json_name = "chartRegistryURL"];
+   */
+  chartRegistryUrl?: string;
+
+  /**
+   * @generated from field: optional string chart_name = 7;
+   */
+  chartName?: string;
+
+  constructor(data?: PartialMessage<YAMLUpdate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "github.com.akuity.kargo.pkg.api.v1alpha1.YAMLUpdate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "file", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "image", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "git_repo_url", jsonName: "gitRepoURL", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "chart_registry_url", jsonName: "chartRegistryURL", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "chart_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): YAMLUpdate {
+    return new YAMLUpdate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): YAMLUpdate {
+    return new YAMLUpdate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): YAMLUpdate {
+    return new YAMLUpdate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: YAMLUpdate | PlainMessage<YAMLUpdate> | undefined, b: YAMLUpdate | PlainMessage<YAMLUpdate> | undefined): boolean {
+    return proto3.util.equals(YAMLUpdate, a, b);
+  }
+}
+